@@ -8,6 +8,8 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// Embed the timezone database; the runtime image doesn't ship one.
+	_ "time/tzdata"
 
 	_ "github.com/Financial-Partner/server/swagger"
 )
@@ -25,6 +27,11 @@ func main() {
 		ProvideLogger().WithError(err).Fatalf("Failed to initialize server")
 	}
 
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go srv.settlementWorker.Run(workerCtx)
+	go srv.snapshotWorker.Run(workerCtx)
+
 	srv.logger.Infof("Server is starting on port %s", srv.cfg.Server.Port)
 	if err := srv.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		srv.logger.WithError(err).Fatalf("Server failed to start")
@@ -35,6 +42,7 @@ func main() {
 	<-quit
 
 	srv.logger.Infof("Server is shutting down...")
+	stopWorkers()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
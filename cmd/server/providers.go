@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"time"
@@ -10,15 +11,30 @@ import (
 	authInfra "github.com/Financial-Partner/server/internal/infrastructure/auth"
 	cacheInfra "github.com/Financial-Partner/server/internal/infrastructure/cache"
 	dbInfra "github.com/Financial-Partner/server/internal/infrastructure/database"
+	llmInfra "github.com/Financial-Partner/server/internal/infrastructure/llm"
 	loggerInfra "github.com/Financial-Partner/server/internal/infrastructure/logger"
+	perFile "github.com/Financial-Partner/server/internal/infrastructure/persistence/file"
 	perMongo "github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
 	perRedis "github.com/Financial-Partner/server/internal/infrastructure/persistence/redis"
 	handler "github.com/Financial-Partner/server/internal/interfaces/http"
 	"github.com/Financial-Partner/server/internal/interfaces/http/middleware"
 	auth_usecase "github.com/Financial-Partner/server/internal/module/auth/usecase"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	exchange_repository "github.com/Financial-Partner/server/internal/module/exchange/repository"
+	exchange_usecase "github.com/Financial-Partner/server/internal/module/exchange/usecase"
+	gacha_domain "github.com/Financial-Partner/server/internal/module/gacha/domain"
+	gacha_repository "github.com/Financial-Partner/server/internal/module/gacha/repository"
 	gacha_usecase "github.com/Financial-Partner/server/internal/module/gacha/usecase"
+	goal_domain "github.com/Financial-Partner/server/internal/module/goal/domain"
+	goal_repository "github.com/Financial-Partner/server/internal/module/goal/repository"
 	goal_usecase "github.com/Financial-Partner/server/internal/module/goal/usecase"
+	investment_repository "github.com/Financial-Partner/server/internal/module/investment/repository"
 	investment_usecase "github.com/Financial-Partner/server/internal/module/investment/usecase"
+	market_domain "github.com/Financial-Partner/server/internal/module/market/domain"
+	market_repository "github.com/Financial-Partner/server/internal/module/market/repository"
+	market_usecase "github.com/Financial-Partner/server/internal/module/market/usecase"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+	report_repository "github.com/Financial-Partner/server/internal/module/report/repository"
 	report_usecase "github.com/Financial-Partner/server/internal/module/report/usecase"
 	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
 	transaction_usecase "github.com/Financial-Partner/server/internal/module/transaction/usecase"
@@ -36,7 +52,17 @@ func ProvideLogger() loggerInfra.Logger {
 }
 
 func ProvideDBClient(cfg *config.Config) (*dbInfra.Client, error) {
-	return dbInfra.NewClient(cfg)
+	client, err := dbInfra.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := perMongo.MigrateAmounts(context.Background(), client); err != nil {
+		return nil, err
+	}
+	if err := perMongo.EnsureIndexes(context.Background(), client); err != nil {
+		return nil, err
+	}
+	return client, nil
 }
 
 func ProvideCacheClient(cfg *config.Config) (*cacheInfra.Client, error) {
@@ -55,8 +81,12 @@ func ProvideUserStore(cache *cacheInfra.Client) *perRedis.UserStore {
 	return perRedis.NewUserStore(cache)
 }
 
-func ProvideUserService(repo user_repository.Repository, store *perRedis.UserStore, log loggerInfra.Logger) *user_usecase.Service {
-	return user_usecase.NewService(repo, store, log)
+func ProvideCharacterRepository(db *dbInfra.Client) user_repository.CharacterRepository {
+	return perMongo.NewCharacterRepository(db)
+}
+
+func ProvideUserService(repo user_repository.Repository, characterRepo user_repository.CharacterRepository, store *perRedis.UserStore, log loggerInfra.Logger) *user_usecase.Service {
+	return user_usecase.NewService(repo, characterRepo, store, log)
 }
 
 func ProvideTransactionRepository(db *dbInfra.Client) transaction_repository.Repository {
@@ -85,24 +115,166 @@ func ProvideAuthService(
 	return auth_usecase.NewService(cfg, authClient, jwtManager, tokenStore, userService)
 }
 
-func ProvideGoalService() *goal_usecase.Service {
-	return goal_usecase.NewService()
+func ProvideGoalRepository(db *dbInfra.Client) goal_repository.Repository {
+	return perMongo.NewGoalRepository(db)
+}
+
+func ProvideGoalStore(cache *cacheInfra.Client) *perRedis.GoalStore {
+	return perRedis.NewGoalStore(cache)
+}
+
+func ProvideGoalService(
+	cfg *config.Config,
+	repo goal_repository.Repository,
+	store *perRedis.GoalStore,
+	transactionRepo transaction_repository.Repository,
+	userService *user_usecase.Service,
+	db *dbInfra.Client,
+	log loggerInfra.Logger,
+) *goal_usecase.Service {
+	goalCfg := goal_domain.Config{MilestoneCharacters: cfg.Goal.MilestoneCharacters}
+	return goal_usecase.NewService(repo, store, transactionRepo, userService, db, goal_usecase.NewSavingsRateStrategy(), goalCfg, log)
+}
+
+func ProvideInvestmentRepository(db *dbInfra.Client) investment_repository.Repository {
+	return perMongo.NewInvestmentRepository(db)
+}
+
+func ProvideInvestmentStore(cache *cacheInfra.Client) *perRedis.InvestmentStore {
+	return perRedis.NewInvestmentStore(cache)
+}
+
+func ProvideInvestmentService(
+	repo investment_repository.Repository,
+	store *perRedis.InvestmentStore,
+	userRepo user_repository.Repository,
+	userService *user_usecase.Service,
+	marketService *market_usecase.Service,
+	rates exchange_domain.RateProvider,
+	transactionService *transaction_usecase.Service,
+	db *dbInfra.Client,
+	log loggerInfra.Logger,
+) *investment_usecase.Service {
+	return investment_usecase.NewService(repo, store, userRepo, userService, marketService, rates, transactionService, db, log)
+}
+
+func ProvideMarketRepository(db *dbInfra.Client) market_repository.Repository {
+	return perMongo.NewMarketRepository(db)
+}
+
+func ProvideMarketService(
+	cfg *config.Config,
+	repo market_repository.Repository,
+	investmentRepo investment_repository.Repository,
+	log loggerInfra.Logger,
+) *market_usecase.Service {
+	marketCfg := market_domain.Config{
+		Seed:          cfg.Market.Seed,
+		Volatility:    cfg.Market.Volatility,
+		TagVolatility: cfg.Market.Tags,
+	}
+	return market_usecase.NewService(repo, investmentRepo, marketCfg, log)
+}
+
+func ProvideSettlementWorker(
+	cfg *config.Config,
+	investmentService *investment_usecase.Service,
+	log loggerInfra.Logger,
+) *investment_usecase.SettlementWorker {
+	return investment_usecase.NewSettlementWorker(investmentService, cfg.Investment.SettlementInterval, log)
+}
+
+func ProvideExchangeRateRepository(cfg *config.Config, db *dbInfra.Client) (exchange_repository.Repository, error) {
+	switch cfg.Exchange.RateTable {
+	case "", "mongo":
+		return perMongo.NewExchangeRateRepository(db), nil
+	case "file":
+		return perFile.NewExchangeRateRepository(cfg.Exchange.RatesFile)
+	default:
+		return nil, fmt.Errorf("unknown exchange rate table %q", cfg.Exchange.RateTable)
+	}
+}
+
+func ProvideRateProvider(repo exchange_repository.Repository) exchange_domain.RateProvider {
+	return exchange_usecase.NewRateTable(repo)
+}
+
+func ProvideTransactionService(
+	repo transaction_repository.Repository,
+	store *perRedis.TransactionStore,
+	userRepo user_repository.Repository,
+	rates exchange_domain.RateProvider,
+	goalService *goal_usecase.Service,
+	log loggerInfra.Logger,
+) *transaction_usecase.Service {
+	return transaction_usecase.NewService(repo, store, userRepo, rates, log, goalService)
 }
 
-func ProvideInvestmentService() *investment_usecase.Service {
-	return investment_usecase.NewService()
+func ProvideGachaRepository(db *dbInfra.Client) gacha_repository.Repository {
+	return perMongo.NewGachaRepository(db)
 }
 
-func ProvideTransactionService(repo transaction_repository.Repository, store *perRedis.TransactionStore, log loggerInfra.Logger) *transaction_usecase.Service {
-	return transaction_usecase.NewService(repo, store, log)
+func ProvideGachaStore(cache *cacheInfra.Client) *perRedis.GachaStore {
+	return perRedis.NewGachaStore(cache)
 }
 
-func ProvideGachaService() *gacha_usecase.Service {
-	return gacha_usecase.NewService()
+func ProvideGachaService(
+	cfg *config.Config,
+	repo gacha_repository.Repository,
+	store *perRedis.GachaStore,
+	userService *user_usecase.Service,
+	db *dbInfra.Client,
+	log loggerInfra.Logger,
+) *gacha_usecase.Service {
+	gachaCfg := gacha_domain.Config{
+		Pity: gacha_domain.PityRules{
+			Default: gacha_domain.PityRule(cfg.Gacha.Pity),
+			Pools:   make(map[string]gacha_domain.PityRule, len(cfg.Gacha.Pools)),
+		},
+		BatchDiscountPercent: cfg.Gacha.BatchDiscountPercent,
+	}
+	for poolID, pity := range cfg.Gacha.Pools {
+		gachaCfg.Pity.Pools[poolID] = gacha_domain.PityRule(pity)
+	}
+	return gacha_usecase.NewService(repo, store, userService, db, gacha_usecase.NewRandomSource(), gachaCfg, log)
 }
 
-func ProvideReportService() *report_usecase.Service {
-	return report_usecase.NewService()
+func ProvideReportSnapshotRepository(db *dbInfra.Client) report_repository.SnapshotRepository {
+	return perMongo.NewReportSnapshotRepository(db)
+}
+
+func ProvideReportStore(cache *cacheInfra.Client) *perRedis.ReportStore {
+	return perRedis.NewReportStore(cache)
+}
+
+func ProvideSummaryGenerator(cfg *config.Config) (report_domain.SummaryGenerator, error) {
+	switch cfg.Report.SummaryProvider {
+	case "", "template":
+		return report_usecase.NewTemplateSummaryGenerator(), nil
+	case "llm":
+		return report_usecase.NewLLMSummaryGenerator(llmInfra.NewClient(cfg)), nil
+	default:
+		return nil, fmt.Errorf("unknown report summary provider %q", cfg.Report.SummaryProvider)
+	}
+}
+
+func ProvideReportService(
+	transactionRepo transaction_repository.Repository,
+	userRepo user_repository.Repository,
+	snapshotRepo report_repository.SnapshotRepository,
+	store *perRedis.ReportStore,
+	generator report_domain.SummaryGenerator,
+	log loggerInfra.Logger,
+) *report_usecase.Service {
+	return report_usecase.NewService(transactionRepo, userRepo, snapshotRepo, store, generator, log)
+}
+
+func ProvideSnapshotWorker(
+	cfg *config.Config,
+	reportService *report_usecase.Service,
+	log loggerInfra.Logger,
+) *report_usecase.SnapshotWorker {
+	return report_usecase.NewSnapshotWorker(reportService, cfg.Report.SnapshotInterval, log)
 }
 
 func ProvideHandler(
@@ -113,9 +285,10 @@ func ProvideHandler(
 	transactionService *transaction_usecase.Service,
 	gachaService *gacha_usecase.Service,
 	reportService *report_usecase.Service,
+	marketService *market_usecase.Service,
 	log loggerInfra.Logger,
 ) *handler.Handler {
-	return handler.NewHandler(userService, authService, goalService, investmentService, transactionService, gachaService, reportService, log)
+	return handler.NewHandler(userService, authService, goalService, investmentService, transactionService, gachaService, reportService, marketService, log)
 }
 
 func ProvideAuthMiddleware(jwtManager *authInfra.JWTManager, cfg *config.Config, log loggerInfra.Logger) *middleware.AuthMiddleware {
@@ -145,7 +318,13 @@ func ProvideRouter(
 	return router
 }
 
-func ProvideServer(router *mux.Router, cfg *config.Config, log loggerInfra.Logger) *Server {
+func ProvideServer(
+	router *mux.Router,
+	settlementWorker *investment_usecase.SettlementWorker,
+	snapshotWorker *report_usecase.SnapshotWorker,
+	cfg *config.Config,
+	log loggerInfra.Logger,
+) *Server {
 	httpServer := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
 		Handler:      router,
@@ -154,5 +333,5 @@ func ProvideServer(router *mux.Router, cfg *config.Config, log loggerInfra.Logge
 		IdleTimeout:  60 * time.Second,
 	}
 
-	return NewServer(httpServer, cfg, log)
+	return NewServer(httpServer, settlementWorker, snapshotWorker, cfg, log)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 
+	"github.com/Financial-Partner/server/internal/entities"
 	handler "github.com/Financial-Partner/server/internal/interfaces/http"
 	"github.com/Financial-Partner/server/internal/interfaces/http/middleware"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -38,7 +39,7 @@ func SetupRoutes(
 
 	protectedRoutes := api.NewRoute().Subrouter()
 	protectedRoutes.Use(authMiddleware.Authenticate)
-	setupProtectedRoutes(protectedRoutes, handlers)
+	setupProtectedRoutes(protectedRoutes, handlers, authMiddleware)
 }
 
 func setupPublicRoutes(router *mux.Router, handlers *handler.Handler) {
@@ -48,20 +49,37 @@ func setupPublicRoutes(router *mux.Router, handlers *handler.Handler) {
 	authRoutes.HandleFunc("/logout", handlers.Logout).Methods(http.MethodPost)
 }
 
-func setupProtectedRoutes(router *mux.Router, handlers *handler.Handler) {
+func setupProtectedRoutes(router *mux.Router, handlers *handler.Handler, authMiddleware *middleware.AuthMiddleware) {
+	adminOnly := func(h http.HandlerFunc) http.Handler {
+		return authMiddleware.RequireRole(entities.UserRoleAdmin)(h)
+	}
+
 	userRoutes := router.PathPrefix("/users").Subrouter()
 	userRoutes.HandleFunc("/me", handlers.GetUser).Methods(http.MethodGet)
 	userRoutes.HandleFunc("/me", handlers.UpdateUser).Methods(http.MethodPut)
+	userRoutes.HandleFunc("/me/characters", handlers.GetCharacters).Methods(http.MethodGet)
+	userRoutes.HandleFunc("/me/character", handlers.EquipCharacter).Methods(http.MethodPut)
+	userRoutes.HandleFunc("/me/timezone", handlers.UpdateTimezone).Methods(http.MethodPut)
+	userRoutes.HandleFunc("/me/portfolio", handlers.GetPortfolio).Methods(http.MethodGet)
+
+	characterRoutes := router.PathPrefix("/characters").Subrouter()
+	characterRoutes.Handle("", adminOnly(handlers.CreateCharacter)).Methods(http.MethodPost)
 
 	goalRoutes := router.PathPrefix("/goals").Subrouter()
 	goalRoutes.HandleFunc("", handlers.CreateGoal).Methods(http.MethodPost)
-	goalRoutes.HandleFunc("", handlers.GetGoal).Methods(http.MethodGet)
+	goalRoutes.HandleFunc("", handlers.GetGoals).Methods(http.MethodGet)
+	goalRoutes.HandleFunc("/milestones", handlers.GetActiveGoalMilestones).Methods(http.MethodGet)
 	goalRoutes.HandleFunc("/suggestion", handlers.GetGoalSuggestion).Methods(http.MethodPost)
 	goalRoutes.HandleFunc("/suggestion/me", handlers.GetAutoGoalSuggestion).Methods(http.MethodGet)
+	goalRoutes.HandleFunc("/{id:[0-9a-fA-F]{24}}", handlers.GetGoal).Methods(http.MethodGet)
+	goalRoutes.HandleFunc("/{id:[0-9a-fA-F]{24}}", handlers.UpdateGoal).Methods(http.MethodPut)
+	goalRoutes.HandleFunc("/{id:[0-9a-fA-F]{24}}", handlers.DeleteGoal).Methods(http.MethodDelete)
+	goalRoutes.HandleFunc("/{id:[0-9a-fA-F]{24}}/milestones", handlers.GetGoalMilestones).Methods(http.MethodGet)
 
 	investmentRoutes := router.PathPrefix("/investments").Subrouter()
 	investmentRoutes.HandleFunc("", handlers.GetOpportunities).Methods(http.MethodGet)
-	investmentRoutes.HandleFunc("", handlers.CreateOpportunity).Methods(http.MethodPost)
+	investmentRoutes.Handle("", adminOnly(handlers.CreateOpportunity)).Methods(http.MethodPost)
+	investmentRoutes.HandleFunc("/{id:[0-9a-fA-F]{24}}/prices", handlers.GetOpportunityPrices).Methods(http.MethodGet)
 
 	userInvestmentRoutes := router.PathPrefix("/users/me/investment").Subrouter()
 	userInvestmentRoutes.HandleFunc("/", handlers.CreateUserInvestment).Methods(http.MethodPost)
@@ -70,12 +88,21 @@ func setupProtectedRoutes(router *mux.Router, handlers *handler.Handler) {
 	transactionRoutes := router.PathPrefix("/transactions").Subrouter()
 	transactionRoutes.HandleFunc("", handlers.CreateTransaction).Methods(http.MethodPost)
 	transactionRoutes.HandleFunc("", handlers.GetTransactions).Methods(http.MethodGet)
+	transactionRoutes.HandleFunc("/import", handlers.ImportTransactions).Methods(http.MethodPost)
+	transactionRoutes.HandleFunc("/{id}", handlers.GetTransaction).Methods(http.MethodGet)
+	transactionRoutes.HandleFunc("/{id}", handlers.UpdateTransaction).Methods(http.MethodPut)
+	transactionRoutes.HandleFunc("/{id}", handlers.DeleteTransaction).Methods(http.MethodDelete)
 
 	gachaRoutes := router.PathPrefix("/gacha").Subrouter()
 	gachaRoutes.HandleFunc("/draw", handlers.DrawGacha).Methods(http.MethodPost)
+	gachaRoutes.HandleFunc("/draw/batch", handlers.DrawGachaBatch).Methods(http.MethodPost)
 	gachaRoutes.HandleFunc("/preview", handlers.PreviewGachas).Methods(http.MethodGet)
+	gachaRoutes.HandleFunc("/inventory", handlers.GetGachaInventory).Methods(http.MethodGet)
+	gachaRoutes.Handle("/pools", adminOnly(handlers.CreateGachaPool)).Methods(http.MethodPost)
 
 	reportRoutes := router.PathPrefix("/reports").Subrouter()
 	reportRoutes.HandleFunc("/finance", handlers.GetReport).Methods(http.MethodGet)
+	reportRoutes.HandleFunc("/finance/export", handlers.ExportReport).Methods(http.MethodGet)
 	reportRoutes.HandleFunc("/analysis", handlers.GetReportSummary).Methods(http.MethodGet)
+	reportRoutes.HandleFunc("/history", handlers.GetReportHistory).Methods(http.MethodGet)
 }
@@ -5,18 +5,24 @@ import (
 
 	"github.com/Financial-Partner/server/internal/config"
 	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	investment_usecase "github.com/Financial-Partner/server/internal/module/investment/usecase"
+	report_usecase "github.com/Financial-Partner/server/internal/module/report/usecase"
 )
 
 type Server struct {
-	httpServer *http.Server
-	cfg        *config.Config
-	logger     logger.Logger
+	httpServer       *http.Server
+	settlementWorker *investment_usecase.SettlementWorker
+	snapshotWorker   *report_usecase.SnapshotWorker
+	cfg              *config.Config
+	logger           logger.Logger
 }
 
-func NewServer(server *http.Server, cfg *config.Config, logger logger.Logger) *Server {
+func NewServer(server *http.Server, settlementWorker *investment_usecase.SettlementWorker, snapshotWorker *report_usecase.SnapshotWorker, cfg *config.Config, logger logger.Logger) *Server {
 	return &Server{
-		httpServer: server,
-		cfg:        cfg,
-		logger:     logger,
+		httpServer:       server,
+		settlementWorker: settlementWorker,
+		snapshotWorker:   snapshotWorker,
+		cfg:              cfg,
+		logger:           logger,
 	}
 }
@@ -15,19 +15,35 @@ func InitializeServer(cfgFile string) (*Server, error) {
 		ProvideCacheClient,
 		ProvideAuthClient,
 		ProvideUserRepository,
+		ProvideCharacterRepository,
 		ProvideUserStore,
 		ProvideUserService,
 		ProvideJWTManager,
 		ProvideLoggerMiddleware,
 		ProvideTokenStore,
 		ProvideAuthService,
+		ProvideGoalRepository,
+		ProvideGoalStore,
 		ProvideGoalService,
+		ProvideInvestmentRepository,
+		ProvideInvestmentStore,
 		ProvideInvestmentService,
+		ProvideMarketRepository,
+		ProvideMarketService,
+		ProvideSettlementWorker,
 		ProvideTransactionRepository,
 		ProvideTransactionStore,
+		ProvideExchangeRateRepository,
+		ProvideRateProvider,
 		ProvideTransactionService,
+		ProvideGachaRepository,
+		ProvideGachaStore,
 		ProvideGachaService,
+		ProvideReportSnapshotRepository,
+		ProvideReportStore,
+		ProvideSummaryGenerator,
 		ProvideReportService,
+		ProvideSnapshotWorker,
 		ProvideHandler,
 		ProvideAuthMiddleware,
 		ProvideRouter,
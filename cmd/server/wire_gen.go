@@ -22,13 +22,14 @@ func InitializeServer(cfgFile string) (*Server, error) {
 		return nil, err
 	}
 	repository := ProvideUserRepository(client)
+	characterRepository := ProvideCharacterRepository(client)
 	cacheClient, err := ProvideCacheClient(config)
 	if err != nil {
 		return nil, err
 	}
 	userStore := ProvideUserStore(cacheClient)
 	logger := ProvideLogger()
-	service := ProvideUserService(repository, userStore, logger)
+	service := ProvideUserService(repository, characterRepository, userStore, logger)
 	authClient, err := ProvideAuthClient(config)
 	if err != nil {
 		return nil, err
@@ -36,17 +37,38 @@ func InitializeServer(cfgFile string) (*Server, error) {
 	jwtManager := ProvideJWTManager(config)
 	tokenStore := ProvideTokenStore(cacheClient)
 	auth_usecaseService := ProvideAuthService(config, authClient, jwtManager, tokenStore, service)
-	goal_usecaseService := ProvideGoalService()
-	investment_usecaseService := ProvideInvestmentService()
+	goal_repositoryRepository := ProvideGoalRepository(client)
+	goalStore := ProvideGoalStore(cacheClient)
 	transaction_repositoryRepository := ProvideTransactionRepository(client)
+	goal_usecaseService := ProvideGoalService(config, goal_repositoryRepository, goalStore, transaction_repositoryRepository, service, client, logger)
+	investment_repositoryRepository := ProvideInvestmentRepository(client)
+	investmentStore := ProvideInvestmentStore(cacheClient)
+	market_repositoryRepository := ProvideMarketRepository(client)
+	market_usecaseService := ProvideMarketService(config, market_repositoryRepository, investment_repositoryRepository, logger)
 	transactionStore := ProvideTransactionStore(cacheClient)
-	transaction_usecaseService := ProvideTransactionService(transaction_repositoryRepository, transactionStore, logger)
-	gacha_usecaseService := ProvideGachaService()
-	report_usecaseService := ProvideReportService()
-	handler := ProvideHandler(service, auth_usecaseService, goal_usecaseService, investment_usecaseService, transaction_usecaseService, gacha_usecaseService, report_usecaseService, logger)
+	exchange_repositoryRepository, err := ProvideExchangeRateRepository(config, client)
+	if err != nil {
+		return nil, err
+	}
+	rateProvider := ProvideRateProvider(exchange_repositoryRepository)
+	transaction_usecaseService := ProvideTransactionService(transaction_repositoryRepository, transactionStore, repository, rateProvider, goal_usecaseService, logger)
+	investment_usecaseService := ProvideInvestmentService(investment_repositoryRepository, investmentStore, repository, service, market_usecaseService, rateProvider, transaction_usecaseService, client, logger)
+	gacha_repositoryRepository := ProvideGachaRepository(client)
+	gachaStore := ProvideGachaStore(cacheClient)
+	gacha_usecaseService := ProvideGachaService(config, gacha_repositoryRepository, gachaStore, service, client, logger)
+	reportStore := ProvideReportStore(cacheClient)
+	summaryGenerator, err := ProvideSummaryGenerator(config)
+	if err != nil {
+		return nil, err
+	}
+	snapshotRepository := ProvideReportSnapshotRepository(client)
+	report_usecaseService := ProvideReportService(transaction_repositoryRepository, repository, snapshotRepository, reportStore, summaryGenerator, logger)
+	handler := ProvideHandler(service, auth_usecaseService, goal_usecaseService, investment_usecaseService, transaction_usecaseService, gacha_usecaseService, report_usecaseService, market_usecaseService, logger)
 	authMiddleware := ProvideAuthMiddleware(jwtManager, config, logger)
 	loggerMiddleware := ProvideLoggerMiddleware(logger)
 	router := ProvideRouter(handler, authMiddleware, loggerMiddleware, config)
-	server := ProvideServer(router, config, logger)
+	settlementWorker := ProvideSettlementWorker(config, investment_usecaseService, logger)
+	snapshotWorker := ProvideSnapshotWorker(config, report_usecaseService, logger)
+	server := ProvideServer(router, settlementWorker, snapshotWorker, config, logger)
 	return server, nil
 }
@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Financial-Partner/server/internal/config"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,25 @@ func TestLoadConfig(t *testing.T) {
 		assert.Equal(t, 1, cfg.Redis.DB)
 		assert.Equal(t, "test-project", cfg.Firebase.ProjectID)
 		assert.Equal(t, "creds.json", cfg.Firebase.CredentialFile)
+		assert.Equal(t, map[int]string{75: "char_002", 100: "char_001"}, cfg.Goal.MilestoneCharacters)
+		assert.Equal(t, config.Pity{Rarity: "rare", Threshold: 10}, cfg.Gacha.Pity)
+		assert.Equal(t, map[string]config.Pity{
+			"60d6ec33f777b123e4567891": {Rarity: "legendary", Threshold: 90},
+		}, cfg.Gacha.Pools)
+		assert.Equal(t, 10, cfg.Gacha.BatchDiscountPercent)
+		assert.Equal(t, time.Minute, cfg.Investment.SettlementInterval)
+		assert.Equal(t, uint64(1), cfg.Market.Seed)
+		assert.Equal(t, 0.02, cfg.Market.Volatility)
+		assert.Equal(t, map[string]float64{"high risk": 0.05, "low risk": 0.005}, cfg.Market.Tags)
+		assert.Equal(t, "llm", cfg.Report.SummaryProvider)
+		assert.Equal(t, 30*time.Minute, cfg.Report.SnapshotInterval)
+		assert.Equal(t, config.Exchange{RateTable: "file", RatesFile: "config/exchange_rates.csv"}, cfg.Exchange)
+		assert.Equal(t, config.LLM{
+			BaseURL: "http://localhost:11434/v1",
+			APIKey:  "llm-key",
+			Model:   "llama3",
+			Timeout: 10 * time.Second,
+		}, cfg.LLM)
 	})
 
 	t.Run("Invalid YAML format", func(t *testing.T) {
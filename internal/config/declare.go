@@ -3,11 +3,18 @@ package config
 import "time"
 
 type Config struct {
-	Server   Server   `mapstructure:"server"`
-	MongoDB  Mongo    `mapstructure:"mongodb"`
-	Redis    Redis    `mapstructure:"redis"`
-	Firebase Firebase `mapstructure:"firebase"`
-	JWT      JWT      `mapstructure:"jwt"`
+	Server     Server     `mapstructure:"server"`
+	MongoDB    Mongo      `mapstructure:"mongodb"`
+	Redis      Redis      `mapstructure:"redis"`
+	Firebase   Firebase   `mapstructure:"firebase"`
+	JWT        JWT        `mapstructure:"jwt"`
+	Goal       Goal       `mapstructure:"goal"`
+	Gacha      Gacha      `mapstructure:"gacha"`
+	Investment Investment `mapstructure:"investment"`
+	Market     Market     `mapstructure:"market"`
+	Report     Report     `mapstructure:"report"`
+	Exchange   Exchange   `mapstructure:"exchange"`
+	LLM        LLM        `mapstructure:"llm"`
 }
 
 type Server struct {
@@ -39,3 +46,60 @@ type JWT struct {
 	AccessExpiry  time.Duration `mapstructure:"access_expiry"`
 	RefreshExpiry time.Duration `mapstructure:"refresh_expiry"`
 }
+
+type Goal struct {
+	// MilestoneCharacters maps the target percent of a goal milestone to the ID of
+	// the character completing it unlocks.
+	MilestoneCharacters map[int]string `mapstructure:"milestone_characters"`
+}
+
+type Gacha struct {
+	Pity Pity `mapstructure:"pity"`
+	// Pools overrides the pity rule of individual pools, keyed by pool ID.
+	Pools map[string]Pity `mapstructure:"pools"`
+	// BatchDiscountPercent is taken off the cost of drawing several gachas at once.
+	BatchDiscountPercent int `mapstructure:"batch_discount_percent"`
+}
+
+type Pity struct {
+	Rarity    string `mapstructure:"rarity"`
+	Threshold int    `mapstructure:"threshold"`
+}
+
+type Investment struct {
+	// SettlementInterval is how often matured investments are settled.
+	SettlementInterval time.Duration `mapstructure:"settlement_interval"`
+}
+
+type Market struct {
+	// Seed makes the simulated price paths reproducible.
+	Seed uint64 `mapstructure:"seed"`
+	// Volatility is the default daily volatility of an opportunity's price.
+	Volatility float64 `mapstructure:"volatility"`
+	// Tags overrides the daily volatility of opportunities by tag.
+	Tags map[string]float64 `mapstructure:"tags"`
+}
+
+type Report struct {
+	// SummaryProvider writes report summaries: "template" (default) or "llm".
+	SummaryProvider string `mapstructure:"summary_provider"`
+	// SnapshotInterval is how often users whose month has ended get their monthly
+	// report snapshot.
+	SnapshotInterval time.Duration `mapstructure:"snapshot_interval"`
+}
+
+type Exchange struct {
+	// RateTable stores the exchange rates used to convert transactions into the user's
+	// base currency: "mongo" (default) or "file".
+	RateTable string `mapstructure:"rate_table"`
+	// RatesFile is the CSV file of the file rate table.
+	RatesFile string `mapstructure:"rates_file"`
+}
+
+// LLM configures a chat completion API compatible with OpenAI's.
+type LLM struct {
+	BaseURL string        `mapstructure:"base_url"`
+	APIKey  string        `mapstructure:"api_key"`
+	Model   string        `mapstructure:"model"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
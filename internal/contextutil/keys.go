@@ -9,6 +9,7 @@ type ContextKey string
 const (
 	UserIDKey    ContextKey = "user_id"
 	UserEmailKey ContextKey = "user_email"
+	UserRoleKey  ContextKey = "user_role"
 	RequestIDKey ContextKey = "request_id"
 )
 
@@ -21,3 +22,8 @@ func GetUserEmail(ctx context.Context) (string, bool) {
 	email, ok := ctx.Value(UserEmailKey).(string)
 	return email, ok
 }
+
+func GetUserRole(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(UserRoleKey).(string)
+	return role, ok
+}
@@ -26,3 +26,19 @@ func TestGetUserEmail(t *testing.T) {
 		assert.Empty(t, gotEmail)
 	})
 }
+
+func TestGetUserRole(t *testing.T) {
+	t.Run("should return user role", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), contextutil.UserRoleKey, "admin")
+
+		gotRole, ok := contextutil.GetUserRole(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "admin", gotRole)
+	})
+
+	t.Run("should return false if user role is not present", func(t *testing.T) {
+		gotRole, ok := contextutil.GetUserRole(context.Background())
+		assert.False(t, ok)
+		assert.Empty(t, gotRole)
+	})
+}
@@ -1,5 +1,7 @@
 package entities
 
+// Character is an entry of the character catalog. The character a user has
+// equipped is embedded in the user as a copy of its catalog entry.
 type Character struct {
 	ID       string `bson:"id" json:"id"`
 	Name     string `bson:"name" json:"name"`
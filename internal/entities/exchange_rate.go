@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ExchangeRate is the price of one major unit of Base in Quote from Date on, e.g. Base
+// EUR, Quote USD and Rate 1.08.
+type ExchangeRate struct {
+	ID    primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Base  string             `bson:"base" json:"base"`
+	Quote string             `bson:"quote" json:"quote"`
+	Rate  float64            `bson:"rate" json:"rate"`
+	Date  time.Time          `bson:"date" json:"date"` // midnight UTC
+}
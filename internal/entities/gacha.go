@@ -1,10 +1,104 @@
 package entities
 
 import (
+	"slices"
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	GachaRarityCommon    = "common"
+	GachaRarityRare      = "rare"
+	GachaRarityEpic      = "epic"
+	GachaRarityLegendary = "legendary"
+)
+
+// GachaRarities lists the rarities from most to least common.
+var GachaRarities = []string{GachaRarityCommon, GachaRarityRare, GachaRarityEpic, GachaRarityLegendary}
+
+// GachaRarityAtLeast reports whether rarity is as rare as minimum or rarer.
+// Unknown rarities rank below common.
+func GachaRarityAtLeast(rarity, minimum string) bool {
+	return slices.Index(GachaRarities, rarity) >= slices.Index(GachaRarities, minimum)
+}
+
 type Gacha struct {
 	ID     primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name   string             `bson:"name" json:"name"`
 	ImgSrc string             `bson:"img_src" json:"img_src"`
+	Rarity string             `bson:"rarity" json:"rarity"`
+	// Weight is the item's relative chance of being drawn within its pool.
+	Weight int `bson:"weight" json:"weight"`
+	// CharacterID is the character the item unlocks when it is first drawn, if any.
+	CharacterID string `bson:"character_id,omitempty" json:"character_id,omitempty"`
+}
+
+// GachaPool is a set of items that can be drawn for a fixed diamond cost.
+type GachaPool struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name      string             `bson:"name" json:"name"`
+	Cost      int64              `bson:"cost" json:"cost"`
+	Items     []Gacha            `bson:"items" json:"items"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// GachaInventoryItem is an item a user has drawn, copied from the pool at draw time.
+type GachaInventoryItem struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID  primitive.ObjectID `bson:"user_id" json:"user_id"`
+	PoolID  primitive.ObjectID `bson:"pool_id" json:"pool_id"`
+	GachaID primitive.ObjectID `bson:"gacha_id" json:"gacha_id"`
+	Name    string             `bson:"name" json:"name"`
+	ImgSrc  string             `bson:"img_src" json:"img_src"`
+	Rarity  string             `bson:"rarity" json:"rarity"`
+	DrawnAt time.Time          `bson:"drawn_at" json:"drawn_at"`
+}
+
+// GachaDraw is the outcome of a single draw. A duplicate of an item the user already
+// owns is converted into shards instead of being added to the inventory.
+type GachaDraw struct {
+	Gacha     Gacha `json:"gacha"`
+	Duplicate bool  `json:"duplicate"`
+	Shards    int64 `json:"shards"`
+}
+
+// GachaInventory is everything a user has collected from gacha draws.
+type GachaInventory struct {
+	Items  []GachaInventoryItem `json:"items"`
+	Shards int64                `json:"shards"`
+}
+
+// GachaShards is a user's balance of shards earned from duplicate draws.
+type GachaShards struct {
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Balance   int64              `bson:"balance" json:"balance"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// GachaPity counts a user's consecutive draws from a pool without an item of the
+// pool's pity rarity.
+type GachaPity struct {
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	PoolID    primitive.ObjectID `bson:"pool_id" json:"pool_id"`
+	Count     int                `bson:"count" json:"count"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// GachaDropRate is the published chance, in percent, of drawing an item of a rarity.
+type GachaDropRate struct {
+	Rarity string  `json:"rarity"`
+	Rate   float64 `json:"rate"`
+}
+
+// GachaPreview is what a user sees before drawing from a pool: a sample of its items,
+// its drop rates and how close the user is to a guaranteed draw.
+type GachaPreview struct {
+	PoolID        primitive.ObjectID `json:"pool_id"`
+	Cost          int64              `json:"cost"`
+	Items         []Gacha            `json:"items"`
+	DropRates     []GachaDropRate    `json:"drop_rates"`
+	PityRarity    string             `json:"pity_rarity"`
+	PityThreshold int                `json:"pity_threshold"`
+	PityCount     int                `json:"pity_count"`
 }
@@ -6,27 +6,65 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	GoalStatusActive    = "active"
+	GoalStatusCompleted = "completed"
+	GoalStatusFailed    = "failed"
+)
+
 type GoalSuggestion struct {
-	SuggestedAmount int64  `bson:"suggested_amount" json:"suggested_amount"`
+	SuggestedAmount Money  `bson:"suggested_amount" json:"suggested_amount"`
 	Period          int    `bson:"period" json:"period"`
 	Message         string `bson:"message" json:"message"`
 }
 
 type Goal struct {
-	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	UserID        primitive.ObjectID `bson:"user_id" json:"user_id"`
-	TargetAmount  int64              `bson:"target_amount" json:"target_amount"`
-	CurrentAmount int64              `bson:"current_amount" json:"current_amount"`
-	Period        int                `bson:"period" json:"period"`
-	Status        string             `bson:"status" json:"status"` // "active", "completed", "failed"
-	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID            primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Name              string             `bson:"name" json:"name"`
+	TargetAmount      Money              `bson:"target_amount" json:"target_amount"`
+	CurrentAmount     Money              `bson:"current_amount" json:"current_amount"`
+	Period            int                `bson:"period" json:"period"`
+	Priority          int                `bson:"priority" json:"priority"`                     // lower values are funded first
+	AllocationPercent int                `bson:"allocation_percent" json:"allocation_percent"` // share of each income, 0 to fund by priority
+	Status            string             `bson:"status" json:"status"`                         // "active", "completed", "failed"
+	Milestones        []GoalMilestone    `bson:"milestones" json:"milestones"`
+	Contributions     []GoalContribution `bson:"contributions,omitempty" json:"-"` // income allocated to the goal
+	CreatedAt         time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt         time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// Deadline returns the moment the goal's saving period elapses.
+func (g *Goal) Deadline() time.Time {
+	return g.CreatedAt.AddDate(0, 0, g.Period)
+}
+
+// Remaining returns how much is still needed to reach the target.
+func (g *Goal) Remaining() int64 {
+	return max(g.TargetAmount.Amount-g.CurrentAmount.Amount, 0)
+}
+
+// ProgressPercent returns how much of the target has been saved, in whole percent.
+func (g *Goal) ProgressPercent() int {
+	if g.TargetAmount.Amount <= 0 {
+		return 0
+	}
+	return int(g.CurrentAmount.Amount * 100 / g.TargetAmount.Amount)
+}
+
+// GoalContribution is the share of an income transaction allocated to a goal, kept so
+// that it can be taken back when the transaction changes.
+type GoalContribution struct {
+	TransactionID primitive.ObjectID `bson:"transaction_id" json:"transaction_id"`
+	Amount        Money              `bson:"amount" json:"amount"`
 }
 
 type GoalMilestone struct {
-	Title         string     `bson:"title" json:"title"`
-	TargetPercent int        `bson:"target_percent" json:"target_percent"`
-	Reward        string     `bson:"reward" json:"reward"`
-	IsCompleted   bool       `bson:"is_completed" json:"is_completed"`
-	CompletedAt   *time.Time `bson:"completed_at" json:"completed_at"`
+	Title          string     `bson:"title" json:"title"`
+	TargetPercent  int        `bson:"target_percent" json:"target_percent"`
+	Reward         int64      `bson:"reward" json:"reward"`                                 // diamonds credited on completion
+	CharacterID    string     `bson:"character_id,omitempty" json:"character_id,omitempty"` // character unlocked on completion
+	IsCompleted    bool       `bson:"is_completed" json:"is_completed"`
+	CompletedAt    *time.Time `bson:"completed_at" json:"completed_at"`
+	RewardWithheld bool       `bson:"reward_withheld,omitempty" json:"reward_withheld,omitempty"` // reward already earned that month, so not paid
 }
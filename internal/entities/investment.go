@@ -6,24 +6,46 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	InvestmentStatusOpen    = "open"
+	InvestmentStatusSettled = "settled"
+)
+
 type Opportunity struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Title       string             `bson:"title" json:"title"`
-	Description string             `bson:"description" json:"description"`
-	Tags        []string           `bson:"tags" json:"tags"`
-	IsIncrease  bool               `bson:"is_increase" json:"is_increase"`
-	Variation   int64              `bson:"variation" json:"variation"`
-	Duration    string             `bson:"duration" json:"duration"`
-	MinAmount   int64              `bson:"min_amount" json:"min_amount"`
-	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Title        string             `bson:"title" json:"title"`
+	Description  string             `bson:"description" json:"description"`
+	Tags         []string           `bson:"tags" json:"tags"`
+	IsIncrease   bool               `bson:"is_increase" json:"is_increase"`
+	Variation    int64              `bson:"variation" json:"variation"`         // expected percent gained or lost at maturity
+	Duration     string             `bson:"duration" json:"duration"`           // human readable, e.g. "a month"
+	DurationDays int                `bson:"duration_days" json:"duration_days"` // days until an investment matures
+	MinAmount    Money              `bson:"min_amount" json:"min_amount"`
+	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// ExpectedGrowth is the factor an investment is expected to grow by at maturity,
+// e.g. 1.2 for a 20% gain. It never drops below zero.
+func (o *Opportunity) ExpectedGrowth() float64 {
+	change := float64(o.Variation) / 100
+	if !o.IsIncrease {
+		change = -change
+	}
+	return max(1+change, 0)
 }
 
 type Investment struct {
-	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	UserID        primitive.ObjectID `bson:"user_id" json:"user_id"`
-	OpportunityID primitive.ObjectID `bson:"opportunity_id" json:"opportunity_id"`
-	Amount        int64              `bson:"amount" json:"amount"`
-	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+	ID                 primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID             primitive.ObjectID `bson:"user_id" json:"user_id"`
+	OpportunityID      primitive.ObjectID `bson:"opportunity_id" json:"opportunity_id"`
+	Amount             Money              `bson:"amount" json:"amount"`
+	Status             string             `bson:"status" json:"status"` // "open", "settled"
+	MaturesAt          time.Time          `bson:"matures_at" json:"matures_at"`
+	Payout             Money              `bson:"payout" json:"payout"` // credited to savings on settlement
+	SettledAt          *time.Time         `bson:"settled_at,omitempty" json:"settled_at,omitempty"`
+	SettlementAttempts int                `bson:"settlement_attempts,omitempty" json:"-"` // failed attempts to settle
+	RetryAt            *time.Time         `bson:"retry_at,omitempty" json:"-"`            // settlement isn't retried before then
+	CreatedAt          time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt          time.Time          `bson:"updated_at" json:"updated_at"`
 }
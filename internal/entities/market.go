@@ -0,0 +1,15 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PricePoint is the simulated price of an opportunity on a day.
+type PricePoint struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	OpportunityID primitive.ObjectID `bson:"opportunity_id" json:"opportunity_id"`
+	Date          time.Time          `bson:"date" json:"date"` // midnight UTC
+	Price         float64            `bson:"price" json:"price"`
+}
@@ -0,0 +1,245 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+var (
+	ErrInvalidCurrency  = errors.New("invalid ISO 4217 currency code")
+	ErrCurrencyMismatch = errors.New("amounts are in different currencies")
+	ErrAmountOverflow   = errors.New("amount is out of range")
+	ErrInvalidAmount    = errors.New("invalid amount")
+)
+
+// currencyDigits maps the ISO 4217 codes in circulation to their number of minor
+// unit digits; most currencies have two.
+var currencyDigits = func() map[string]int {
+	digits := map[string]int{
+		"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+		"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0,
+		"XPF": 0,
+		"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+		"CLF": 4, "UYW": 4,
+	}
+	for _, code := range strings.Fields(`
+		AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BMD BND BOB BRL BSD BTN
+		BWP BYN BZD CAD CDF CHF CNY COP CRC CUP CVE CZK DKK DOP DZD EGP ERN ETB EUR FJD
+		FKP GBP GEL GHS GIP GMD GTQ GYD HKD HNL HTG HUF IDR ILS INR IRR JMD KES KGS KHR
+		KPW KYD KZT LAK LBP LKR LRD LSL MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN
+		MYR MZN NAD NGN NIO NOK NPR NZD PAB PEN PGK PHP PKR PLN QAR RON RSD RUB SAR SBD
+		SCR SDG SEK SGD SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS TMT TOP TRY TTD TWD
+		TZS UAH USD UZS VES WST XCD YER ZAR ZMW ZWG`) {
+		digits[code] = 2
+	}
+	return digits
+}()
+
+// IsCurrency reports whether code is an ISO 4217 currency code in circulation.
+func IsCurrency(code string) bool {
+	_, ok := currencyDigits[code]
+	return ok
+}
+
+// CurrencyDigits returns the number of minor unit digits of the currency, e.g. 2 for
+// USD and 0 for JPY.
+func CurrencyDigits(code string) (int, error) {
+	digits, ok := currencyDigits[code]
+	if !ok {
+		return 0, ErrInvalidCurrency
+	}
+	return digits, nil
+}
+
+// Money is an amount in the minor units of an ISO 4217 currency, e.g. 1050 USD is
+// $10.50. Arithmetic between amounts of different currencies fails rather than
+// mixing them, and results that don't fit in an int64 fail rather than wrap.
+type Money struct {
+	Amount   int64  `bson:"amount" json:"amount"`
+	Currency string `bson:"currency" json:"currency" example:"USD"`
+}
+
+// NewMoney returns the amount in the currency, whose code is matched case-insensitively.
+func NewMoney(amount int64, currency string) (Money, error) {
+	m := Money{Amount: amount, Currency: strings.ToUpper(strings.TrimSpace(currency))}
+	if err := m.Validate(); err != nil {
+		return Money{}, err
+	}
+	return m, nil
+}
+
+// ParseMoney parses an amount written in major units of the currency, such as
+// "-1,234.50", with an optional sign and comma thousands separators. An amount in
+// parentheses is negative, as in accounting exports.
+func ParseMoney(amount, currency string) (Money, error) {
+	digits, err := CurrencyDigits(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	s := strings.ReplaceAll(strings.TrimSpace(amount), ",", "")
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s, negative = s[1:len(s)-1], true
+	}
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		negative = negative != (s[0] == '-')
+		s = s[1:]
+	}
+
+	units, fraction, _ := strings.Cut(s, ".")
+	if units == "" && fraction == "" || len(fraction) > digits {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+	minor, err := strconv.ParseUint(units+fraction+strings.Repeat("0", digits-len(fraction)), 10, 63)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	m := Money{Amount: int64(minor), Currency: currency}
+	if negative {
+		m.Amount = -m.Amount
+	}
+	return m, nil
+}
+
+// Validate checks that the currency is a known ISO 4217 code.
+func (m Money) Validate() error {
+	if !IsCurrency(m.Currency) {
+		return ErrInvalidCurrency
+	}
+	return nil
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	sum := m.Amount + other.Amount
+	// The sum overflowed if both operands have the same sign and the sum's differs.
+	if (m.Amount >= 0) == (other.Amount >= 0) && (sum >= 0) != (m.Amount >= 0) {
+		return Money{}, ErrAmountOverflow
+	}
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	negated, err := other.Neg()
+	if err != nil {
+		return Money{}, err
+	}
+	return m.Add(negated)
+}
+
+func (m Money) Neg() (Money, error) {
+	if m.Amount == math.MinInt64 {
+		return Money{}, ErrAmountOverflow
+	}
+	return Money{Amount: -m.Amount, Currency: m.Currency}, nil
+}
+
+// Scale multiplies the amount by factor, rounding half away from zero.
+func (m Money) Scale(factor float64) (Money, error) {
+	scaled := math.Round(float64(m.Amount) * factor)
+	// float64(math.MaxInt64) rounds up to 2^63, which is already out of range.
+	if math.IsNaN(scaled) || scaled >= math.MaxInt64 || scaled < math.MinInt64 {
+		return Money{}, ErrAmountOverflow
+	}
+	return Money{Amount: int64(scaled), Currency: m.Currency}, nil
+}
+
+// Convert returns the amount in another currency at rate, the price of one major unit
+// of m's currency in that currency, rounding half away from zero.
+func (m Money) Convert(currency string, rate float64) (Money, error) {
+	from, err := CurrencyDigits(m.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+	to, err := CurrencyDigits(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	converted, err := m.Scale(rate * math.Pow10(to-from))
+	if err != nil {
+		return Money{}, err
+	}
+	converted.Currency = currency
+	return converted, nil
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// String formats the amount in major units followed by the currency, e.g. "-10.50 USD".
+func (m Money) String() string {
+	return strings.TrimSpace(m.Decimal() + " " + m.Currency)
+}
+
+// Decimal formats the amount in major units without the currency, e.g. "-10.50". An
+// amount in an unknown currency is formatted in minor units.
+func (m Money) Decimal() string {
+	digits, err := CurrencyDigits(m.Currency)
+	if err != nil || digits == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+
+	// Formatting the magnitude as unsigned handles math.MinInt64.
+	magnitude := uint64(m.Amount)
+	sign := ""
+	if m.Amount < 0 {
+		magnitude = -magnitude
+		sign = "-"
+	}
+	units := strconv.FormatUint(magnitude, 10)
+	if len(units) <= digits {
+		units = strings.Repeat("0", digits-len(units)+1) + units
+	}
+	point := len(units) - digits
+	return sign + units[:point] + "." + units[point:]
+}
+
+// UnmarshalBSONValue decodes an amount stored as a document. Amounts stored before
+// they had a currency are plain numbers of whole units, which are read as amounts in
+// DefaultCurrency.
+func (m *Money) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	value := bsoncore.Value{Type: t, Data: data}
+	var units float64
+	switch t {
+	case bsontype.EmbeddedDocument:
+		// The alias has no methods, so decoding into it doesn't recurse.
+		type money Money
+		*m = Money{}
+		return bson.Unmarshal(data, (*money)(m))
+	case bsontype.Null, bsontype.Undefined:
+		*m = Money{}
+		return nil
+	case bsontype.Int32:
+		units = float64(value.Int32())
+	case bsontype.Int64:
+		units = float64(value.Int64())
+	case bsontype.Double:
+		units = value.Double()
+	default:
+		return fmt.Errorf("cannot decode BSON %s into an amount", t)
+	}
+
+	digits, err := CurrencyDigits(DefaultCurrency)
+	if err != nil {
+		return err
+	}
+	legacy, err := Money{Amount: 1, Currency: DefaultCurrency}.Scale(units * math.Pow10(digits))
+	if err != nil {
+		return err
+	}
+	*m = legacy
+	return nil
+}
@@ -0,0 +1,204 @@
+package entities_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+func usd(amount int64) entities.Money {
+	return entities.Money{Amount: amount, Currency: "USD"}
+}
+
+func TestNewMoney(t *testing.T) {
+	t.Run("Normalizes the currency code", func(t *testing.T) {
+		money, err := entities.NewMoney(1050, " usd ")
+		require.NoError(t, err)
+		assert.Equal(t, usd(1050), money)
+	})
+
+	for _, currency := range []string{"", "US", "XYZ", "dollars"} {
+		t.Run("Rejects "+currency, func(t *testing.T) {
+			money, err := entities.NewMoney(1050, currency)
+			assert.ErrorIs(t, err, entities.ErrInvalidCurrency)
+			assert.Equal(t, entities.Money{}, money)
+		})
+	}
+}
+
+func TestCurrencyDigits(t *testing.T) {
+	for currency, want := range map[string]int{"USD": 2, "JPY": 0, "KWD": 3, "CLF": 4} {
+		digits, err := entities.CurrencyDigits(currency)
+		require.NoError(t, err)
+		assert.Equal(t, want, digits, currency)
+	}
+
+	_, err := entities.CurrencyDigits("XYZ")
+	assert.ErrorIs(t, err, entities.ErrInvalidCurrency)
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	t.Run("Add and Sub", func(t *testing.T) {
+		sum, err := usd(1000).Add(usd(250))
+		require.NoError(t, err)
+		assert.Equal(t, usd(1250), sum)
+
+		difference, err := usd(1000).Sub(usd(1250))
+		require.NoError(t, err)
+		assert.Equal(t, usd(-250), difference)
+	})
+
+	t.Run("Currency mismatch", func(t *testing.T) {
+		euros := entities.Money{Amount: 100, Currency: "EUR"}
+
+		_, err := usd(100).Add(euros)
+		assert.ErrorIs(t, err, entities.ErrCurrencyMismatch)
+		_, err = usd(100).Sub(euros)
+		assert.ErrorIs(t, err, entities.ErrCurrencyMismatch)
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		_, err := usd(math.MaxInt64).Add(usd(1))
+		assert.ErrorIs(t, err, entities.ErrAmountOverflow)
+		_, err = usd(math.MinInt64).Add(usd(-1))
+		assert.ErrorIs(t, err, entities.ErrAmountOverflow)
+		_, err = usd(0).Sub(usd(math.MinInt64))
+		assert.ErrorIs(t, err, entities.ErrAmountOverflow)
+		_, err = usd(math.MinInt64).Neg()
+		assert.ErrorIs(t, err, entities.ErrAmountOverflow)
+	})
+
+	t.Run("Scale rounds half away from zero", func(t *testing.T) {
+		scaled, err := usd(1005).Scale(0.5)
+		require.NoError(t, err)
+		assert.Equal(t, usd(503), scaled)
+
+		scaled, err = usd(-1005).Scale(0.5)
+		require.NoError(t, err)
+		assert.Equal(t, usd(-503), scaled)
+	})
+
+	t.Run("Scale overflow", func(t *testing.T) {
+		for _, factor := range []float64{3, -3, math.NaN(), math.Inf(1)} {
+			_, err := usd(math.MaxInt64 / 2).Scale(factor)
+			assert.ErrorIs(t, err, entities.ErrAmountOverflow, factor)
+		}
+	})
+
+	t.Run("Convert accounts for minor units", func(t *testing.T) {
+		yen, err := usd(1050).Convert("JPY", 150)
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 1575, Currency: "JPY"}, yen)
+
+		dinars, err := usd(1050).Convert("KWD", 0.31)
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 3255, Currency: "KWD"}, dinars)
+
+		_, err = usd(1050).Convert("XYZ", 1)
+		assert.ErrorIs(t, err, entities.ErrInvalidCurrency)
+		_, err = entities.Money{Amount: 1050}.Convert("USD", 1)
+		assert.ErrorIs(t, err, entities.ErrInvalidCurrency)
+	})
+}
+
+func TestMoneyString(t *testing.T) {
+	for want, money := range map[string]entities.Money{
+		"10.50 USD":                 usd(1050),
+		"-10.50 USD":                usd(-1050),
+		"0.05 USD":                  usd(5),
+		"0.00 USD":                  usd(0),
+		"1050 JPY":                  {Amount: 1050, Currency: "JPY"},
+		"1.050 KWD":                 {Amount: 1050, Currency: "KWD"},
+		"-92233720368547758.08 USD": usd(math.MinInt64),
+		"1050":                      {Amount: 1050},
+	} {
+		assert.Equal(t, want, money.String())
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	for want, money := range map[string]entities.Money{
+		"-10.50": usd(-1050),
+		"0.05":   usd(5),
+		"1050":   {Amount: 1050, Currency: "JPY"},
+		"1.050":  {Amount: 1050, Currency: "KWD"},
+		"105":    {Amount: 105, Currency: "XYZ"},
+	} {
+		assert.Equal(t, want, money.Decimal())
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	for amount, want := range map[string]entities.Money{
+		"10.50":       usd(1050),
+		"-1,234.5":    usd(-123450),
+		"+3":          usd(300),
+		" .05 ":       usd(5),
+		"(12.00)":     usd(-1200),
+		"(-12.00)":    usd(1200),
+		"12.":         usd(1200),
+		"1,000,000.0": usd(100000000),
+	} {
+		money, err := entities.ParseMoney(amount, "USD")
+		require.NoError(t, err, amount)
+		assert.Equal(t, want, money, amount)
+	}
+
+	yen, err := entities.ParseMoney("1500", "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, entities.Money{Amount: 1500, Currency: "JPY"}, yen)
+
+	for _, amount := range []string{"", ".", "-", "abc", "1.005", "1.2.3", "--1", "1e3", "99999999999999999999"} {
+		_, err := entities.ParseMoney(amount, "USD")
+		assert.ErrorIs(t, err, entities.ErrInvalidAmount, amount)
+	}
+	_, err = entities.ParseMoney("1.5", "JPY")
+	assert.ErrorIs(t, err, entities.ErrInvalidAmount)
+	_, err = entities.ParseMoney("1", "XYZ")
+	assert.ErrorIs(t, err, entities.ErrInvalidCurrency)
+}
+
+func TestMoneyUnmarshalBSONValue(t *testing.T) {
+	type holder struct {
+		Amount entities.Money `bson:"amount"`
+	}
+	decode := func(t *testing.T, amount any) (entities.Money, error) {
+		data, err := bson.Marshal(bson.M{"amount": amount})
+		require.NoError(t, err)
+		var h holder
+		err = bson.Unmarshal(data, &h)
+		return h.Amount, err
+	}
+
+	for name, tc := range map[string]struct {
+		stored any
+		want   entities.Money
+	}{
+		"Document":      {entities.Money{Amount: 1050, Currency: "EUR"}, entities.Money{Amount: 1050, Currency: "EUR"}},
+		"Legacy int":    {int32(25), usd(2500)},
+		"Legacy long":   {int64(-7), usd(-700)},
+		"Legacy double": {10.505, usd(1051)},
+		"Null":          {nil, entities.Money{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := decode(t, tc.stored)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("Overflow", func(t *testing.T) {
+		_, err := decode(t, int64(math.MaxInt64))
+		assert.ErrorIs(t, err, entities.ErrAmountOverflow)
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		_, err := decode(t, "10.50")
+		assert.Error(t, err)
+	})
+}
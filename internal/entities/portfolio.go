@@ -0,0 +1,34 @@
+package entities
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// Portfolio summarizes a user's investments. Cost basis and current value cover
+// open investments only; realized P&L comes from settled ones. All amounts are in
+// Currency, the user's base currency.
+type Portfolio struct {
+	Currency      string          `json:"currency"`
+	Holdings      []Holding       `json:"holdings"`
+	CostBasis     int64           `json:"cost_basis"`
+	CurrentValue  int64           `json:"current_value"`
+	UnrealizedPnL int64           `json:"unrealized_pnl"`
+	RealizedPnL   int64           `json:"realized_pnl"`
+	Allocations   []TagAllocation `json:"allocations"`
+}
+
+// Holding is everything a user has invested in one opportunity.
+type Holding struct {
+	OpportunityID primitive.ObjectID `json:"opportunity_id"`
+	Title         string             `json:"title"`
+	Tags          []string           `json:"tags"`
+	CostBasis     int64              `json:"cost_basis"`
+	CurrentValue  int64              `json:"current_value"`
+	UnrealizedPnL int64              `json:"unrealized_pnl"`
+	RealizedPnL   int64              `json:"realized_pnl"`
+}
+
+// TagAllocation is the share of a portfolio's current value held under a tag.
+type TagAllocation struct {
+	Tag     string  `json:"tag"`
+	Value   int64   `json:"value"`
+	Percent float64 `json:"percent"`
+}
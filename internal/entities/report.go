@@ -1,14 +1,80 @@
 package entities
 
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Report totals a user's transactions in the user's base currency.
 type Report struct {
+	// Start and End bound the reported period; End is exclusive.
+	Start time.Time `bson:"start" json:"start"`
+	End   time.Time `bson:"end" json:"end"`
+	// Currency is the base currency the amounts are in, in its minor units.
+	Currency    string    `bson:"currency" json:"currency"`
 	Revenue     int64     `bson:"revenue" json:"revenue"`
 	Expenses    int64     `bson:"expenses" json:"expenses"`
 	NetProfit   int64     `bson:"net_profit" json:"net_profit"`
 	Categories  []string  `bson:"categories" json:"categories"`
 	Amounts     []int64   `bson:"amounts" json:"amounts"`
 	Percentages []float64 `bson:"percentages" json:"percentages"`
+	// Interval is the length of each bucket in Series: daily, weekly or monthly.
+	Interval string         `bson:"interval" json:"interval"`
+	Series   []ReportBucket `bson:"series" json:"series"`
+	// Previous compares the report with the period of equal length just before it.
+	Previous ReportComparison `bson:"previous" json:"previous"`
+}
+
+// ReportBucket totals the transactions of one day, week or month of a report. Start is
+// the calendar start of the bucket, so the first bucket may start before the report.
+type ReportBucket struct {
+	Start   time.Time `bson:"start" json:"start"`
+	Income  int64     `bson:"income" json:"income"`
+	Expense int64     `bson:"expense" json:"expense"`
+	Net     int64     `bson:"net" json:"net"`
+}
+
+// ReportComparison holds the totals of the previous period and the percent change from
+// them. A change is nil when the previous total is zero.
+type ReportComparison struct {
+	Revenue         int64    `bson:"revenue" json:"revenue"`
+	Expenses        int64    `bson:"expenses" json:"expenses"`
+	NetProfit       int64    `bson:"net_profit" json:"net_profit"`
+	RevenueChange   *float64 `bson:"revenue_change,omitempty" json:"revenue_change,omitempty"`
+	ExpensesChange  *float64 `bson:"expenses_change,omitempty" json:"expenses_change,omitempty"`
+	NetProfitChange *float64 `bson:"net_profit_change,omitempty" json:"net_profit_change,omitempty"`
+}
+
+// CategoryTotal is the sum of a user's transactions of one type and category.
+type CategoryTotal struct {
+	Type     string `bson:"type" json:"type"`
+	Category string `bson:"category" json:"category"`
+	Total    int64  `bson:"total" json:"total"`
 }
 
 type ReportSummary struct {
-	Summary string `bson:"summary" json:"summary"`
+	Summary string    `bson:"summary" json:"summary"`
+	Start   time.Time `bson:"start" json:"start"`
+	End     time.Time `bson:"end" json:"end"`
+	// Digest identifies the report the summary was written from, so that a cached
+	// summary is not reused once the report changes.
+	Digest string `bson:"digest" json:"digest"`
+}
+
+// ReportExport is a report together with the transactions it was computed from.
+type ReportExport struct {
+	Report       *Report       `json:"report"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// ReportSnapshot is a user's monthly report as it stood when the month ended in the
+// user's timezone. Snapshots are never updated, so later edits to transactions don't
+// change them.
+type ReportSnapshot struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Timezone  string             `bson:"timezone" json:"timezone"`
+	Report    Report             `bson:"report" json:"report"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
 }
@@ -6,14 +6,56 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	TransactionTypeIncome  = "income"
+	TransactionTypeExpense = "expense"
+)
+
 type Transaction struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	UserID      primitive.ObjectID `bson:"user_id" json:"user_id"`
-	Amount      int                `bson:"amount" json:"amount"`
-	Description string             `bson:"description" json:"description"`
-	Date        time.Time          `bson:"date" json:"date"`
-	Category    string             `bson:"category" json:"category"`
-	Type        string             `bson:"type" json:"type" example:"expense"` // Type can be "expense" or "income"
-	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	ID     primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Amount Money              `bson:"amount" json:"amount"`
+	// BaseAmount is the amount converted into the user's base currency at the rate of
+	// the transaction date; it equals Amount when that is in the base currency.
+	BaseAmount  Money     `bson:"base_amount" json:"base_amount"`
+	Description string    `bson:"description" json:"description"`
+	Date        time.Time `bson:"date" json:"date"`
+	Category    string    `bson:"category" json:"category"`
+	Type        string    `bson:"type" json:"type" example:"expense"` // Type can be "expense" or "income"
+	// ImportKey identifies the statement row an imported transaction came from; a
+	// user has one transaction at most per key. It is empty for other transactions.
+	ImportKey string    `bson:"import_key,omitempty" json:"import_key,omitempty"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// TransactionQuery selects a page of a user's transactions. Zero-valued filters
+// don't narrow the selection.
+type TransactionQuery struct {
+	// From and To bound the transaction date; To is exclusive.
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Category string    `json:"category"`
+	Type     string    `json:"type"`
+	// MinAmount and MaxAmount bound the amount in minor units, whatever its currency.
+	MinAmount *int64 `json:"min_amount"`
+	MaxAmount *int64 `json:"max_amount"`
+	// Search matches a case-insensitive substring of the description.
+	Search    string `json:"search"`
+	Ascending bool   `json:"ascending"`
+	// After is the position of the last transaction of the previous page, if any.
+	After *TransactionCursor `json:"after"`
+	Limit int                `json:"limit"`
+}
+
+// TransactionCursor is a position in a listing ordered by date and then ID.
+type TransactionCursor struct {
+	Date time.Time          `json:"date"`
+	ID   primitive.ObjectID `json:"id"`
+}
+
+type TransactionPage struct {
+	Transactions []Transaction `json:"transactions"`
+	// NextCursor continues the listing after this page; it is empty on the last page.
+	NextCursor string `json:"next_cursor"`
 }
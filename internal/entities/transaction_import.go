@@ -0,0 +1,26 @@
+package entities
+
+const (
+	ImportRowNew       = "new"
+	ImportRowDuplicate = "duplicate"
+	ImportRowInvalid   = "invalid"
+)
+
+// TransactionImport is the outcome of importing a bank statement. A preview only
+// reports what importing it would do; a commit saves the new rows.
+type TransactionImport struct {
+	Committed bool
+	Rows      []ImportRow
+}
+
+// ImportRow is the outcome of one transaction of a statement.
+type ImportRow struct {
+	// Line is the row's line in a CSV file, or its position among the transactions
+	// of an OFX file.
+	Line   int
+	Status string
+	// Error tells why an invalid row can't be imported.
+	Error string
+	// Transaction is what the row imports as; it is nil for invalid rows.
+	Transaction *Transaction
+}
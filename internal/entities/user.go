@@ -6,12 +6,35 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
+
+// DefaultTimezone is the timezone of users who haven't set one.
+const DefaultTimezone = "UTC"
+
+// DefaultCurrency is the currency of users who haven't set one.
+const DefaultCurrency = "USD"
+
 type User struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Email     string             `bson:"email" json:"email"`
-	Name      string             `bson:"name" json:"name"`
-	Wallet    Wallet             `bson:"wallet" json:"wallet"`
-	Character Character          `bson:"character" json:"character"`
-	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Email           string             `bson:"email" json:"email"`
+	Name            string             `bson:"name" json:"name"`
+	Role            string             `bson:"role" json:"role"`                             // "user", "admin"
+	Timezone        string             `bson:"timezone,omitempty" json:"timezone,omitempty"` // IANA name; empty means UTC
+	Currency        string             `bson:"currency,omitempty" json:"currency,omitempty"` // ISO 4217 code; empty means USD
+	Wallet          Wallet             `bson:"wallet" json:"wallet"`
+	Character       Character          `bson:"character" json:"character"`
+	OwnedCharacters []string           `bson:"owned_characters" json:"owned_characters"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// BaseCurrency returns the currency the user's savings and totals are kept in.
+func (u *User) BaseCurrency() string {
+	if u.Currency == "" {
+		return DefaultCurrency
+	}
+	return u.Currency
 }
@@ -2,5 +2,5 @@ package entities
 
 type Wallet struct {
 	Diamonds int64 `bson:"diamonds" json:"diamonds"`
-	Savings  int64 `bson:"savings" json:"savings"`
+	Savings  Money `bson:"savings" json:"savings"` // held in the user's base currency
 }
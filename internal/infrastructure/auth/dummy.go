@@ -6,6 +6,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/Financial-Partner/server/internal/config"
+	"github.com/Financial-Partner/server/internal/entities"
 )
 
 type DummyJWTValidator struct {
@@ -31,5 +32,6 @@ func (v *DummyJWTValidator) ValidateToken(tokenString string) (*Claims, error) {
 	return &Claims{
 		ID:    dummyObjectID.Hex(),
 		Email: "bypass@example.com",
+		Role:  entities.UserRoleAdmin,
 	}, nil
 }
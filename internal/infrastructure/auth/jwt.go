@@ -16,6 +16,7 @@ type JWTManager struct {
 type Claims struct {
 	ID    string `json:"id"`
 	Email string `json:"email"`
+	Role  string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -27,12 +28,15 @@ func NewJWTManager(secretKey string, accessExpiry, refreshExpiry time.Duration)
 	}
 }
 
-func (m *JWTManager) GenerateAccessToken(id, email string) (string, time.Time, error) {
+// GenerateAccessToken issues an access token carrying the user's role. Refresh
+// tokens carry no role, so a role change takes effect on the next refresh.
+func (m *JWTManager) GenerateAccessToken(id, email, role string) (string, time.Time, error) {
 	expiresAt := time.Now().Add(m.accessExpiry)
 
 	claims := &Claims{
 		ID:    id,
 		Email: email,
+		Role:  role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/infrastructure/auth"
 )
 
@@ -22,7 +23,7 @@ func TestJWTManager(t *testing.T) {
 		id := primitive.NewObjectID().Hex()
 		email := "test@example.com"
 
-		token, expiryTime, err := jwtManager.GenerateAccessToken(id, email)
+		token, expiryTime, err := jwtManager.GenerateAccessToken(id, email, entities.UserRoleAdmin)
 
 		require.NoError(t, err)
 		require.NotEmpty(t, token)
@@ -34,6 +35,7 @@ func TestJWTManager(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, id, claims.ID)
 		assert.Equal(t, email, claims.Email)
+		assert.Equal(t, entities.UserRoleAdmin, claims.Role)
 	})
 
 	t.Run("GenerateRefreshToken", func(t *testing.T) {
@@ -52,6 +54,7 @@ func TestJWTManager(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, id, claims.ID)
 		assert.Equal(t, email, claims.Email)
+		assert.Empty(t, claims.Role)
 	})
 
 	t.Run("ValidateToken_Valid", func(t *testing.T) {
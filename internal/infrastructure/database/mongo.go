@@ -17,6 +17,7 @@ type MongoClient interface {
 	Ping(ctx context.Context, rp *readpref.ReadPref) error
 	Database(name string, opts ...*options.DatabaseOptions) *mongo.Database
 	Disconnect(ctx context.Context) error
+	UseSession(ctx context.Context, fn func(mongo.SessionContext) error) error
 }
 
 type ConnectFunc func(ctx context.Context, opts ...*options.ClientOptions) (MongoClient, error)
@@ -59,6 +60,17 @@ func (m *Client) Collection(name string, opts ...*options.CollectionOptions) *mo
 	return m.database.Collection(name)
 }
 
+// WithTransaction runs fn inside a multi-document transaction. Repositories join
+// the transaction by using the context handed to fn, which carries the session.
+func (m *Client) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.client.UseSession(ctx, func(sc mongo.SessionContext) error {
+		_, err := sc.WithTransaction(sc, func(sc mongo.SessionContext) (interface{}, error) {
+			return nil, fn(sc)
+		})
+		return err
+	})
+}
+
 func (m *Client) Close(ctx context.Context) error {
 	return m.client.Disconnect(ctx)
 }
@@ -89,3 +89,17 @@ func (mr *MockMongoClientMockRecorder) Ping(ctx, rp any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockMongoClient)(nil).Ping), ctx, rp)
 }
+
+// UseSession mocks base method.
+func (m *MockMongoClient) UseSession(ctx context.Context, fn func(mongo.SessionContext) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseSession", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseSession indicates an expected call of UseSession.
+func (mr *MockMongoClientMockRecorder) UseSession(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseSession", reflect.TypeOf((*MockMongoClient)(nil).UseSession), ctx, fn)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Financial-Partner/server/internal/config"
 	"github.com/Financial-Partner/server/internal/infrastructure/database"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/mock/gomock"
@@ -117,3 +118,53 @@ func TestClientClose(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestClientWithTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	cfg := &config.Config{}
+
+	newClient := func(mt *mtest.T) *database.Client {
+		mockClient := database.NewMockMongoClient(ctrl)
+		mockClient.EXPECT().Ping(gomock.Any(), nil).Return(nil)
+		mockClient.EXPECT().Database(gomock.Any(), gomock.Any()).Return(mt.DB)
+		mockClient.EXPECT().UseSession(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(mongo.SessionContext) error) error {
+				return mt.Client.UseSession(ctx, fn)
+			},
+		)
+
+		connectFunc := func(ctx context.Context, opts ...*options.ClientOptions) (database.MongoClient, error) {
+			return mockClient, nil
+		}
+
+		client, err := database.NewClient(cfg, connectFunc)
+		require.NoError(mt, err)
+		return client
+	}
+
+	mt.Run("success", func(mt *mtest.T) {
+		client := newClient(mt)
+
+		called := false
+		err := client.WithTransaction(context.Background(), func(ctx context.Context) error {
+			called = true
+			require.NotNil(mt, mongo.SessionFromContext(ctx))
+			return nil
+		})
+		require.NoError(mt, err)
+		require.True(mt, called)
+	})
+
+	mt.Run("callback error", func(mt *mtest.T) {
+		client := newClient(mt)
+
+		err := client.WithTransaction(context.Background(), func(ctx context.Context) error {
+			return errors.New("callback error")
+		})
+		require.EqualError(mt, err, "callback error")
+	})
+}
@@ -0,0 +1,111 @@
+package llm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Financial-Partner/server/internal/config"
+	"github.com/Financial-Partner/server/internal/infrastructure/llm"
+)
+
+func newClient(url, apiKey string) *llm.Client {
+	cfg := &config.Config{}
+	cfg.LLM.BaseURL = url + "/v1/"
+	cfg.LLM.APIKey = apiKey
+	cfg.LLM.Model = "test-model"
+	return llm.NewClient(cfg)
+}
+
+func TestComplete(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			var body struct {
+				Model    string `json:"model"`
+				Messages []struct {
+					Role    string `json:"role"`
+					Content string `json:"content"`
+				} `json:"messages"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "test-model", body.Model)
+			require.Len(t, body.Messages, 2)
+			assert.Equal(t, "system", body.Messages[0].Role)
+			assert.Equal(t, "Be brief.", body.Messages[0].Content)
+			assert.Equal(t, "user", body.Messages[1].Role)
+			assert.Equal(t, "Summarize.", body.Messages[1].Content)
+
+			_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":" You saved 3500. \n"}}]}`))
+		}))
+		defer server.Close()
+
+		reply, err := newClient(server.URL, "secret").Complete(context.Background(), "Be brief.", "Summarize.")
+		require.NoError(t, err)
+		assert.Equal(t, "You saved 3500.", reply)
+	})
+
+	t.Run("Without API key", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"ok"}}]}`))
+		}))
+		defer server.Close()
+
+		reply, err := newClient(server.URL, "").Complete(context.Background(), "Be brief.", "Summarize.")
+		require.NoError(t, err)
+		assert.Equal(t, "ok", reply)
+	})
+
+	t.Run("Error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "invalid api key", http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		reply, err := newClient(server.URL, "wrong").Complete(context.Background(), "Be brief.", "Summarize.")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status 401: invalid api key")
+		assert.Empty(t, reply)
+	})
+
+	t.Run("Empty completion", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"choices":[]}`))
+		}))
+		defer server.Close()
+
+		reply, err := newClient(server.URL, "").Complete(context.Background(), "Be brief.", "Summarize.")
+		assert.ErrorIs(t, err, llm.ErrEmptyCompletion)
+		assert.Empty(t, reply)
+	})
+
+	t.Run("Invalid response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`not json`))
+		}))
+		defer server.Close()
+
+		reply, err := newClient(server.URL, "").Complete(context.Background(), "Be brief.", "Summarize.")
+		require.Error(t, err)
+		assert.Empty(t, reply)
+	})
+
+	t.Run("Unreachable server", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		reply, err := newClient(server.URL, "").Complete(context.Background(), "Be brief.", "Summarize.")
+		require.Error(t, err)
+		assert.Empty(t, reply)
+	})
+}
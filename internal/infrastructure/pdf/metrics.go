@@ -0,0 +1,29 @@
+package pdf
+
+import "unicode/utf8"
+
+// TextWidth returns the width in points of text drawn in font at size.
+func TextWidth(text string, font Font, size float64) float64 {
+	f := fonts[font]
+	total := 0
+	for _, r := range text {
+		total += f.advance(f.glyphID(r))
+	}
+	return float64(total) * size / 1000
+}
+
+// Truncate shortens text with an ellipsis so that it fits within width.
+func Truncate(text string, font Font, size, width float64) string {
+	if TextWidth(text, font, size) <= width {
+		return text
+	}
+	const ellipsis = "..."
+	for len(text) > 0 {
+		_, n := utf8.DecodeLastRuneInString(text)
+		text = text[:len(text)-n]
+		if TextWidth(text+ellipsis, font, size) <= width {
+			return text + ellipsis
+		}
+	}
+	return ""
+}
@@ -0,0 +1,26 @@
+package pdf_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Financial-Partner/server/internal/infrastructure/pdf"
+)
+
+func TestTextWidth(t *testing.T) {
+	assert.InDelta(t, 6.36, pdf.TextWidth("0", pdf.Regular, 10), 1e-9)
+	assert.InDelta(t, 25.31, pdf.TextWidth("Hello", pdf.Regular, 10), 1e-9)
+	assert.InDelta(t, 28.85, pdf.TextWidth("Hello", pdf.Bold, 10), 1e-9)
+	assert.InDelta(t, 6.15, pdf.TextWidth("é", pdf.Regular, 10), 1e-9)
+	assert.InDelta(t, 27.89, pdf.TextWidth("Кофе", pdf.Regular, 10), 1e-9)
+	// Characters the font lacks take the width of its missing glyph.
+	assert.InDelta(t, 6, pdf.TextWidth("東", pdf.Regular, 10), 1e-9)
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "Groceries", pdf.Truncate("Groceries", pdf.Regular, 10, 100))
+	assert.Equal(t, "Weekly gr...", pdf.Truncate("Weekly groceries at the market", pdf.Regular, 10, 60))
+	assert.Equal(t, "Продукт...", pdf.Truncate("Продукты и напитки", pdf.Regular, 10, 60))
+	assert.Equal(t, "", pdf.Truncate("Groceries", pdf.Regular, 10, 5))
+}
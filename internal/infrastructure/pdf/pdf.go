@@ -0,0 +1,257 @@
+// Package pdf writes simple PDF documents made of text, lines and filled rectangles,
+// which is all the server's exports need, without depending on a PDF library.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	_ "embed"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font is one of the faces of DejaVu Sans, which covers Latin, Greek and Cyrillic
+// among other scripts. Documents embed the glyphs they use.
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+)
+
+var (
+	//go:embed fonts/DejaVuSans.ttf
+	regularFont []byte
+	//go:embed fonts/DejaVuSans-Bold.ttf
+	boldFont []byte
+
+	fontNames = []string{Regular: "DejaVuSans", Bold: "DejaVuSans-Bold"}
+	fonts     = []*trueType{Regular: mustParseTrueType(regularFont), Bold: mustParseTrueType(boldFont)}
+)
+
+func mustParseTrueType(data []byte) *trueType {
+	f, err := parseTrueType(data)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Color is an RGB color.
+type Color struct {
+	R, G, B uint8
+}
+
+var (
+	Black     = Color{0, 0, 0}
+	Gray      = Color{128, 128, 128}
+	LightGray = Color{220, 220, 220}
+)
+
+// Document is a PDF document built page by page. Coordinates are in points from the
+// top-left corner of the page.
+type Document struct {
+	pages []*bytes.Buffer
+	// Text is encoded as character IDs numbering the characters of the document in
+	// order of appearance, from 1, which all fonts share.
+	cids  map[rune]uint16
+	runes []rune
+}
+
+func New() *Document {
+	return &Document{cids: make(map[rune]uint16)}
+}
+
+// AddPage starts a new page; everything drawn afterwards goes on it.
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// PageCount returns the number of pages added so far.
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+func (d *Document) page() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Text draws text with its baseline at y. Characters the font has no glyph for, such
+// as Chinese ones, are drawn as a box but still copy and search as text.
+func (d *Document) Text(x, y float64, font Font, size float64, color Color, text string) {
+	fmt.Fprintf(d.page(), "BT %s rg /F%d %s Tf %s %s Td <%s> Tj ET\n",
+		rgb(color), font+1, num(size), num(x), num(PageHeight-y), d.encode(text))
+}
+
+// encode returns the character IDs of text in hexadecimal.
+func (d *Document) encode(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		cid, ok := d.cids[r]
+		// Characters past the 65535 IDs available are drawn as the missing glyph.
+		if !ok && len(d.runes) < math.MaxUint16 {
+			d.runes = append(d.runes, r)
+			cid = uint16(len(d.runes))
+			d.cids[r] = cid
+		}
+		fmt.Fprintf(&b, "%04X", cid)
+	}
+	return b.String()
+}
+
+// Line draws a line of the given width between two points.
+func (d *Document) Line(x1, y1, x2, y2, width float64, color Color) {
+	fmt.Fprintf(d.page(), "q %s RG %s w %s %s m %s %s l S Q\n",
+		rgb(color), num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// Rect fills the rectangle whose top-left corner is at x, y.
+func (d *Document) Rect(x, y, width, height float64, color Color) {
+	fmt.Fprintf(d.page(), "q %s rg %s %s %s %s re f Q\n",
+		rgb(color), num(x), num(PageHeight-y-height), num(width), num(height))
+}
+
+// WriteTo writes the document. A document without pages is written with one blank page.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	d.page()
+
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(format string, args ...any) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n", len(offsets))
+		fmt.Fprintf(&buf, format, args...)
+		buf.WriteString("\nendobj\n")
+	}
+
+	// Objects 1, 2 and 3 are the catalog, the page tree and the map from character IDs
+	// to Unicode, followed by fontObjects objects per font and then a page and its
+	// content stream for each page.
+	const fontObjects = 5
+	firstPage := 4 + fontObjects*len(fonts)
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	resources := make([]string, len(fonts))
+	for i := range fonts {
+		resources[i] = fmt.Sprintf("/F%d %d 0 R", i+1, 4+fontObjects*i)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))
+	toUnicode := d.toUnicode()
+	object("<< /Length %d >>\nstream\n%s\nendstream", len(toUnicode), toUnicode)
+	for i, font := range fonts {
+		first := 4 + fontObjects*i
+		name := d.subsetTag(fontNames[i]) + "+" + fontNames[i]
+		glyphs := make([]byte, 2*(len(d.runes)+1))
+		used := make(map[uint16]bool, len(d.runes))
+		var widths strings.Builder
+		for j, r := range d.runes {
+			glyph := font.glyphID(r)
+			glyphs[2*j+2], glyphs[2*j+3] = byte(glyph>>8), byte(glyph)
+			used[glyph] = true
+			fmt.Fprintf(&widths, " %d", font.advance(glyph))
+		}
+		file := font.subset(used)
+
+		object("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode 3 0 R >>",
+			name, first+1)
+		object("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+			"/FontDescriptor %d 0 R /DW %d /W [1 [%s ]] /CIDToGIDMap %d 0 R >>",
+			name, first+2, font.advance(0), widths.String(), first+4)
+		object("<< /Type /FontDescriptor /FontName /%s /Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 "+
+			"/Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+			name, font.scale(font.bbox[0]), font.scale(font.bbox[1]), font.scale(font.bbox[2]), font.scale(font.bbox[3]),
+			font.scale(font.ascent), font.scale(font.descent), font.scale(font.capHeight), first+3)
+		compressed := deflate(file)
+		object("<< /Length %d /Length1 %d /Filter /FlateDecode >>\nstream\n%s\nendstream", len(compressed), len(file), compressed)
+		compressed = deflate(glyphs)
+		object("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", len(compressed), compressed)
+	}
+	for i, content := range d.pages {
+		object("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), strings.Join(resources, " "), firstPage+2*i+1)
+		object("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.Bytes())
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// num formats a number with at most two decimals.
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+func rgb(c Color) string {
+	return fmt.Sprintf("%s %s %s", num(float64(c.R)/255), num(float64(c.G)/255), num(float64(c.B)/255))
+}
+
+// toUnicode returns the CMap that maps the document's character IDs back to text, so
+// that readers can copy and search it.
+func (d *Document) toUnicode() string {
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// A CMap maps 100 characters at most per block.
+	for chunk := range slices.Chunk(d.runes, 100) {
+		fmt.Fprintf(&b, "%d beginbfchar\n", len(chunk))
+		for _, r := range chunk {
+			fmt.Fprintf(&b, "<%04X> <", d.cids[r])
+			for _, unit := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&b, "%04X", unit)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend")
+	return b.String()
+}
+
+// subsetTag returns the six capital letters that name a font subset, derived from
+// the characters it covers.
+func (d *Document) subsetTag(font string) string {
+	sum := crc32.ChecksumIEEE([]byte(font + string(d.runes)))
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + byte(sum%26)
+		sum /= 26
+	}
+	return string(tag)
+}
+
+func deflate(data []byte) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	// Writes to a bytes.Buffer don't fail.
+	_, _ = w.Write(data)
+	_ = w.Close()
+	return buf.Bytes()
+}
@@ -0,0 +1,182 @@
+package pdf_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Financial-Partner/server/internal/infrastructure/pdf"
+)
+
+func render(t *testing.T, doc *pdf.Document) string {
+	t.Helper()
+	var buf bytes.Buffer
+	n, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	return buf.String()
+}
+
+var (
+	cmapEntry = regexp.MustCompile(`<([0-9A-F]{4})> <([0-9A-F]+)>\n`)
+	drawnText = regexp.MustCompile(`<([0-9A-F]*)> Tj`)
+)
+
+// texts returns the strings drawn in the document, mapped back to Unicode.
+func texts(t *testing.T, out string) []string {
+	t.Helper()
+	chars := make(map[string]string)
+	for _, entry := range cmapEntry.FindAllStringSubmatch(out, -1) {
+		units, err := hex.DecodeString(entry[2])
+		require.NoError(t, err)
+		utf := make([]uint16, len(units)/2)
+		for i := range utf {
+			utf[i] = binary.BigEndian.Uint16(units[2*i:])
+		}
+		chars[entry[1]] = string(utf16.Decode(utf))
+	}
+
+	var drawn []string
+	for _, text := range drawnText.FindAllStringSubmatch(out, -1) {
+		var b strings.Builder
+		for i := 0; i < len(text[1]); i += 4 {
+			b.WriteString(chars[text[1][i:i+4]])
+		}
+		drawn = append(drawn, b.String())
+	}
+	return drawn
+}
+
+// stream returns the decoded content of a stream object.
+func stream(t *testing.T, out string, object string) (dict string, content []byte) {
+	t.Helper()
+	match := regexp.MustCompile(`(?m)^` + object + ` 0 obj\n(<<.*?>>)\nstream\n`).FindStringSubmatchIndex(out)
+	require.NotNil(t, match, "object %s", object)
+	dict = out[match[2]:match[3]]
+	length, err := strconv.Atoi(regexp.MustCompile(`/Length (\d+)`).FindStringSubmatch(dict)[1])
+	require.NoError(t, err)
+	content = []byte(out[match[1] : match[1]+length])
+	if strings.Contains(dict, "/FlateDecode") {
+		r, err := zlib.NewReader(bytes.NewReader(content))
+		require.NoError(t, err)
+		content, err = io.ReadAll(r)
+		require.NoError(t, err)
+	}
+	return dict, content
+}
+
+func TestDocument(t *testing.T) {
+	t.Run("Structure", func(t *testing.T) {
+		doc := pdf.New()
+		doc.AddPage()
+		doc.Text(50, 60, pdf.Bold, 18, pdf.Black, "Finance report")
+		doc.AddPage()
+		doc.Text(50, 60, pdf.Regular, 10, pdf.Gray, "Page 2")
+		assert.Equal(t, 2, doc.PageCount())
+
+		out := render(t, doc)
+		assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+		assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+		assert.Contains(t, out, "/Count 2")
+		assert.Regexp(t, `/BaseFont /[A-Z]{6}\+DejaVuSans-Bold /Encoding /Identity-H`, out)
+		assert.Equal(t, []string{"Finance report", "Page 2"}, texts(t, out))
+
+		// Every cross-reference entry must point at the object it numbers.
+		xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(out)
+		require.Len(t, xref, 2)
+		start, err := strconv.Atoi(xref[1])
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(out[start:], "xref\n0 18\n"))
+
+		entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(out[start:], -1)
+		require.Len(t, entries, 17)
+		for i, entry := range entries {
+			offset, err := strconv.Atoi(entry[1])
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(out[offset:], fmt.Sprintf("%d 0 obj\n", i+1)), "object %d", i+1)
+		}
+	})
+
+	t.Run("Drawing", func(t *testing.T) {
+		doc := pdf.New()
+		doc.Text(50, 60, pdf.Bold, 18, pdf.Black, "Finance")
+		doc.Line(50, 70, 545.28, 70, 0.5, pdf.LightGray)
+		doc.Rect(50, 100, 120, 10, pdf.Color{R: 255, G: 0, B: 0})
+
+		out := render(t, doc)
+		assert.Equal(t, 1, doc.PageCount())
+		assert.Contains(t, out, "BT 0 0 0 rg /F2 18 Tf 50 781.89 Td <0001000200030004000300050006> Tj ET\n")
+		assert.Contains(t, out, "q 0.86 0.86 0.86 RG 0.5 w 50 771.89 m 545.28 771.89 l S Q\n")
+		assert.Contains(t, out, "q 1 0 0 rg 50 731.89 120 10 re f Q\n")
+	})
+
+	t.Run("Encodes any script", func(t *testing.T) {
+		text := `Café (à la carte) \ Продукты Ελλάδα 東京`
+		doc := pdf.New()
+		doc.Text(0, 0, pdf.Regular, 10, pdf.Black, text)
+
+		out := render(t, doc)
+		assert.Equal(t, []string{text}, texts(t, out))
+
+		// The font has glyphs for every character but the Chinese ones.
+		object := regexp.MustCompile(`/CIDToGIDMap (\d+) 0 R`).FindStringSubmatch(out)
+		require.Len(t, object, 2)
+		_, glyphs := stream(t, out, object[1])
+		seen := make(map[rune]bool)
+		cid := 0
+		for _, r := range []rune(text) {
+			if seen[r] {
+				continue
+			}
+			seen[r] = true
+			cid++
+			glyph := binary.BigEndian.Uint16(glyphs[2*cid:])
+			if strings.ContainsRune("東京", r) {
+				assert.Zero(t, glyph, string(r))
+			} else {
+				assert.NotZero(t, glyph, string(r))
+			}
+		}
+	})
+
+	t.Run("Embeds font subsets", func(t *testing.T) {
+		doc := pdf.New()
+		doc.Text(0, 0, pdf.Regular, 10, pdf.Black, "Groceries, Продукты")
+		doc.Text(0, 0, pdf.Bold, 10, pdf.Black, "Total")
+
+		out := render(t, doc)
+		files := regexp.MustCompile(`/FontFile2 (\d+) 0 R`).FindAllStringSubmatch(out, -1)
+		require.Len(t, files, 2)
+		for _, file := range files {
+			dict, font := stream(t, out, file[1])
+			assert.Contains(t, dict, fmt.Sprintf("/Length1 %d", len(font)))
+			// The outlines of a few glyphs out of thousands.
+			assert.Less(t, len(font), 100_000)
+
+			// A TrueType file sums to a fixed value once its head table is adjusted.
+			var sum uint32
+			for i := 0; i < len(font); i += 4 {
+				sum += binary.BigEndian.Uint32(font[i:])
+			}
+			assert.Equal(t, uint32(0xB1B0AFBA), sum)
+		}
+	})
+
+	t.Run("Empty document", func(t *testing.T) {
+		doc := pdf.New()
+
+		out := render(t, doc)
+		assert.Contains(t, out, "/Count 1")
+	})
+}
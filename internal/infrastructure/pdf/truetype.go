@@ -0,0 +1,361 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// trueType is a TrueType font parsed far enough to measure text and embed the glyphs
+// a document uses.
+type trueType struct {
+	tables     map[string][]byte
+	unitsPerEm int
+	numGlyphs  int
+	// bbox, ascent, descent and capHeight are in font units.
+	bbox            [4]int16
+	ascent, descent int16
+	capHeight       int16
+	advances        []uint16
+	glyphOffsets    []uint32
+	glyphs          map[rune]uint16
+}
+
+var errMalformedFont = errors.New("malformed TrueType font")
+
+// parseTrueType reads the tables of a TrueType font.
+func parseTrueType(data []byte) (*trueType, error) {
+	if len(data) < 12 {
+		return nil, errMalformedFont
+	}
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	if len(data) < 12+16*numTables {
+		return nil, errMalformedFont
+	}
+
+	f := &trueType{tables: make(map[string][]byte, numTables)}
+	for i := range numTables {
+		record := data[12+16*i:]
+		offset, length := binary.BigEndian.Uint32(record[8:]), binary.BigEndian.Uint32(record[12:])
+		if uint64(offset)+uint64(length) > uint64(len(data)) {
+			return nil, errMalformedFont
+		}
+		f.tables[string(record[:4])] = data[offset : offset+length]
+	}
+	for _, name := range []string{"head", "hhea", "maxp", "hmtx", "loca", "glyf", "cmap"} {
+		if f.tables[name] == nil {
+			return nil, fmt.Errorf("%w: no %s table", errMalformedFont, name)
+		}
+	}
+
+	head, hhea, maxp := f.tables["head"], f.tables["hhea"], f.tables["maxp"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return nil, errMalformedFont
+	}
+	f.unitsPerEm = int(binary.BigEndian.Uint16(head[18:]))
+	for i := range f.bbox {
+		f.bbox[i] = int16(binary.BigEndian.Uint16(head[36+2*i:]))
+	}
+	f.ascent = int16(binary.BigEndian.Uint16(hhea[4:]))
+	f.descent = int16(binary.BigEndian.Uint16(hhea[6:]))
+	f.numGlyphs = int(binary.BigEndian.Uint16(maxp[4:]))
+	if f.unitsPerEm == 0 || f.numGlyphs == 0 {
+		return nil, errMalformedFont
+	}
+
+	if err := f.parseAdvances(int(binary.BigEndian.Uint16(hhea[34:]))); err != nil {
+		return nil, err
+	}
+	if err := f.parseGlyphOffsets(binary.BigEndian.Uint16(head[50:]) == 1); err != nil {
+		return nil, err
+	}
+	if err := f.parseCmap(); err != nil {
+		return nil, err
+	}
+
+	// The height of H, the usual reference for capitals, as the font has no OS/2
+	// table recent enough to tell it.
+	f.capHeight = f.ascent
+	if glyph := f.glyph(f.glyphs['H']); len(glyph) >= 10 {
+		f.capHeight = int16(binary.BigEndian.Uint16(glyph[8:]))
+	}
+	return f, nil
+}
+
+// parseAdvances reads the advance width of every glyph. Glyphs past the last metric
+// share its advance.
+func (f *trueType) parseAdvances(numMetrics int) error {
+	hmtx := f.tables["hmtx"]
+	if numMetrics == 0 || len(hmtx) < 4*numMetrics {
+		return errMalformedFont
+	}
+	f.advances = make([]uint16, f.numGlyphs)
+	for i := range f.advances {
+		f.advances[i] = binary.BigEndian.Uint16(hmtx[4*min(i, numMetrics-1):])
+	}
+	return nil
+}
+
+func (f *trueType) parseGlyphOffsets(long bool) error {
+	loca, glyf := f.tables["loca"], f.tables["glyf"]
+	f.glyphOffsets = make([]uint32, f.numGlyphs+1)
+	for i := range f.glyphOffsets {
+		switch {
+		case long && len(loca) >= 4*(i+1):
+			f.glyphOffsets[i] = binary.BigEndian.Uint32(loca[4*i:])
+		case !long && len(loca) >= 2*(i+1):
+			f.glyphOffsets[i] = 2 * uint32(binary.BigEndian.Uint16(loca[2*i:]))
+		default:
+			return errMalformedFont
+		}
+		if f.glyphOffsets[i] > uint32(len(glyf)) || i > 0 && f.glyphOffsets[i] < f.glyphOffsets[i-1] {
+			return errMalformedFont
+		}
+	}
+	return nil
+}
+
+// parseCmap reads the glyphs of the characters from the font's Unicode character map,
+// preferring the one covering characters past the Basic Multilingual Plane.
+func (f *trueType) parseCmap() error {
+	cmap := f.tables["cmap"]
+	if len(cmap) < 4 {
+		return errMalformedFont
+	}
+	var bmp, full []byte
+	for i := range int(binary.BigEndian.Uint16(cmap[2:])) {
+		record := cmap[4+8*i:]
+		if len(record) < 8 {
+			return errMalformedFont
+		}
+		platform, encoding := binary.BigEndian.Uint16(record), binary.BigEndian.Uint16(record[2:])
+		offset := binary.BigEndian.Uint32(record[4:])
+		if offset >= uint32(len(cmap)) {
+			return errMalformedFont
+		}
+		switch {
+		case platform == 3 && encoding == 10:
+			full = cmap[offset:]
+		case platform == 3 && encoding == 1:
+			bmp = cmap[offset:]
+		}
+	}
+
+	f.glyphs = make(map[rune]uint16)
+	switch {
+	case full != nil:
+		return f.parseCmapFormat12(full)
+	case bmp != nil:
+		return f.parseCmapFormat4(bmp)
+	}
+	return fmt.Errorf("%w: no Unicode character map", errMalformedFont)
+}
+
+func (f *trueType) parseCmapFormat4(subtable []byte) error {
+	if len(subtable) < 14 || binary.BigEndian.Uint16(subtable) != 4 {
+		return errMalformedFont
+	}
+	segments := int(binary.BigEndian.Uint16(subtable[6:])) / 2
+	ends := 14
+	starts := ends + 2*segments + 2
+	deltas := starts + 2*segments
+	rangeOffsets := deltas + 2*segments
+	if len(subtable) < rangeOffsets+2*segments {
+		return errMalformedFont
+	}
+
+	for i := range segments {
+		end := binary.BigEndian.Uint16(subtable[ends+2*i:])
+		start := binary.BigEndian.Uint16(subtable[starts+2*i:])
+		delta := binary.BigEndian.Uint16(subtable[deltas+2*i:])
+		rangeOffset := int(binary.BigEndian.Uint16(subtable[rangeOffsets+2*i:]))
+		for c := int(start); c <= int(end) && c != 0xFFFF; c++ {
+			glyph := uint16(c) + delta
+			if rangeOffset != 0 {
+				at := rangeOffsets + 2*i + rangeOffset + 2*(c-int(start))
+				if at+2 > len(subtable) {
+					return errMalformedFont
+				}
+				if glyph = binary.BigEndian.Uint16(subtable[at:]); glyph != 0 {
+					glyph += delta
+				}
+			}
+			if glyph != 0 && int(glyph) < f.numGlyphs {
+				f.glyphs[rune(c)] = glyph
+			}
+		}
+	}
+	return nil
+}
+
+func (f *trueType) parseCmapFormat12(subtable []byte) error {
+	if len(subtable) < 16 || binary.BigEndian.Uint16(subtable) != 12 {
+		return errMalformedFont
+	}
+	groups := int(binary.BigEndian.Uint32(subtable[12:]))
+	if len(subtable) < 16+12*groups {
+		return errMalformedFont
+	}
+
+	for i := range groups {
+		group := subtable[16+12*i:]
+		start, end := binary.BigEndian.Uint32(group), binary.BigEndian.Uint32(group[4:])
+		glyph := binary.BigEndian.Uint32(group[8:])
+		for c := start; c <= end && c <= 0x10FFFF; c++ {
+			if id := glyph + c - start; id != 0 && id < uint32(f.numGlyphs) {
+				f.glyphs[rune(c)] = uint16(id)
+			}
+		}
+	}
+	return nil
+}
+
+// glyphID returns the glyph that draws r, or the missing-character glyph 0.
+func (f *trueType) glyphID(r rune) uint16 {
+	return f.glyphs[r]
+}
+
+// advance returns the advance width of a glyph in thousandths of the font size.
+func (f *trueType) advance(glyph uint16) int {
+	return int(f.advances[glyph]) * 1000 / f.unitsPerEm
+}
+
+// scale converts font units into thousandths of the font size.
+func (f *trueType) scale(v int16) int {
+	return int(v) * 1000 / f.unitsPerEm
+}
+
+func (f *trueType) glyph(id uint16) []byte {
+	return f.tables["glyf"][f.glyphOffsets[id]:f.glyphOffsets[id+1]]
+}
+
+// Composite glyph flags.
+const (
+	argsAreWords   = 0x0001
+	hasScale       = 0x0008
+	moreComponents = 0x0020
+	hasXYScale     = 0x0040
+	hasTwoByTwo    = 0x0080
+)
+
+// components returns the glyphs a composite glyph is made of.
+func components(glyph []byte) []uint16 {
+	if len(glyph) < 10 || int16(binary.BigEndian.Uint16(glyph)) >= 0 {
+		return nil
+	}
+
+	var ids []uint16
+	for at := 10; at+4 <= len(glyph); {
+		flags := binary.BigEndian.Uint16(glyph[at:])
+		ids = append(ids, binary.BigEndian.Uint16(glyph[at+2:]))
+		at += 4
+		if flags&argsAreWords != 0 {
+			at += 4
+		} else {
+			at += 2
+		}
+		switch {
+		case flags&hasScale != 0:
+			at += 2
+		case flags&hasXYScale != 0:
+			at += 4
+		case flags&hasTwoByTwo != 0:
+			at += 8
+		}
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+	return ids
+}
+
+// subsetTables are the tables a PDF reader needs to draw a TrueType font's glyphs.
+var subsetTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// subset returns a font file that only has the outlines of the used glyphs, along
+// with the glyphs they are composed of. Glyphs keep their IDs, the others being empty.
+func (f *trueType) subset(used map[uint16]bool) []byte {
+	keep := map[uint16]bool{0: true}
+	pending := []uint16{0}
+	for id := range used {
+		pending = append(pending, id)
+	}
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		keep[id] = true
+		for _, component := range components(f.glyph(id)) {
+			if !keep[component] && int(component) < f.numGlyphs {
+				pending = append(pending, component)
+			}
+		}
+	}
+
+	var glyf []byte
+	loca := make([]byte, 4*(f.numGlyphs+1))
+	for id := range f.numGlyphs {
+		binary.BigEndian.PutUint32(loca[4*id:], uint32(len(glyf)))
+		if keep[uint16(id)] {
+			glyf = append(glyf, f.glyph(uint16(id))...)
+			glyf = append(glyf, make([]byte, -len(glyf)&3)...)
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*f.numGlyphs:], uint32(len(glyf)))
+
+	// The head table is rewritten for the long loca offsets, with a checksum
+	// adjustment computed once the file is complete.
+	head := append([]byte(nil), f.tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)
+	binary.BigEndian.PutUint16(head[50:], 1)
+
+	tables := map[string][]byte{"glyf": glyf, "loca": loca, "head": head}
+	var names []string
+	for _, name := range subsetTables {
+		if tables[name] == nil {
+			tables[name] = f.tables[name]
+		}
+		if tables[name] != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	out := make([]byte, 12+16*len(names))
+	binary.BigEndian.PutUint32(out, 0x00010000)
+	binary.BigEndian.PutUint16(out[4:], uint16(len(names)))
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= len(names) {
+		entrySelector++
+	}
+	binary.BigEndian.PutUint16(out[6:], uint16(16<<entrySelector))
+	binary.BigEndian.PutUint16(out[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(out[10:], uint16(16*len(names)-16<<entrySelector))
+
+	var headOffset int
+	for i, name := range names {
+		if name == "head" {
+			headOffset = len(out)
+		}
+		record := out[12+16*i:]
+		copy(record, name)
+		binary.BigEndian.PutUint32(record[4:], checksum(tables[name]))
+		binary.BigEndian.PutUint32(record[8:], uint32(len(out)))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(tables[name])))
+		out = append(out, tables[name]...)
+		out = append(out, make([]byte, -len(out)&3)...)
+	}
+	binary.BigEndian.PutUint32(out[headOffset+8:], 0xB1B0AFBA-checksum(out))
+	return out
+}
+
+// checksum sums the table as big-endian 32-bit words, padding it with zeros.
+func checksum(table []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(table); i += 4 {
+		var word [4]byte
+		copy(word[:], table[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
@@ -0,0 +1,116 @@
+package file
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	exchange_repository "github.com/Financial-Partner/server/internal/module/exchange/repository"
+)
+
+// ExchangeRateRepository serves exchange rates from a CSV file read at startup, for
+// deployments without access to a rate source. The file has a header row and the
+// columns date (YYYY-MM-DD), base, quote and rate.
+type ExchangeRateRepository struct {
+	// rates holds the rates of each currency pair, oldest first.
+	rates map[string][]entities.ExchangeRate
+}
+
+func NewExchangeRateRepository(path string) (exchange_repository.Repository, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open exchange rates: %w", err)
+	}
+	defer f.Close()
+
+	repo, err := readExchangeRates(f)
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func readExchangeRates(r io.Reader) (*ExchangeRateRepository, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	if _, err := reader.Read(); err != nil {
+		return nil, fmt.Errorf("failed to read exchange rates header: %w", err)
+	}
+
+	repo := &ExchangeRateRepository{rates: make(map[string][]entities.ExchangeRate)}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read exchange rates: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rate, err := parseExchangeRate(record)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate on line %d: %w", line, err)
+		}
+		key := pairKey(rate.Base, rate.Quote)
+		repo.rates[key] = append(repo.rates[key], rate)
+	}
+
+	for _, rates := range repo.rates {
+		slices.SortStableFunc(rates, func(a, b entities.ExchangeRate) int {
+			return a.Date.Compare(b.Date)
+		})
+	}
+	return repo, nil
+}
+
+func (r *ExchangeRateRepository) FindRate(_ context.Context, base, quote string, on time.Time) (*entities.ExchangeRate, error) {
+	rates := r.rates[pairKey(base, quote)]
+	// The first rate dated after on follows the one in effect on it.
+	i, _ := slices.BinarySearchFunc(rates, on, func(rate entities.ExchangeRate, on time.Time) int {
+		if rate.Date.After(on) {
+			return 1
+		}
+		return -1
+	})
+	if i == 0 {
+		return nil, exchange_domain.ErrRateNotFound
+	}
+	rate := rates[i-1]
+	return &rate, nil
+}
+
+func parseExchangeRate(record []string) (entities.ExchangeRate, error) {
+	date, err := time.Parse(time.DateOnly, record[0])
+	if err != nil {
+		return entities.ExchangeRate{}, fmt.Errorf("date must be formatted as YYYY-MM-DD: %w", err)
+	}
+
+	base, quote := strings.ToUpper(record[1]), strings.ToUpper(record[2])
+	if !entities.IsCurrency(base) || !entities.IsCurrency(quote) {
+		return entities.ExchangeRate{}, entities.ErrInvalidCurrency
+	}
+
+	rate, err := strconv.ParseFloat(record[3], 64)
+	if err != nil || rate <= 0 || math.IsInf(rate, 0) {
+		return entities.ExchangeRate{}, fmt.Errorf("rate must be a positive number, got %q", record[3])
+	}
+
+	return entities.ExchangeRate{Base: base, Quote: quote, Rate: rate, Date: date}, nil
+}
+
+func pairKey(base, quote string) string {
+	return base + "/" + quote
+}
@@ -0,0 +1,87 @@
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/file"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+)
+
+func writeRates(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "exchange_rates.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestExchangeRateRepository(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	path := writeRates(t, `date,base,quote,rate
+2025-03-10,EUR,USD,1.10
+2025-03-01, eur, usd, 1.05
+2025-03-01,USD,JPY,150
+`)
+	repo, err := file.NewExchangeRateRepository(path)
+	require.NoError(t, err)
+
+	t.Run("Returns the latest rate on or before the day", func(t *testing.T) {
+		for on, want := range map[time.Time]float64{
+			day(1):                 1.05,
+			day(9).Add(time.Hour):  1.05,
+			day(10):                1.10,
+			day(31).Add(time.Hour): 1.10,
+		} {
+			rate, err := repo.FindRate(context.Background(), "EUR", "USD", on)
+			require.NoError(t, err)
+			assert.Equal(t, want, rate.Rate, on)
+		}
+
+		rate, err := repo.FindRate(context.Background(), "USD", "JPY", day(5))
+		require.NoError(t, err)
+		assert.Equal(t, entities.ExchangeRate{Base: "USD", Quote: "JPY", Rate: 150, Date: day(1)}, *rate)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		for _, pair := range [][2]string{{"EUR", "GBP"}, {"USD", "EUR"}} {
+			rate, err := repo.FindRate(context.Background(), pair[0], pair[1], day(5))
+			assert.ErrorIs(t, err, exchange_domain.ErrRateNotFound)
+			assert.Nil(t, rate)
+		}
+
+		rate, err := repo.FindRate(context.Background(), "EUR", "USD", day(1).Add(-time.Second))
+		assert.ErrorIs(t, err, exchange_domain.ErrRateNotFound)
+		assert.Nil(t, rate)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		repo, err := file.NewExchangeRateRepository(filepath.Join(t.TempDir(), "missing.csv"))
+		assert.Error(t, err)
+		assert.Nil(t, repo)
+	})
+
+	invalidFiles := map[string]string{
+		"Empty file":       "",
+		"Missing column":   "date,base,quote,rate\n2025-03-01,EUR,USD\n",
+		"Malformed date":   "date,base,quote,rate\n03/01/2025,EUR,USD,1.05\n",
+		"Unknown currency": "date,base,quote,rate\n2025-03-01,EUR,XYZ,1.05\n",
+		"Malformed rate":   "date,base,quote,rate\n2025-03-01,EUR,USD,one\n",
+		"Zero rate":        "date,base,quote,rate\n2025-03-01,EUR,USD,0\n",
+	}
+	for name, content := range invalidFiles {
+		t.Run(name, func(t *testing.T) {
+			repo, err := file.NewExchangeRateRepository(writeRates(t, content))
+			assert.Error(t, err)
+			assert.Nil(t, repo)
+		})
+	}
+}
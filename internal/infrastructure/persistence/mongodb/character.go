@@ -0,0 +1,58 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
+)
+
+type MongoCharacterRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCharacterRepository(db MongoClient) user_repository.CharacterRepository {
+	return &MongoCharacterRepository{
+		collection: db.Collection("characters"),
+	}
+}
+
+func (r *MongoCharacterRepository) FindById(ctx context.Context, id string) (*entities.Character, error) {
+	var entity entities.Character
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// FindByIds returns the catalog entries of the given characters, sorted by name.
+// IDs missing from the catalog are skipped.
+func (r *MongoCharacterRepository) FindByIds(ctx context.Context, ids []string) ([]entities.Character, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	var characters []entities.Character
+	cursor, err := r.collection.Find(ctx, bson.M{"id": bson.M{"$in": ids}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &characters); err != nil {
+		return nil, err
+	}
+
+	return characters, nil
+}
+
+func (r *MongoCharacterRepository) Create(ctx context.Context, character *entities.Character) (*entities.Character, error) {
+	_, err := r.collection.InsertOne(ctx, character)
+	if err != nil {
+		return nil, err
+	}
+	return character, nil
+}
@@ -0,0 +1,89 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestMongoCharacterRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	testCharacter := entities.Character{
+		ID:       "char_001",
+		Name:     "Financial Assistant",
+		ImageURL: "https://example.com/char_001.png",
+	}
+	testCharacterBSON, err := bson.Marshal(testCharacter)
+	require.NoError(t, err)
+	var testCharacterDoc bson.D
+	err = bson.Unmarshal(testCharacterBSON, &testCharacterDoc)
+	require.NoError(t, err)
+
+	t.Run("FindById", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testCharacterDoc))
+			repo := mongodb.NewCharacterRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), testCharacter.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, &testCharacter, result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewCharacterRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), "char_404")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+	t.Run("FindByIds", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testCharacterDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewCharacterRepository(mt.DB)
+			result, err := repo.FindByIds(context.Background(), []string{testCharacter.ID})
+			assert.NoError(t, err)
+			assert.Equal(t, []entities.Character{testCharacter}, result)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "database error",
+			}))
+			repo := mongodb.NewCharacterRepository(mt.DB)
+			result, err := repo.FindByIds(context.Background(), []string{testCharacter.ID})
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+	t.Run("Create", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse())
+			repo := mongodb.NewCharacterRepository(mt.DB)
+			character := testCharacter
+			result, err := repo.Create(context.Background(), &character)
+			assert.NoError(t, err)
+			assert.Equal(t, &testCharacter, result)
+		})
+		mt.Run("duplicate", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+				Index:   0,
+				Code:    11000,
+				Message: "duplicate key error",
+			}))
+			repo := mongodb.NewCharacterRepository(mt.DB)
+			character := testCharacter
+			result, err := repo.Create(context.Background(), &character)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+}
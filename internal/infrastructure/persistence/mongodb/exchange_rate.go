@@ -0,0 +1,40 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	exchange_repository "github.com/Financial-Partner/server/internal/module/exchange/repository"
+)
+
+type MongoExchangeRateRepository struct {
+	collection *mongo.Collection
+}
+
+func NewExchangeRateRepository(db MongoClient) exchange_repository.Repository {
+	return &MongoExchangeRateRepository{
+		collection: db.Collection("exchange_rates"),
+	}
+}
+
+func (r *MongoExchangeRateRepository) FindRate(ctx context.Context, base, quote string, on time.Time) (*entities.ExchangeRate, error) {
+	filter := bson.M{"base": base, "quote": quote, "date": bson.M{"$lte": on}}
+	opts := options.FindOne().SetSort(bson.D{{Key: "date", Value: -1}})
+
+	var rate entities.ExchangeRate
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&rate)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, exchange_domain.ErrRateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
@@ -0,0 +1,69 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+)
+
+func TestMongoExchangeRateRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	on := time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC)
+	testRate := entities.ExchangeRate{
+		ID:    primitive.NewObjectID(),
+		Base:  "EUR",
+		Quote: "USD",
+		Rate:  1.08,
+		Date:  time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	rateBSON, err := bson.Marshal(testRate)
+	require.NoError(t, err)
+	var rateDoc bson.D
+	require.NoError(t, bson.Unmarshal(rateBSON, &rateDoc))
+
+	t.Run("FindRate", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, rateDoc))
+
+			repo := mongodb.NewExchangeRateRepository(mt.DB)
+			result, err := repo.FindRate(context.Background(), "EUR", "USD", on)
+			require.NoError(t, err)
+			assert.Equal(t, &testRate, result)
+
+			// The latest rate dated on or before the day is used.
+			filter := mt.GetStartedEvent().Command.Lookup("filter").Document()
+			assert.Equal(t, "EUR", filter.Lookup("base").StringValue())
+			assert.Equal(t, "USD", filter.Lookup("quote").StringValue())
+			assert.Equal(t, on, filter.Lookup("date", "$lte").Time().UTC())
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+
+			repo := mongodb.NewExchangeRateRepository(mt.DB)
+			result, err := repo.FindRate(context.Background(), "EUR", "USD", on)
+			assert.ErrorIs(t, err, exchange_domain.ErrRateNotFound)
+			assert.Nil(t, result)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "Database error"}))
+
+			repo := mongodb.NewExchangeRateRepository(mt.DB)
+			result, err := repo.FindRate(context.Background(), "EUR", "USD", on)
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, exchange_domain.ErrRateNotFound)
+			assert.Nil(t, result)
+		})
+	})
+}
@@ -0,0 +1,149 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	gacha_repository "github.com/Financial-Partner/server/internal/module/gacha/repository"
+)
+
+type MongoGachaRepository struct {
+	pools     *mongo.Collection
+	inventory *mongo.Collection
+	pity      *mongo.Collection
+	shards    *mongo.Collection
+}
+
+func NewGachaRepository(db MongoClient) gacha_repository.Repository {
+	return &MongoGachaRepository{
+		pools:     db.Collection("gacha_pools"),
+		inventory: db.Collection("gacha_inventory"),
+		pity:      db.Collection("gacha_pity"),
+		shards:    db.Collection("gacha_shards"),
+	}
+}
+
+func (r *MongoGachaRepository) FindPoolById(ctx context.Context, poolID primitive.ObjectID) (*entities.GachaPool, error) {
+	var pool entities.GachaPool
+	err := r.pools.FindOne(ctx, bson.M{"_id": poolID}).Decode(&pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// FindLatestPool returns the most recently created pool, which is the one drawn
+// from when no pool is given.
+func (r *MongoGachaRepository) FindLatestPool(ctx context.Context) (*entities.GachaPool, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var pool entities.GachaPool
+	err := r.pools.FindOne(ctx, bson.M{}, opts).Decode(&pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// CreatePool stores a new pool, giving it and each of its items an ID.
+func (r *MongoGachaRepository) CreatePool(ctx context.Context, pool *entities.GachaPool) (*entities.GachaPool, error) {
+	pool.ID = primitive.NewObjectID()
+	for i := range pool.Items {
+		pool.Items[i].ID = primitive.NewObjectID()
+	}
+	_, err := r.pools.InsertOne(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (r *MongoGachaRepository) CreateInventoryItem(ctx context.Context, item *entities.GachaInventoryItem) (*entities.GachaInventoryItem, error) {
+	item.ID = primitive.NewObjectID()
+	_, err := r.inventory.InsertOne(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// FindInventoryByUserId lists the user's drawn items, newest first.
+func (r *MongoGachaRepository) FindInventoryByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.GachaInventoryItem, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "drawn_at", Value: -1}})
+
+	var items []entities.GachaInventoryItem
+	cursor, err := r.inventory.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// FindOwnedGachaIds returns which of the given items the user already has in their inventory.
+func (r *MongoGachaRepository) FindOwnedGachaIds(ctx context.Context, userID primitive.ObjectID, gachaIDs []primitive.ObjectID) ([]primitive.ObjectID, error) {
+	filter := bson.M{"user_id": userID, "gacha_id": bson.M{"$in": gachaIDs}}
+	values, err := r.inventory.Distinct(ctx, "gacha_id", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make([]primitive.ObjectID, 0, len(values))
+	for _, value := range values {
+		if id, ok := value.(primitive.ObjectID); ok {
+			owned = append(owned, id)
+		}
+	}
+	return owned, nil
+}
+
+func (r *MongoGachaRepository) FindPity(ctx context.Context, userID, poolID primitive.ObjectID) (*entities.GachaPity, error) {
+	var pity entities.GachaPity
+	err := r.pity.FindOne(ctx, bson.M{"user_id": userID, "pool_id": poolID}).Decode(&pity)
+	if err != nil {
+		return nil, err
+	}
+	return &pity, nil
+}
+
+// SavePity stores the user's pity count for the pool, creating the counter on first use.
+func (r *MongoGachaRepository) SavePity(ctx context.Context, pity *entities.GachaPity) error {
+	pity.UpdatedAt = time.Now().UTC()
+	filter := bson.M{"user_id": pity.UserID, "pool_id": pity.PoolID}
+	update := bson.M{"$set": bson.M{
+		"count":      pity.Count,
+		"updated_at": pity.UpdatedAt,
+	}}
+	_, err := r.pity.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *MongoGachaRepository) FindShards(ctx context.Context, userID primitive.ObjectID) (*entities.GachaShards, error) {
+	var shards entities.GachaShards
+	err := r.shards.FindOne(ctx, bson.M{"user_id": userID}).Decode(&shards)
+	if err != nil {
+		return nil, err
+	}
+	return &shards, nil
+}
+
+// AddShards atomically increases the user's shard balance, creating it on first use.
+func (r *MongoGachaRepository) AddShards(ctx context.Context, userID primitive.ObjectID, amount int64) error {
+	update := bson.M{
+		"$inc": bson.M{"balance": amount},
+		"$set": bson.M{"updated_at": time.Now().UTC()},
+	}
+	_, err := r.shards.UpdateOne(ctx, bson.M{"user_id": userID}, update, options.Update().SetUpsert(true))
+	return err
+}
@@ -0,0 +1,280 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestMongoGachaRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	testUserID := primitive.NewObjectID()
+	testPool := entities.GachaPool{
+		ID:   primitive.NewObjectID(),
+		Name: "Starter pool",
+		Cost: 100,
+		Items: []entities.Gacha{
+			{ID: primitive.NewObjectID(), Name: "Piggy bank", ImgSrc: "https://example.com/piggy.png", Rarity: entities.GachaRarityCommon, Weight: 90},
+			{ID: primitive.NewObjectID(), Name: "Golden coin", ImgSrc: "https://example.com/coin.png", Rarity: entities.GachaRarityLegendary, Weight: 10},
+		},
+		CreatedAt: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	testItem := entities.GachaInventoryItem{
+		ID:      primitive.NewObjectID(),
+		UserID:  testUserID,
+		PoolID:  testPool.ID,
+		GachaID: testPool.Items[0].ID,
+		Name:    testPool.Items[0].Name,
+		ImgSrc:  testPool.Items[0].ImgSrc,
+		Rarity:  testPool.Items[0].Rarity,
+		DrawnAt: time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testPoolBSON, err := bson.Marshal(testPool)
+	require.NoError(t, err)
+	var testPoolDoc bson.D
+	err = bson.Unmarshal(testPoolBSON, &testPoolDoc)
+	require.NoError(t, err)
+
+	testPity := entities.GachaPity{
+		UserID:    testUserID,
+		PoolID:    testPool.ID,
+		Count:     3,
+		UpdatedAt: time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testPityBSON, err := bson.Marshal(testPity)
+	require.NoError(t, err)
+	var testPityDoc bson.D
+	err = bson.Unmarshal(testPityBSON, &testPityDoc)
+	require.NoError(t, err)
+
+	testItemBSON, err := bson.Marshal(testItem)
+	require.NoError(t, err)
+	var testItemDoc bson.D
+	err = bson.Unmarshal(testItemBSON, &testItemDoc)
+	require.NoError(t, err)
+
+	t.Run("FindPoolById", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testPoolDoc))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindPoolById(context.Background(), testPool.ID)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, testPool, *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindPoolById(context.Background(), testPool.ID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindLatestPool", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testPoolDoc))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindLatestPool(context.Background())
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, testPool, *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindLatestPool(context.Background())
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("CreatePool", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse())
+			repo := mongodb.NewGachaRepository(mt.DB)
+			pool := testPool
+			pool.ID = primitive.NilObjectID
+			pool.Items = []entities.Gacha{{Name: "Piggy bank", Rarity: entities.GachaRarityCommon, Weight: 1}}
+			result, err := repo.CreatePool(context.Background(), &pool)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.False(t, result.ID.IsZero())
+			assert.False(t, result.Items[0].ID.IsZero())
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "duplicate key error",
+			}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			pool := testPool
+			pool.Items = nil
+			result, err := repo.CreatePool(context.Background(), &pool)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("CreateInventoryItem", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse())
+			repo := mongodb.NewGachaRepository(mt.DB)
+			item := testItem
+			item.ID = primitive.NilObjectID
+			result, err := repo.CreateInventoryItem(context.Background(), &item)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.False(t, result.ID.IsZero())
+			assert.Equal(t, testItem.GachaID, result.GachaID)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "duplicate key error",
+			}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			item := testItem
+			result, err := repo.CreateInventoryItem(context.Background(), &item)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindInventoryByUserId", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testItemDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindInventoryByUserId(context.Background(), testUserID)
+			assert.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, testItem, result[0])
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "database error",
+			}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindInventoryByUserId(context.Background(), testUserID)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindPity", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testPityDoc))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindPity(context.Background(), testUserID, testPool.ID)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, testPity, *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindPity(context.Background(), testUserID, testPool.ID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("SavePity", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			pity := testPity
+			err := repo.SavePity(context.Background(), &pity)
+			assert.NoError(t, err)
+			assert.True(t, pity.UpdatedAt.After(testPity.UpdatedAt))
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "update error",
+			}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			pity := testPity
+			err := repo.SavePity(context.Background(), &pity)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("FindOwnedGachaIds", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "values", Value: bson.A{testItem.GachaID}}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindOwnedGachaIds(context.Background(), testUserID, []primitive.ObjectID{testItem.GachaID, testPool.Items[1].ID})
+			assert.NoError(t, err)
+			assert.Equal(t, []primitive.ObjectID{testItem.GachaID}, result)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "database error",
+			}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindOwnedGachaIds(context.Background(), testUserID, []primitive.ObjectID{testItem.GachaID})
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindShards", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			shards := entities.GachaShards{UserID: testUserID, Balance: 25, UpdatedAt: time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)}
+			shardsBSON, err := bson.Marshal(shards)
+			require.NoError(t, err)
+			var shardsDoc bson.D
+			require.NoError(t, bson.Unmarshal(shardsBSON, &shardsDoc))
+
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, shardsDoc))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindShards(context.Background(), testUserID)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, shards, *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			result, err := repo.FindShards(context.Background(), testUserID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("AddShards", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			err := repo.AddShards(context.Background(), testUserID, 5)
+			assert.NoError(t, err)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "update error",
+			}))
+			repo := mongodb.NewGachaRepository(mt.DB)
+			err := repo.AddShards(context.Background(), testUserID, 5)
+			assert.Error(t, err)
+		})
+	})
+}
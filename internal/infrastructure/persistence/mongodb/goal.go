@@ -0,0 +1,247 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	goal_repository "github.com/Financial-Partner/server/internal/module/goal/repository"
+)
+
+type MongoGoalRepository struct {
+	collection *mongo.Collection
+	rewards    *mongo.Collection
+}
+
+func NewGoalRepository(db MongoClient) goal_repository.Repository {
+	return &MongoGoalRepository{
+		collection: db.Collection("goals"),
+		rewards:    db.Collection("goal_rewards"),
+	}
+}
+
+func (r *MongoGoalRepository) Create(ctx context.Context, entity *entities.Goal) (*entities.Goal, error) {
+	entity.ID = primitive.NewObjectID()
+	_, err := r.collection.InsertOne(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// Update saves the goal's settings and status. The saved amount is left untouched; it
+// only changes through AddContribution and RemoveContributions, so a concurrent
+// contribution is never overwritten. Milestones only change through CompleteMilestone
+// so a reward is never paid twice. The currency of the saved amount changes only while
+// nothing is saved; if a contribution was saved in the old currency meanwhile, the
+// update fails with mongo.ErrNoDocuments.
+func (r *MongoGoalRepository) Update(ctx context.Context, entity *entities.Goal) error {
+	entity.UpdatedAt = time.Now().UTC()
+	filter := bson.M{
+		"_id":     entity.ID,
+		"user_id": entity.UserID,
+		"$or": bson.A{
+			bson.M{"current_amount.currency": entity.CurrentAmount.Currency},
+			bson.M{"current_amount.amount": 0},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"name":                    entity.Name,
+		"target_amount":           entity.TargetAmount,
+		"current_amount.currency": entity.CurrentAmount.Currency,
+		"period":                  entity.Period,
+		"priority":                entity.Priority,
+		"allocation_percent":      entity.AllocationPercent,
+		"status":                  entity.Status,
+		"updated_at":              entity.UpdatedAt,
+	}}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoGoalRepository) Delete(ctx context.Context, userID, goalID primitive.ObjectID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": goalID, "user_id": userID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoGoalRepository) FindById(ctx context.Context, userID, goalID primitive.ObjectID) (*entities.Goal, error) {
+	var entity entities.Goal
+	err := r.collection.FindOne(ctx, bson.M{"_id": goalID, "user_id": userID}).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// FindByUserId lists the user's goals in funding order. An empty status lists goals of every status.
+func (r *MongoGoalRepository) FindByUserId(ctx context.Context, userID primitive.ObjectID, status string) ([]entities.Goal, error) {
+	filter := bson.M{"user_id": userID}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "priority", Value: 1}, {Key: "created_at", Value: 1}})
+
+	var goals []entities.Goal
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &goals); err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
+
+// AddContribution atomically increases the goal's current amount by a share of the
+// transaction, records the contribution and returns the updated goal. The update is
+// skipped, yielding mongo.ErrNoDocuments, if the goal is saved for in another currency.
+func (r *MongoGoalRepository) AddContribution(ctx context.Context, goalID, transactionID primitive.ObjectID, amount entities.Money) (*entities.Goal, error) {
+	filter := bson.M{"_id": goalID, "current_amount.currency": amount.Currency}
+	update := bson.M{
+		"$inc":  bson.M{"current_amount.amount": amount.Amount},
+		"$push": bson.M{"contributions": entities.GoalContribution{TransactionID: transactionID, Amount: amount}},
+		"$set":  bson.M{"updated_at": time.Now().UTC()},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var entity entities.Goal
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// RemoveContributions takes back what the transaction contributed to the user's goals
+// and returns the goals it had contributed to, updated. A goal whose contributions
+// were removed concurrently is left out.
+func (r *MongoGoalRepository) RemoveContributions(ctx context.Context, userID, transactionID primitive.ObjectID) ([]entities.Goal, error) {
+	filter := bson.M{"user_id": userID, "contributions.transaction_id": transactionID}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var goals []entities.Goal
+	if err := cursor.All(ctx, &goals); err != nil {
+		return nil, err
+	}
+
+	updated := make([]entities.Goal, 0, len(goals))
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	for _, goal := range goals {
+		var amount int64
+		for _, contribution := range goal.Contributions {
+			if contribution.TransactionID == transactionID {
+				amount += contribution.Amount.Amount
+			}
+		}
+
+		update := bson.M{
+			"$inc":  bson.M{"current_amount.amount": -amount},
+			"$pull": bson.M{"contributions": bson.M{"transaction_id": transactionID}},
+			"$set":  bson.M{"updated_at": time.Now().UTC()},
+		}
+		var entity entities.Goal
+		err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": goal.ID, "contributions.transaction_id": transactionID}, update, opts).Decode(&entity)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		updated = append(updated, entity)
+	}
+
+	return updated, nil
+}
+
+// CompleteMilestone marks the milestone at index as completed. It reports false when
+// the milestone had already been completed, e.g. by a concurrent update.
+func (r *MongoGoalRepository) CompleteMilestone(ctx context.Context, goalID primitive.ObjectID, index int, completedAt time.Time, rewardWithheld bool) (bool, error) {
+	prefix := fmt.Sprintf("milestones.%d.", index)
+	filter := bson.M{"_id": goalID, prefix + "is_completed": false}
+	update := bson.M{"$set": bson.M{
+		prefix + "is_completed":    true,
+		prefix + "completed_at":    completedAt,
+		prefix + "reward_withheld": rewardWithheld,
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// ReopenMilestone marks the completed milestone at index as not completed. It reports
+// false when the milestone wasn't completed, e.g. because a concurrent update reopened it.
+func (r *MongoGoalRepository) ReopenMilestone(ctx context.Context, goalID primitive.ObjectID, index int) (bool, error) {
+	prefix := fmt.Sprintf("milestones.%d.", index)
+	filter := bson.M{"_id": goalID, prefix + "is_completed": true}
+	update := bson.M{"$set": bson.M{
+		prefix + "is_completed":    false,
+		prefix + "completed_at":    nil,
+		prefix + "reward_withheld": false,
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// milestoneRewardKey identifies the reward of the user's milestones at a target percent
+// in the UTC month of completedAt.
+func milestoneRewardKey(userID primitive.ObjectID, targetPercent int, completedAt time.Time) bson.D {
+	return bson.D{
+		{Key: "user_id", Value: userID},
+		{Key: "target_percent", Value: targetPercent},
+		{Key: "month", Value: completedAt.UTC().Format("2006-01")},
+	}
+}
+
+// ClaimMilestoneReward records that the user earned the reward of a milestone at the
+// target percent in the month of completedAt. It reports false when the reward was
+// already claimed that month, by this goal or another.
+func (r *MongoGoalRepository) ClaimMilestoneReward(ctx context.Context, userID primitive.ObjectID, targetPercent int, completedAt time.Time) (bool, error) {
+	filter := bson.M{"_id": milestoneRewardKey(userID, targetPercent, completedAt)}
+	update := bson.M{"$setOnInsert": bson.M{"claimed_at": completedAt}}
+
+	result, err := r.rewards.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+	return result.UpsertedCount == 1, nil
+}
+
+// ReleaseMilestoneReward deletes the claim of a reward taken back, so that it can be
+// earned again.
+func (r *MongoGoalRepository) ReleaseMilestoneReward(ctx context.Context, userID primitive.ObjectID, targetPercent int, completedAt time.Time) error {
+	_, err := r.rewards.DeleteOne(ctx, bson.M{"_id": milestoneRewardKey(userID, targetPercent, completedAt)})
+	return err
+}
@@ -0,0 +1,349 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestMongoGoalRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	testUserID := primitive.NewObjectID()
+	testGoal := entities.Goal{
+		ID:                primitive.NewObjectID(),
+		UserID:            testUserID,
+		Name:              "Emergency fund",
+		TargetAmount:      entities.Money{Amount: 10000, Currency: "USD"},
+		CurrentAmount:     entities.Money{Amount: 2500, Currency: "USD"},
+		Period:            30,
+		Priority:          1,
+		AllocationPercent: 20,
+		Status:            entities.GoalStatusActive,
+		CreatedAt:         time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:         time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testGoalBSON, err := bson.Marshal(testGoal)
+	require.NoError(t, err)
+	var testGoalDoc bson.D
+	err = bson.Unmarshal(testGoalBSON, &testGoalDoc)
+	require.NoError(t, err)
+
+	t.Run("FindById", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testGoalDoc))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), testUserID, testGoal.ID)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, testGoal, *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), testUserID, testGoal.ID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindByUserId", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testGoalDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.FindByUserId(context.Background(), testUserID, entities.GoalStatusActive)
+			assert.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, testGoal, result[0])
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "database error",
+			}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.FindByUserId(context.Background(), testUserID, "")
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			err := repo.Delete(context.Background(), testUserID, testGoal.ID)
+			assert.NoError(t, err)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			err := repo.Delete(context.Background(), testUserID, testGoal.ID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "delete error",
+			}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			err := repo.Delete(context.Background(), testUserID, testGoal.ID)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("AddContribution", func(t *testing.T) {
+		transactionID := primitive.NewObjectID()
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testGoalDoc}})
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.AddContribution(context.Background(), testGoal.ID, transactionID, entities.Money{Amount: 500, Currency: "USD"})
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, testGoal.ID, result.ID)
+
+			command := mt.GetStartedEvent().Command
+			assert.Equal(t, "USD", command.Lookup("query", "current_amount.currency").StringValue())
+			assert.Equal(t, int64(500), command.Lookup("update", "$inc", "current_amount.amount").Int64())
+			assert.Equal(t, transactionID, command.Lookup("update", "$push", "contributions", "transaction_id").ObjectID())
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.AddContribution(context.Background(), testGoal.ID, transactionID, entities.Money{Amount: 500, Currency: "USD"})
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("RemoveContributions", func(t *testing.T) {
+		transactionID := primitive.NewObjectID()
+		funded := testGoal
+		funded.Contributions = []entities.GoalContribution{
+			{TransactionID: transactionID, Amount: entities.Money{Amount: 300, Currency: "USD"}},
+			{TransactionID: primitive.NewObjectID(), Amount: entities.Money{Amount: 200, Currency: "USD"}},
+		}
+		fundedBSON, err := bson.Marshal(funded)
+		require.NoError(t, err)
+		var fundedDoc bson.D
+		require.NoError(t, bson.Unmarshal(fundedBSON, &fundedDoc))
+
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, fundedDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+				bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testGoalDoc}},
+			)
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.RemoveContributions(context.Background(), testUserID, transactionID)
+			assert.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, testGoal.ID, result[0].ID)
+
+			// The find and its getMore come first.
+			mt.GetStartedEvent()
+			mt.GetStartedEvent()
+			command := mt.GetStartedEvent().Command
+			assert.Equal(t, int64(-300), command.Lookup("update", "$inc", "current_amount.amount").Int64())
+		})
+		mt.Run("removed concurrently", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, fundedDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+				bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}},
+			)
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.RemoveContributions(context.Background(), testUserID, transactionID)
+			assert.NoError(t, err)
+			assert.Empty(t, result)
+		})
+		mt.Run("find error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "find error"}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.RemoveContributions(context.Background(), testUserID, transactionID)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+		mt.Run("update error", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, fundedDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+				mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "update error"}),
+			)
+			repo := mongodb.NewGoalRepository(mt.DB)
+			result, err := repo.RemoveContributions(context.Background(), testUserID, transactionID)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse())
+			repo := mongodb.NewGoalRepository(mt.DB)
+			goal := testGoal
+			result, err := repo.Create(context.Background(), &goal)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.False(t, result.ID.IsZero())
+			assert.Equal(t, testGoal.TargetAmount, result.TargetAmount)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "duplicate key error",
+			}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			goal := testGoal
+			result, err := repo.Create(context.Background(), &goal)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			goal := testGoal
+			err := repo.Update(context.Background(), &goal)
+			assert.NoError(t, err)
+			assert.True(t, goal.UpdatedAt.After(testGoal.UpdatedAt))
+
+			started := mt.GetStartedEvent()
+			set := started.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u", "$set").Document()
+			_, err = set.LookupErr("current_amount")
+			assert.Error(t, err, "the saved amount must not be overwritten")
+			_, err = set.LookupErr("current_amount.amount")
+			assert.Error(t, err, "the saved amount must not be overwritten")
+			assert.Equal(t, goal.CurrentAmount.Currency, set.Lookup("current_amount.currency").StringValue())
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			goal := testGoal
+			err := repo.Update(context.Background(), &goal)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "update error",
+			}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			goal := testGoal
+			err := repo.Update(context.Background(), &goal)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("CompleteMilestone", func(t *testing.T) {
+		mt.Run("completed", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			completed, err := repo.CompleteMilestone(context.Background(), testGoal.ID, 0, time.Now(), false)
+			assert.NoError(t, err)
+			assert.True(t, completed)
+		})
+		mt.Run("already completed", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			completed, err := repo.CompleteMilestone(context.Background(), testGoal.ID, 0, time.Now(), false)
+			assert.NoError(t, err)
+			assert.False(t, completed)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "update error",
+			}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			completed, err := repo.CompleteMilestone(context.Background(), testGoal.ID, 0, time.Now(), false)
+			assert.Error(t, err)
+			assert.False(t, completed)
+		})
+	})
+	t.Run("ReopenMilestone", func(t *testing.T) {
+		mt.Run("reopened", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			reopened, err := repo.ReopenMilestone(context.Background(), testGoal.ID, 0)
+			assert.NoError(t, err)
+			assert.True(t, reopened)
+		})
+		mt.Run("not completed", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			reopened, err := repo.ReopenMilestone(context.Background(), testGoal.ID, 0)
+			assert.NoError(t, err)
+			assert.False(t, reopened)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "update error"}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			reopened, err := repo.ReopenMilestone(context.Background(), testGoal.ID, 0)
+			assert.Error(t, err)
+			assert.False(t, reopened)
+		})
+	})
+	t.Run("ClaimMilestoneReward", func(t *testing.T) {
+		mt.Run("claimed", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(
+				bson.E{Key: "n", Value: 1},
+				bson.E{Key: "nModified", Value: 0},
+				bson.E{Key: "upserted", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: "key"}}}},
+			))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			claimed, err := repo.ClaimMilestoneReward(context.Background(), testGoal.UserID, 25, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+			assert.NoError(t, err)
+			assert.True(t, claimed)
+
+			started := mt.GetStartedEvent()
+			update := started.Command.Lookup("updates").Array().Index(0).Value().Document()
+			key := update.Lookup("q", "_id")
+			assert.Equal(t, "2026-10", key.Document().Lookup("month").StringValue())
+			assert.True(t, update.Lookup("upsert").Boolean())
+		})
+		mt.Run("already claimed", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 0}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			claimed, err := repo.ClaimMilestoneReward(context.Background(), testGoal.UserID, 25, time.Now())
+			assert.NoError(t, err)
+			assert.False(t, claimed)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "update error"}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			claimed, err := repo.ClaimMilestoneReward(context.Background(), testGoal.UserID, 25, time.Now())
+			assert.Error(t, err)
+			assert.False(t, claimed)
+		})
+	})
+	t.Run("ReleaseMilestoneReward", func(t *testing.T) {
+		mt.Run("released", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			err := repo.ReleaseMilestoneReward(context.Background(), testGoal.UserID, 25, time.Now())
+			assert.NoError(t, err)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "delete error"}))
+			repo := mongodb.NewGoalRepository(mt.DB)
+			err := repo.ReleaseMilestoneReward(context.Background(), testGoal.UserID, 25, time.Now())
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// indexes lists, by collection, the indexes the repositories rely on.
+var indexes = []struct {
+	collection string
+	models     []mongo.IndexModel
+}{
+	{"market_prices", []mongo.IndexModel{
+		// SavePrices upserts one price per opportunity and day; without a unique
+		// index two concurrent upserts of the same day both insert.
+		{
+			Keys:    bson.D{{Key: "opportunity_id", Value: 1}, {Key: "date", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}},
+	{"transactions", []mongo.IndexModel{
+		// FindByQuery pages through a user's transactions by date and ID.
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "date", Value: 1}, {Key: "_id", Value: 1}}},
+		// CreateMany skips the statement rows another import already saved.
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "import_key", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"import_key": bson.M{"$exists": true}}),
+		},
+	}},
+}
+
+// EnsureIndexes creates the indexes the repositories rely on. Creating an index that
+// already exists is a no-op, so it runs on every start.
+func EnsureIndexes(ctx context.Context, db MongoClient) error {
+	for _, index := range indexes {
+		_, err := db.Collection(index.collection).Indexes().CreateMany(ctx, index.models)
+		if err != nil {
+			return fmt.Errorf("failed to create %s indexes: %w", index.collection, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+)
+
+type createdIndex struct {
+	Key                     bson.D `bson:"key"`
+	Unique                  bool   `bson:"unique"`
+	PartialFilterExpression bson.D `bson:"partialFilterExpression"`
+}
+
+func TestEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+		err := mongodb.EnsureIndexes(context.Background(), mt.DB)
+		require.NoError(t, err)
+
+		created := make(map[string][]createdIndex)
+		for event := mt.GetStartedEvent(); event != nil; event = mt.GetStartedEvent() {
+			collection := event.Command.Lookup("createIndexes").StringValue()
+			var command struct {
+				Indexes []createdIndex `bson:"indexes"`
+			}
+			require.NoError(t, bson.Unmarshal(event.Command, &command))
+			created[collection] = append(created[collection], command.Indexes...)
+		}
+		assert.Equal(t, map[string][]createdIndex{
+			"market_prices": {
+				{Key: bson.D{{Key: "opportunity_id", Value: int32(1)}, {Key: "date", Value: int32(1)}}, Unique: true},
+			},
+			"transactions": {
+				{Key: bson.D{{Key: "user_id", Value: int32(1)}, {Key: "date", Value: int32(1)}, {Key: "_id", Value: int32(1)}}},
+				{
+					Key:                     bson.D{{Key: "user_id", Value: int32(1)}, {Key: "import_key", Value: int32(1)}},
+					Unique:                  true,
+					PartialFilterExpression: bson.D{{Key: "import_key", Value: bson.D{{Key: "$exists", Value: true}}}},
+				},
+			},
+		}, created)
+	})
+
+	mt.Run("database error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    11000,
+			Message: "database error",
+		}))
+		err := mongodb.EnsureIndexes(context.Background(), mt.DB)
+		assert.ErrorContains(t, err, "failed to create market_prices indexes")
+	})
+}
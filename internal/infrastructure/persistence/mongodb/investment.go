@@ -2,25 +2,32 @@ package mongodb
 
 import (
 	"context"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/Financial-Partner/server/internal/entities"
+	investment_repository "github.com/Financial-Partner/server/internal/module/investment/repository"
 )
 
 type MongoInvestmentRepository struct {
-	collection *mongo.Collection
+	investments   *mongo.Collection
+	opportunities *mongo.Collection
 }
 
-func NewInvestmentRepository(db MongoClient) *MongoInvestmentRepository {
+func NewInvestmentRepository(db MongoClient) investment_repository.Repository {
 	return &MongoInvestmentRepository{
-		collection: db.Collection("investments"),
+		investments:   db.Collection("investments"),
+		opportunities: db.Collection("opportunities"),
 	}
 }
 
 func (r *MongoInvestmentRepository) CreateInvestment(ctx context.Context, entity *entities.Investment) (*entities.Investment, error) {
-	_, err := r.collection.InsertOne(ctx, entity)
+	entity.ID = primitive.NewObjectID()
+	_, err := r.investments.InsertOne(ctx, entity)
 	if err != nil {
 		return nil, err
 	}
@@ -28,16 +35,20 @@ func (r *MongoInvestmentRepository) CreateInvestment(ctx context.Context, entity
 }
 
 func (r *MongoInvestmentRepository) CreateOpportunity(ctx context.Context, entity *entities.Opportunity) (*entities.Opportunity, error) {
-	_, err := r.collection.InsertOne(ctx, entity)
+	entity.ID = primitive.NewObjectID()
+	_, err := r.opportunities.InsertOne(ctx, entity)
 	if err != nil {
 		return nil, err
 	}
 	return entity, nil
 }
 
-func (r *MongoInvestmentRepository) FindOpportunitiesByUserId(ctx context.Context, userID string) ([]entities.Opportunity, error) {
+// FindOpportunities returns every opportunity, newest first.
+func (r *MongoInvestmentRepository) FindOpportunities(ctx context.Context) ([]entities.Opportunity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
 	var opportunities []entities.Opportunity
-	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID})
+	cursor, err := r.opportunities.Find(ctx, bson.M{}, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -50,9 +61,46 @@ func (r *MongoInvestmentRepository) FindOpportunitiesByUserId(ctx context.Contex
 	return opportunities, nil
 }
 
-func (r *MongoInvestmentRepository) FindInvestmentsByUserId(ctx context.Context, userID string) ([]entities.Investment, error) {
+func (r *MongoInvestmentRepository) FindOpportunityById(ctx context.Context, id primitive.ObjectID) (*entities.Opportunity, error) {
+	var entity entities.Opportunity
+	err := r.opportunities.FindOne(ctx, bson.M{"_id": id}).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+func (r *MongoInvestmentRepository) FindInvestmentsByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.Investment, error) {
+	var investments []entities.Investment
+	cursor, err := r.investments.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &investments); err != nil {
+		return nil, err
+	}
+
+	return investments, nil
+}
+
+// FindMaturedInvestments returns up to limit open investments that matured by now,
+// oldest maturity first. Investments that failed to settle are left out until they
+// are due for a retry.
+func (r *MongoInvestmentRepository) FindMaturedInvestments(ctx context.Context, now time.Time, limit int64) ([]entities.Investment, error) {
+	filter := bson.M{
+		"status":     entities.InvestmentStatusOpen,
+		"matures_at": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"retry_at": bson.M{"$exists": false}},
+			bson.M{"retry_at": bson.M{"$lte": now}},
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "matures_at", Value: 1}}).SetLimit(limit)
+
 	var investments []entities.Investment
-	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID})
+	cursor, err := r.investments.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -64,3 +112,34 @@ func (r *MongoInvestmentRepository) FindInvestmentsByUserId(ctx context.Context,
 
 	return investments, nil
 }
+
+// SettleInvestment marks an open investment as settled with its payout. It reports
+// false when the investment had already been settled, e.g. by another worker.
+func (r *MongoInvestmentRepository) SettleInvestment(ctx context.Context, id primitive.ObjectID, payout entities.Money, settledAt time.Time) (bool, error) {
+	filter := bson.M{"_id": id, "status": entities.InvestmentStatusOpen}
+	update := bson.M{"$set": bson.M{
+		"status":     entities.InvestmentStatusSettled,
+		"payout":     payout,
+		"settled_at": settledAt,
+		"updated_at": settledAt,
+	}}
+
+	result, err := r.investments.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// RecordSettlementFailure counts a failed attempt to settle an open investment and
+// puts off the next one until retryAt.
+func (r *MongoInvestmentRepository) RecordSettlementFailure(ctx context.Context, id primitive.ObjectID, retryAt time.Time) error {
+	filter := bson.M{"_id": id, "status": entities.InvestmentStatusOpen}
+	update := bson.M{
+		"$inc": bson.M{"settlement_attempts": 1},
+		"$set": bson.M{"retry_at": retryAt},
+	}
+
+	_, err := r.investments.UpdateOne(ctx, filter, update)
+	return err
+}
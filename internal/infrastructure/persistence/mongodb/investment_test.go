@@ -10,19 +10,20 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 )
 
 func TestMongoInvestmentRepository(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 
-	testUserID := primitive.NewObjectID().Hex()
+	testUserID := primitive.NewObjectID()
 
 	testInvestment := &entities.Investment{
 		ID:            primitive.NewObjectID(),
 		UserID:        primitive.NewObjectID(),
 		OpportunityID: primitive.NewObjectID(),
-		Amount:        1000,
+		Amount:        entities.Money{Amount: 1000, Currency: "USD"},
 		CreatedAt:     time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 		UpdatedAt:     time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 	}
@@ -38,7 +39,7 @@ func TestMongoInvestmentRepository(t *testing.T) {
 		IsIncrease:  true,
 		Variation:   10,
 		Duration:    "1 year",
-		MinAmount:   1000,
+		MinAmount:   entities.Money{Amount: 1000, Currency: "USD"},
 		CreatedAt:   time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 		UpdatedAt:   time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 	}
@@ -122,7 +123,7 @@ func TestMongoInvestmentRepository(t *testing.T) {
 		})
 	})
 
-	t.Run("FindOpportunitiesByUserId", func(t *testing.T) {
+	t.Run("FindOpportunities", func(t *testing.T) {
 		mt.Run("database error", func(mt *mtest.T) {
 			mt.AddMockResponses(
 				mtest.CreateCommandErrorResponse(mtest.CommandError{
@@ -132,14 +133,14 @@ func TestMongoInvestmentRepository(t *testing.T) {
 			)
 
 			repo := mongodb.NewInvestmentRepository(mt.DB)
-			result, err := repo.FindOpportunitiesByUserId(context.Background(), testUserID)
+			result, err := repo.FindOpportunities(context.Background())
 			assert.Error(t, err)
 			assert.Nil(t, result)
 		})
 		mt.Run("not found", func(mt *mtest.T) {
 			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
 			repo := mongodb.NewInvestmentRepository(mt.DB)
-			result, err := repo.FindOpportunitiesByUserId(context.Background(), testUserID)
+			result, err := repo.FindOpportunities(context.Background())
 			assert.NoError(t, err)
 			assert.Nil(t, result)
 		})
@@ -149,7 +150,7 @@ func TestMongoInvestmentRepository(t *testing.T) {
 				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
 			)
 			repo := mongodb.NewInvestmentRepository(mt.Client.Database("testdb"))
-			result, err := repo.FindOpportunitiesByUserId(context.Background(), testUserID)
+			result, err := repo.FindOpportunities(context.Background())
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
 			assert.Len(t, result, len(testOpportunityDocs))
@@ -197,4 +198,91 @@ func TestMongoInvestmentRepository(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("FindOpportunityById", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testOpportunityDocs[0]))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			result, err := repo.FindOpportunityById(context.Background(), testOpportunity.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, testOpportunities[0], *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			result, err := repo.FindOpportunityById(context.Background(), testOpportunity.ID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindMaturedInvestments", func(t *testing.T) {
+		now := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testInvestmentDocs...),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			result, err := repo.FindMaturedInvestments(context.Background(), now, 100)
+			assert.NoError(t, err)
+			assert.Equal(t, testInvestments, result)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "Database error",
+			}))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			result, err := repo.FindMaturedInvestments(context.Background(), now, 100)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("SettleInvestment", func(t *testing.T) {
+		settledAt := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+		mt.Run("settled", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			settled, err := repo.SettleInvestment(context.Background(), testInvestment.ID, entities.Money{Amount: 1200, Currency: "USD"}, settledAt)
+			assert.NoError(t, err)
+			assert.True(t, settled)
+		})
+		mt.Run("already settled", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			settled, err := repo.SettleInvestment(context.Background(), testInvestment.ID, entities.Money{Amount: 1200, Currency: "USD"}, settledAt)
+			assert.NoError(t, err)
+			assert.False(t, settled)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "Database error",
+			}))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			settled, err := repo.SettleInvestment(context.Background(), testInvestment.ID, entities.Money{Amount: 1200, Currency: "USD"}, settledAt)
+			assert.Error(t, err)
+			assert.False(t, settled)
+		})
+	})
+	t.Run("RecordSettlementFailure", func(t *testing.T) {
+		retryAt := time.Date(2023, time.March, 1, 0, 1, 0, 0, time.UTC)
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			err := repo.RecordSettlementFailure(context.Background(), testInvestment.ID, retryAt)
+			assert.NoError(t, err)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "Database error",
+			}))
+			repo := mongodb.NewInvestmentRepository(mt.DB)
+			err := repo.RecordSettlementFailure(context.Background(), testInvestment.ID, retryAt)
+			assert.Error(t, err)
+		})
+	})
 }
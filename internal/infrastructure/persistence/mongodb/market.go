@@ -0,0 +1,56 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	market_repository "github.com/Financial-Partner/server/internal/module/market/repository"
+)
+
+type MongoMarketRepository struct {
+	prices *mongo.Collection
+}
+
+func NewMarketRepository(db MongoClient) market_repository.Repository {
+	return &MongoMarketRepository{
+		prices: db.Collection("market_prices"),
+	}
+}
+
+// FindPrices returns the opportunity's price points, oldest first.
+func (r *MongoMarketRepository) FindPrices(ctx context.Context, opportunityID primitive.ObjectID) ([]entities.PricePoint, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}})
+
+	var points []entities.PricePoint
+	cursor, err := r.prices.Find(ctx, bson.M{"opportunity_id": opportunityID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &points); err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+// SavePrices stores the price points, keeping any price already stored for the
+// same opportunity and day.
+func (r *MongoMarketRepository) SavePrices(ctx context.Context, points []entities.PricePoint) error {
+	models := make([]mongo.WriteModel, 0, len(points))
+	for _, point := range points {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"opportunity_id": point.OpportunityID, "date": point.Date}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{"price": point.Price}}).
+			SetUpsert(true))
+	}
+
+	_, err := r.prices.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
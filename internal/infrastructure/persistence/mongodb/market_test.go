@@ -0,0 +1,82 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+)
+
+func TestMongoMarketRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	opportunityID := primitive.NewObjectID()
+	testPoints := []entities.PricePoint{
+		{ID: primitive.NewObjectID(), OpportunityID: opportunityID, Date: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Price: 100},
+		{ID: primitive.NewObjectID(), OpportunityID: opportunityID, Date: time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC), Price: 101.5},
+	}
+
+	var testPointDocs []bson.D
+	for _, point := range testPoints {
+		pointBSON, err := bson.Marshal(point)
+		require.NoError(t, err)
+		var pointDoc bson.D
+		require.NoError(t, bson.Unmarshal(pointBSON, &pointDoc))
+		testPointDocs = append(testPointDocs, pointDoc)
+	}
+
+	t.Run("FindPrices", func(t *testing.T) {
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "Database error"}))
+
+			repo := mongodb.NewMarketRepository(mt.DB)
+			result, err := repo.FindPrices(context.Background(), opportunityID)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+
+			repo := mongodb.NewMarketRepository(mt.DB)
+			result, err := repo.FindPrices(context.Background(), opportunityID)
+			assert.NoError(t, err)
+			assert.Empty(t, result)
+		})
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testPointDocs...),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+
+			repo := mongodb.NewMarketRepository(mt.DB)
+			result, err := repo.FindPrices(context.Background(), opportunityID)
+			require.NoError(t, err)
+			assert.Equal(t, testPoints, result)
+		})
+	})
+
+	t.Run("SavePrices", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 0}))
+
+			repo := mongodb.NewMarketRepository(mt.DB)
+			err := repo.SavePrices(context.Background(), testPoints)
+			assert.NoError(t, err)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "Database error"}))
+
+			repo := mongodb.NewMarketRepository(mt.DB)
+			err := repo.SavePrices(context.Background(), testPoints)
+			assert.Error(t, err)
+		})
+	})
+}
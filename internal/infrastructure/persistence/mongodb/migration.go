@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+// legacyAmounts lists, by collection, the fields that held plain numbers of whole
+// units before amounts were stored with their currency.
+var legacyAmounts = []struct {
+	collection string
+	fields     []string
+}{
+	{"users", []string{"wallet.savings"}},
+	{"transactions", []string{"amount"}},
+	{"goals", []string{"target_amount", "current_amount"}},
+	{"investments", []string{"amount", "payout"}},
+	{"opportunities", []string{"min_amount"}},
+}
+
+// MigrateAmounts rewrites the amounts stored as plain numbers into amounts in
+// entities.DefaultCurrency, which is what they were recorded in, and gives the
+// transactions recorded before base amounts were kept their amount as base amount.
+// Entities decode legacy numbers too, but queries and updates on the amount fields
+// need the new shape, such as incrementing savings. Migrated documents no longer
+// match, so running it again is a no-op.
+func MigrateAmounts(ctx context.Context, db MongoClient) error {
+	digits, err := entities.CurrencyDigits(entities.DefaultCurrency)
+	if err != nil {
+		return err
+	}
+	scale := math.Pow10(digits)
+
+	for _, legacy := range legacyAmounts {
+		collection := db.Collection(legacy.collection)
+		for _, field := range legacy.fields {
+			update := bson.A{bson.M{"$set": bson.M{field: bson.M{
+				"amount":   bson.M{"$toLong": bson.M{"$round": bson.A{bson.M{"$multiply": bson.A{"$" + field, scale}}, 0}}},
+				"currency": entities.DefaultCurrency,
+			}}}}
+			_, err := collection.UpdateMany(ctx, bson.M{field: bson.M{"$type": "number"}}, update)
+			if err != nil {
+				return fmt.Errorf("failed to migrate %s.%s: %w", legacy.collection, field, err)
+			}
+		}
+	}
+
+	_, err = db.Collection("transactions").UpdateMany(ctx,
+		bson.M{"base_amount": bson.M{"$exists": false}},
+		bson.A{bson.M{"$set": bson.M{"base_amount": "$amount"}}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to backfill transactions.base_amount: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+)
+
+func TestMigrateAmounts(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("success", func(mt *mtest.T) {
+		// One update per migrated field and one backfilling base amounts.
+		for range 8 {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+		}
+		err := mongodb.MigrateAmounts(context.Background(), mt.DB)
+		assert.NoError(t, err)
+	})
+
+	mt.Run("database error", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    11000,
+			Message: "database error",
+		}))
+		err := mongodb.MigrateAmounts(context.Background(), mt.DB)
+		assert.ErrorContains(t, err, "failed to migrate users.wallet.savings")
+	})
+
+	mt.Run("backfill error", func(mt *mtest.T) {
+		for range 7 {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+		}
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    11000,
+			Message: "database error",
+		}))
+		err := mongodb.MigrateAmounts(context.Background(), mt.DB)
+		assert.ErrorContains(t, err, "failed to backfill transactions.base_amount")
+	})
+}
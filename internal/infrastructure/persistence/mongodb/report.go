@@ -0,0 +1,66 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	report_repository "github.com/Financial-Partner/server/internal/module/report/repository"
+)
+
+type MongoReportSnapshotRepository struct {
+	collection *mongo.Collection
+}
+
+func NewReportSnapshotRepository(db MongoClient) report_repository.SnapshotRepository {
+	return &MongoReportSnapshotRepository{
+		collection: db.Collection("report_snapshots"),
+	}
+}
+
+// CreateSnapshot stores the snapshot unless the user already has one for the same
+// period, which is left untouched. It reports whether the snapshot was stored.
+func (r *MongoReportSnapshotRepository) CreateSnapshot(ctx context.Context, snapshot *entities.ReportSnapshot) (bool, error) {
+	snapshot.ID = primitive.NewObjectID()
+	filter := bson.M{"user_id": snapshot.UserID, "report.start": snapshot.Report.Start}
+	update := bson.M{"$setOnInsert": snapshot}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+	return result.UpsertedCount > 0, nil
+}
+
+// SnapshotExists reports whether the user has a snapshot of the period starting at start.
+func (r *MongoReportSnapshotRepository) SnapshotExists(ctx context.Context, userID primitive.ObjectID, start time.Time) (bool, error) {
+	filter := bson.M{"user_id": userID, "report.start": start}
+	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindSnapshotsByUserId returns the user's snapshots, most recent period first.
+func (r *MongoReportSnapshotRepository) FindSnapshotsByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.ReportSnapshot, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "report.start", Value: -1}})
+
+	var snapshots []entities.ReportSnapshot
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &snapshots); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
@@ -0,0 +1,125 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+)
+
+func TestMongoReportSnapshotRepository(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	testUserID := primitive.NewObjectID()
+	start := time.Date(2025, time.February, 28, 16, 0, 0, 0, time.UTC)
+	testSnapshot := entities.ReportSnapshot{
+		ID:       primitive.NewObjectID(),
+		UserID:   testUserID,
+		Timezone: "Asia/Taipei",
+		Report: entities.Report{
+			Start:     start,
+			End:       time.Date(2025, time.March, 31, 16, 0, 0, 0, time.UTC),
+			Revenue:   5000,
+			Expenses:  2100,
+			NetProfit: 2900,
+			Interval:  "daily",
+		},
+		CreatedAt: time.Date(2025, time.March, 31, 16, 5, 0, 0, time.UTC),
+	}
+	snapshotBSON, err := bson.Marshal(testSnapshot)
+	require.NoError(t, err)
+	var snapshotDoc bson.D
+	require.NoError(t, bson.Unmarshal(snapshotBSON, &snapshotDoc))
+
+	t.Run("CreateSnapshot", func(t *testing.T) {
+		mt.Run("created", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(
+				bson.E{Key: "n", Value: 1},
+				bson.E{Key: "nModified", Value: 0},
+				bson.E{Key: "upserted", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: primitive.NewObjectID()}}}},
+			))
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			snapshot := testSnapshot
+			created, err := repo.CreateSnapshot(context.Background(), &snapshot)
+			assert.NoError(t, err)
+			assert.True(t, created)
+			assert.NotEqual(t, testSnapshot.ID, snapshot.ID)
+		})
+		mt.Run("already exists", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 0}))
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			snapshot := testSnapshot
+			created, err := repo.CreateSnapshot(context.Background(), &snapshot)
+			assert.NoError(t, err)
+			assert.False(t, created)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "database error"}))
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			snapshot := testSnapshot
+			created, err := repo.CreateSnapshot(context.Background(), &snapshot)
+			assert.Error(t, err)
+			assert.False(t, created)
+		})
+	})
+
+	t.Run("SnapshotExists", func(t *testing.T) {
+		mt.Run("exists", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{{Key: "n", Value: 1}}))
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			exists, err := repo.SnapshotExists(context.Background(), testUserID, start)
+			assert.NoError(t, err)
+			assert.True(t, exists)
+		})
+		mt.Run("missing", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			exists, err := repo.SnapshotExists(context.Background(), testUserID, start)
+			assert.NoError(t, err)
+			assert.False(t, exists)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "database error"}))
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			exists, err := repo.SnapshotExists(context.Background(), testUserID, start)
+			assert.Error(t, err)
+			assert.False(t, exists)
+		})
+	})
+
+	t.Run("FindSnapshotsByUserId", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, snapshotDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			snapshots, err := repo.FindSnapshotsByUserId(context.Background(), testUserID)
+			assert.NoError(t, err)
+			assert.Equal(t, []entities.ReportSnapshot{testSnapshot}, snapshots)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "database error"}))
+
+			repo := mongodb.NewReportSnapshotRepository(mt.DB)
+			snapshots, err := repo.FindSnapshotsByUserId(context.Background(), testUserID)
+			assert.Error(t, err)
+			assert.Nil(t, snapshots)
+		})
+	})
+}
@@ -2,12 +2,18 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/Financial-Partner/server/internal/entities"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
 	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
 )
 
@@ -30,9 +36,144 @@ func (r *MongoTransactionRepository) Create(ctx context.Context, entity *entitie
 	return entity, nil
 }
 
-func (r *MongoTransactionRepository) FindByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.Transaction, error) {
+// CreateMany inserts the transactions in one batch, assigning their IDs, and returns
+// the inserted ones. Transactions whose import key the user already has are skipped
+// and keep a zero ID.
+func (r *MongoTransactionRepository) CreateMany(ctx context.Context, transactions []*entities.Transaction) ([]*entities.Transaction, error) {
+	if len(transactions) == 0 {
+		return nil, nil
+	}
+
+	documents := make([]interface{}, len(transactions))
+	for i, entity := range transactions {
+		entity.ID = primitive.NewObjectID()
+		documents[i] = entity
+	}
+	_, err := r.collection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return transactions, nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return nil, err
+	}
+	skipped := make(map[int]bool, len(bulkErr.WriteErrors))
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return nil, err
+		}
+		skipped[writeErr.Index] = true
+	}
+
+	inserted := make([]*entities.Transaction, 0, len(transactions)-len(skipped))
+	for i, entity := range transactions {
+		if skipped[i] {
+			entity.ID = primitive.NilObjectID
+			continue
+		}
+		inserted = append(inserted, entity)
+	}
+	return inserted, nil
+}
+
+// Update saves the transaction's amounts, category, type, date and description. Only the
+// owner's transaction is matched.
+func (r *MongoTransactionRepository) Update(ctx context.Context, entity *entities.Transaction) error {
+	entity.UpdatedAt = time.Now().UTC()
+	update := bson.M{"$set": bson.M{
+		"amount":      entity.Amount,
+		"base_amount": entity.BaseAmount,
+		"category":    entity.Category,
+		"type":        entity.Type,
+		"date":        entity.Date,
+		"description": entity.Description,
+		"updated_at":  entity.UpdatedAt,
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": entity.ID, "user_id": entity.UserID}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Delete removes the user's transaction and returns it as it was before deletion.
+func (r *MongoTransactionRepository) Delete(ctx context.Context, userID, transactionID primitive.ObjectID) (*entities.Transaction, error) {
+	var entity entities.Transaction
+	err := r.collection.FindOneAndDelete(ctx, bson.M{"_id": transactionID, "user_id": userID}).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+func (r *MongoTransactionRepository) FindById(ctx context.Context, userID, transactionID primitive.ObjectID) (*entities.Transaction, error) {
+	var entity entities.Transaction
+	err := r.collection.FindOne(ctx, bson.M{"_id": transactionID, "user_id": userID}).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// FindByQuery returns up to query.Limit of the user's transactions that match the
+// query, ordered by date and then ID.
+func (r *MongoTransactionRepository) FindByQuery(ctx context.Context, userID primitive.ObjectID, query entities.TransactionQuery) ([]entities.Transaction, error) {
+	filter := bson.M{"user_id": userID}
+
+	date := bson.M{}
+	if !query.From.IsZero() {
+		date["$gte"] = query.From
+	}
+	if !query.To.IsZero() {
+		date["$lt"] = query.To
+	}
+	if len(date) > 0 {
+		filter["date"] = date
+	}
+
+	amount := bson.M{}
+	if query.MinAmount != nil {
+		amount["$gte"] = *query.MinAmount
+	}
+	if query.MaxAmount != nil {
+		amount["$lte"] = *query.MaxAmount
+	}
+	if len(amount) > 0 {
+		filter["amount.amount"] = amount
+	}
+
+	// Categories and types are stored as entered, so they are matched regardless of case.
+	if query.Category != "" {
+		filter["category"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(query.Category) + "$", Options: "i"}
+	}
+	if query.Type != "" {
+		filter["type"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(query.Type) + "$", Options: "i"}
+	}
+	if query.Search != "" {
+		filter["description"] = primitive.Regex{Pattern: regexp.QuoteMeta(query.Search), Options: "i"}
+	}
+
+	order, after := -1, "$lt"
+	if query.Ascending {
+		order, after = 1, "$gt"
+	}
+	if query.After != nil {
+		filter["$or"] = bson.A{
+			bson.M{"date": bson.M{after: query.After.Date}},
+			bson.M{"date": query.After.Date, "_id": bson.M{after: query.After.ID}},
+		}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "date", Value: order}, {Key: "_id", Value: order}}).
+		SetLimit(int64(query.Limit))
+
 	var transactions []entities.Transaction
-	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID})
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -44,3 +185,120 @@ func (r *MongoTransactionRepository) FindByUserId(ctx context.Context, userID pr
 
 	return transactions, nil
 }
+
+// FindByUserIdBetween returns a user's transactions dated in [start, end), oldest first.
+func (r *MongoTransactionRepository) FindByUserIdBetween(ctx context.Context, userID primitive.ObjectID, start, end time.Time) ([]entities.Transaction, error) {
+	filter := bson.M{"user_id": userID, "date": bson.M{"$gte": start, "$lt": end}}
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}})
+
+	var transactions []entities.Transaction
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &transactions); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// baseAmount is a transaction's amount in the user's base currency. Transactions
+// recorded before base amounts were kept have only their amount, which was in the
+// base currency.
+var baseAmount = bson.M{"$ifNull": bson.A{"$base_amount.amount", "$amount.amount"}}
+
+// SumAmountByCategory totals a user's transactions dated in [start, end) by lower-cased
+// type and category, largest first.
+func (r *MongoTransactionRepository) SumAmountByCategory(ctx context.Context, userID primitive.ObjectID, start, end time.Time) ([]entities.CategoryTotal, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "date": bson.M{"$gte": start, "$lt": end}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"type": bson.M{"$toLower": "$type"}, "category": "$category"},
+			"total": bson.M{"$sum": baseAmount},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"type":     "$_id.type",
+			"category": "$_id.category",
+			"total":    1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "total", Value: -1}, {Key: "category", Value: 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var totals []entities.CategoryTotal
+	if err := cursor.All(ctx, &totals); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// dateTruncUnits maps report intervals to $dateTrunc units.
+var dateTruncUnits = map[string]string{
+	report_domain.ReportTypeDaily:   "day",
+	report_domain.ReportTypeWeekly:  "week",
+	report_domain.ReportTypeMonthly: "month",
+	report_domain.ReportTypeYearly:  "year",
+}
+
+// SumAmountByInterval totals a user's income and expenses dated in [start, end) per
+// UTC day, week (starting on Monday), month or year, oldest first. Intervals without
+// transactions are left out.
+func (r *MongoTransactionRepository) SumAmountByInterval(ctx context.Context, userID primitive.ObjectID, start, end time.Time, interval string) ([]entities.ReportBucket, error) {
+	unit, ok := dateTruncUnits[interval]
+	if !ok {
+		return nil, fmt.Errorf("unsupported interval %q", interval)
+	}
+
+	sumOfType := func(transactionType string) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$toLower": "$type"}, transactionType}},
+			baseAmount,
+			0,
+		}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "date": bson.M{"$gte": start, "$lt": end}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"$dateTrunc": bson.M{
+				"date":        "$date",
+				"unit":        unit,
+				"timezone":    "UTC",
+				"startOfWeek": "monday",
+			}},
+			"income":  sumOfType(entities.TransactionTypeIncome),
+			"expense": sumOfType(entities.TransactionTypeExpense),
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":     0,
+			"start":   "$_id",
+			"income":  1,
+			"expense": 1,
+			"net":     bson.M{"$subtract": bson.A{"$income", "$expense"}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "start", Value: 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var buckets []entities.ReportBucket
+	if err := cursor.All(ctx, &buckets); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
@@ -7,10 +7,12 @@ import (
 
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/infrastructure/persistence/mongodb"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 )
 
@@ -22,7 +24,8 @@ func TestMongoTransactionRepository(t *testing.T) {
 		{
 			ID:          primitive.NewObjectID(),
 			UserID:      primitive.NewObjectID(),
-			Amount:      100,
+			Amount:      entities.Money{Amount: 100, Currency: "USD"},
+			BaseAmount:  entities.Money{Amount: 100, Currency: "USD"},
 			Description: "Dinner",
 			Date:        time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
 			Category:    "Food",
@@ -33,7 +36,8 @@ func TestMongoTransactionRepository(t *testing.T) {
 		{
 			ID:          primitive.NewObjectID(),
 			UserID:      primitive.NewObjectID(),
-			Amount:      200,
+			Amount:      entities.Money{Amount: 200, Currency: "USD"},
+			BaseAmount:  entities.Money{Amount: 200, Currency: "USD"},
 			Description: "Rent",
 			Date:        time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
 			Category:    "Housing",
@@ -54,37 +58,177 @@ func TestMongoTransactionRepository(t *testing.T) {
 		testTransactionDocs = append(testTransactionDocs, transactionDoc)
 	}
 
-	t.Run("FindByUserId", func(t *testing.T) {
+	t.Run("FindByQuery", func(t *testing.T) {
+		minAmount, maxAmount := int64(100), int64(500)
+		after := entities.TransactionCursor{Date: testTransactions[1].Date, ID: testTransactions[1].ID}
+
 		mt.Run("success", func(mt *mtest.T) {
 			mt.AddMockResponses(
 				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testTransactionDocs...),
-				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch), // Simulate end of cursor
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
 			)
-			repo := mongodb.NewTransactionRepository(mt.Client.Database("testdb"))
-			result, err := repo.FindByUserId(context.Background(), testUserID)
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.FindByQuery(context.Background(), testUserID, entities.TransactionQuery{
+				From:      time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+				To:        time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+				Category:  "food",
+				Type:      "expense",
+				MinAmount: &minAmount,
+				MaxAmount: &maxAmount,
+				Search:    "a.b",
+				After:     &after,
+				Limit:     3,
+			})
 			assert.NoError(t, err)
-			assert.NotNil(t, result)
-			assert.Len(t, result, len(testTransactions))
+			assert.Equal(t, testTransactions, result)
 
-			// Validate each transaction
-			for i, transaction := range result {
-				assert.Equal(t, testTransactions[i], transaction)
-			}
+			command := mt.GetStartedEvent().Command
+			assert.Equal(t, int64(3), command.Lookup("limit").AsInt64())
+			sort := command.Lookup("sort").Document()
+			assert.Equal(t, int32(-1), sort.Lookup("date").Int32())
+			assert.Equal(t, int32(-1), sort.Lookup("_id").Int32())
+
+			filter := command.Lookup("filter").Document()
+			assert.Equal(t, testUserID, filter.Lookup("user_id").ObjectID())
+			assert.Equal(t, testTransactions[0].Date, filter.Lookup("date", "$gte").Time().UTC())
+			assert.Equal(t, int64(100), filter.Lookup("amount.amount", "$gte").Int64())
+			assert.Equal(t, int64(500), filter.Lookup("amount.amount", "$lte").Int64())
+			pattern, options := filter.Lookup("category").Regex()
+			assert.Equal(t, "^food$", pattern)
+			assert.Equal(t, "i", options)
+			pattern, _ = filter.Lookup("description").Regex()
+			assert.Equal(t, `a\.b`, pattern)
+			assert.Equal(t, after.ID, filter.Lookup("$or", "1", "_id", "$lt").ObjectID())
 		})
-		mt.Run("not found", func(mt *mtest.T) {
+		mt.Run("ascending", func(mt *mtest.T) {
 			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
 			repo := mongodb.NewTransactionRepository(mt.DB)
-			result, err := repo.FindByUserId(context.Background(), testUserID)
-			assert.Nil(t, err)
+			result, err := repo.FindByQuery(context.Background(), testUserID, entities.TransactionQuery{
+				Ascending: true,
+				After:     &after,
+				Limit:     20,
+			})
+			assert.NoError(t, err)
+			assert.Empty(t, result)
+
+			command := mt.GetStartedEvent().Command
+			assert.Equal(t, int32(1), command.Lookup("sort", "date").Int32())
+
+			filter := command.Lookup("filter").Document()
+			assert.Equal(t, after.ID, filter.Lookup("$or", "1", "_id", "$gt").ObjectID())
+			_, err = filter.LookupErr("date")
+			assert.Error(t, err)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    1,
+				Message: "database error",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.FindByQuery(context.Background(), testUserID, entities.TransactionQuery{Limit: 20})
+			assert.Error(t, err)
 			assert.Nil(t, result)
 		})
+	})
+
+	t.Run("FindByUserIdBetween", func(t *testing.T) {
+		start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testTransactionDocs...),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.FindByUserIdBetween(context.Background(), testUserID, start, end)
+			assert.NoError(t, err)
+			assert.Equal(t, testTransactions, result)
+		})
 		mt.Run("database error", func(mt *mtest.T) {
 			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
-				Code:    11000,
+				Code:    1,
 				Message: "database error",
 			}))
 			repo := mongodb.NewTransactionRepository(mt.DB)
-			result, err := repo.FindByUserId(context.Background(), testUserID)
+			result, err := repo.FindByUserIdBetween(context.Background(), testUserID, start, end)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindById", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testTransactionDocs[0]))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), testUserID, testTransactions[0].ID)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, testTransactions[0], *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), testUserID, testTransactions[0].ID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			transaction := testTransactions[1]
+			err := repo.Update(context.Background(), &transaction)
+			assert.NoError(t, err)
+			assert.True(t, transaction.UpdatedAt.After(testTransactions[1].UpdatedAt))
+
+			set := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u", "$set").Document()
+			assert.Equal(t, int64(200), set.Lookup("base_amount", "amount").Int64())
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			transaction := testTransactions[1]
+			err := repo.Update(context.Background(), &transaction)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "update error",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			transaction := testTransactions[1]
+			err := repo.Update(context.Background(), &transaction)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testTransactionDocs[0]}})
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.Delete(context.Background(), testUserID, testTransactions[0].ID)
+			assert.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, testTransactions[0], *result)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.Delete(context.Background(), testUserID, testTransactions[0].ID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "delete error",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.Delete(context.Background(), testUserID, testTransactions[0].ID)
 			assert.Error(t, err)
 			assert.Nil(t, result)
 		})
@@ -110,4 +254,131 @@ func TestMongoTransactionRepository(t *testing.T) {
 			assert.Nil(t, result)
 		})
 	})
+	t.Run("CreateMany", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateSuccessResponse())
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			first, second := testTransactions[0], testTransactions[1]
+			first.ID, second.ID = primitive.NilObjectID, primitive.NilObjectID
+			inserted, err := repo.CreateMany(context.Background(), []*entities.Transaction{&first, &second})
+			assert.NoError(t, err)
+			assert.Equal(t, []*entities.Transaction{&first, &second}, inserted)
+			assert.False(t, first.ID.IsZero())
+			assert.NotEqual(t, first.ID, second.ID)
+			assert.False(t, mt.GetStartedEvent().Command.Lookup("ordered").Boolean())
+		})
+		mt.Run("skips taken import keys", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+				Index:   1,
+				Code:    11000,
+				Message: "duplicate key error",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			first, second := testTransactions[0], testTransactions[1]
+			first.ImportKey, second.ImportKey = "a-1", "b-1"
+			inserted, err := repo.CreateMany(context.Background(), []*entities.Transaction{&first, &second})
+			assert.NoError(t, err)
+			assert.Equal(t, []*entities.Transaction{&first}, inserted)
+			assert.False(t, first.ID.IsZero())
+			assert.True(t, second.ID.IsZero())
+		})
+		mt.Run("nothing to insert", func(mt *mtest.T) {
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			inserted, err := repo.CreateMany(context.Background(), nil)
+			assert.NoError(t, err)
+			assert.Empty(t, inserted)
+		})
+		mt.Run("write error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+				Index:   0,
+				Code:    121,
+				Message: "document failed validation",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			transaction := testTransactions[0]
+			inserted, err := repo.CreateMany(context.Background(), []*entities.Transaction{&transaction})
+			assert.Error(t, err)
+			assert.Nil(t, inserted)
+		})
+		mt.Run("error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "duplicate key error",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			transaction := testTransactions[0]
+			inserted, err := repo.CreateMany(context.Background(), []*entities.Transaction{&transaction})
+			assert.Error(t, err)
+			assert.Nil(t, inserted)
+		})
+	})
+	t.Run("SumAmountByCategory", func(t *testing.T) {
+		start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch,
+					bson.D{{Key: "type", Value: "income"}, {Key: "category", Value: "Salary"}, {Key: "total", Value: int64(5000)}},
+					bson.D{{Key: "type", Value: "expense"}, {Key: "category", Value: "Food"}, {Key: "total", Value: int64(1200)}},
+				),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.SumAmountByCategory(context.Background(), testUserID, start, end)
+			assert.NoError(t, err)
+			assert.Equal(t, []entities.CategoryTotal{
+				{Type: "income", Category: "Salary", Total: 5000},
+				{Type: "expense", Category: "Food", Total: 1200},
+			}, result)
+
+			// Transactions in other currencies are totaled in the base currency.
+			pipeline := mt.GetStartedEvent().Command.Lookup("pipeline").String()
+			assert.Contains(t, pipeline, "$base_amount.amount")
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "database error",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.SumAmountByCategory(context.Background(), testUserID, start, end)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("SumAmountByInterval", func(t *testing.T) {
+		start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch,
+					bson.D{{Key: "start", Value: start}, {Key: "income", Value: int64(5000)}, {Key: "expense", Value: int64(1200)}, {Key: "net", Value: int64(3800)}},
+				),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.SumAmountByInterval(context.Background(), testUserID, start, end, report_domain.ReportTypeWeekly)
+			assert.NoError(t, err)
+			assert.Equal(t, []entities.ReportBucket{{Start: start, Income: 5000, Expense: 1200, Net: 3800}}, result)
+		})
+		mt.Run("unsupported interval", func(mt *mtest.T) {
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.SumAmountByInterval(context.Background(), testUserID, start, end, "hourly")
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+				Code:    11000,
+				Message: "database error",
+			}))
+			repo := mongodb.NewTransactionRepository(mt.DB)
+			result, err := repo.SumAmountByInterval(context.Background(), testUserID, start, end, report_domain.ReportTypeDaily)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -35,6 +36,15 @@ func (r *MongoUserRepository) FindByEmail(ctx context.Context, email string) (*e
 	return &entity, nil
 }
 
+func (r *MongoUserRepository) FindById(ctx context.Context, id primitive.ObjectID) (*entities.User, error) {
+	var entity entities.User
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
 func (r *MongoUserRepository) Create(ctx context.Context, entity *entities.User) (*entities.User, error) {
 	_, err := r.collection.InsertOne(ctx, entity)
 	if err != nil {
@@ -48,3 +58,126 @@ func (r *MongoUserRepository) Update(ctx context.Context, entity *entities.User)
 	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": entity.ID}, entity)
 	return err
 }
+
+// UpdateWallet adds the given deltas to the user's wallet in a single atomic update.
+// The update is skipped, yielding mongo.ErrNoDocuments, if a balance would drop below
+// zero or the savings are held in another currency than the delta's.
+func (r *MongoUserRepository) UpdateWallet(ctx context.Context, id primitive.ObjectID, diamonds int64, savings entities.Money) (*entities.User, error) {
+	filter := bson.M{"_id": id}
+	if diamonds < 0 {
+		filter["wallet.diamonds"] = bson.M{"$gte": -diamonds}
+	}
+	if !savings.IsZero() {
+		filter["wallet.savings.currency"] = savings.Currency
+	}
+	if savings.Amount < 0 {
+		filter["wallet.savings.amount"] = bson.M{"$gte": -savings.Amount}
+	}
+
+	update := bson.M{
+		"$inc": bson.M{"wallet.diamonds": diamonds, "wallet.savings.amount": savings.Amount},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+	return r.findOneAndUpdate(ctx, filter, update)
+}
+
+// AddCharacter adds the character to the characters the user owns. Owning a
+// character twice has no effect.
+func (r *MongoUserRepository) AddCharacter(ctx context.Context, id primitive.ObjectID, characterID string) (*entities.User, error) {
+	update := bson.M{
+		"$addToSet": bson.M{"owned_characters": characterID},
+		"$set":      bson.M{"updated_at": time.Now()},
+	}
+	return r.findOneAndUpdate(ctx, bson.M{"_id": id}, update)
+}
+
+// EquipCharacter makes the character the user's equipped one. The update is skipped,
+// yielding mongo.ErrNoDocuments, if the user doesn't own the character.
+func (r *MongoUserRepository) EquipCharacter(ctx context.Context, id primitive.ObjectID, character entities.Character) (*entities.User, error) {
+	filter := bson.M{"_id": id, "owned_characters": character.ID}
+	update := bson.M{"$set": bson.M{
+		"character":  character,
+		"updated_at": time.Now(),
+	}}
+	return r.findOneAndUpdate(ctx, filter, update)
+}
+
+// UpdateTimezone sets the user's timezone.
+func (r *MongoUserRepository) UpdateTimezone(ctx context.Context, id primitive.ObjectID, timezone string) (*entities.User, error) {
+	update := bson.M{"$set": bson.M{
+		"timezone":   timezone,
+		"updated_at": time.Now(),
+	}}
+	return r.findOneAndUpdate(ctx, bson.M{"_id": id}, update)
+}
+
+// UpdateProfile sets the user's name and base currency; empty values are left as
+// they are. Savings aren't converted, so the currency only changes while the user has
+// none or already holds them in that currency.
+func (r *MongoUserRepository) UpdateProfile(ctx context.Context, id primitive.ObjectID, name, currency string) (*entities.User, error) {
+	filter := bson.M{"_id": id}
+	set := bson.M{"updated_at": time.Now()}
+	if name != "" {
+		set["name"] = name
+	}
+	if currency != "" {
+		filter["$or"] = bson.A{
+			bson.M{"wallet.savings.amount": 0},
+			bson.M{"wallet.savings.currency": currency},
+		}
+		set["currency"] = currency
+		set["wallet.savings.currency"] = currency
+	}
+	return r.findOneAndUpdate(ctx, filter, bson.M{"$set": set})
+}
+
+// FindTimezones returns the timezones users have set. Users without one are in UTC,
+// which is always included.
+func (r *MongoUserRepository) FindTimezones(ctx context.Context) ([]string, error) {
+	values, err := r.collection.Distinct(ctx, "timezone", bson.M{"timezone": bson.M{"$nin": bson.A{nil, "", entities.DefaultTimezone}}})
+	if err != nil {
+		return nil, err
+	}
+
+	timezones := []string{entities.DefaultTimezone}
+	for _, value := range values {
+		if timezone, ok := value.(string); ok {
+			timezones = append(timezones, timezone)
+		}
+	}
+	return timezones, nil
+}
+
+// FindByTimezone returns up to limit users in the timezone whose IDs come after afterID,
+// in ID order. Users without a timezone are found under UTC.
+func (r *MongoUserRepository) FindByTimezone(ctx context.Context, timezone string, afterID primitive.ObjectID, limit int64) ([]entities.User, error) {
+	filter := bson.M{"_id": bson.M{"$gt": afterID}, "timezone": timezone}
+	if timezone == entities.DefaultTimezone {
+		filter["timezone"] = bson.M{"$in": bson.A{nil, "", entities.DefaultTimezone}}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit)
+
+	var users []entities.User
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *MongoUserRepository) findOneAndUpdate(ctx context.Context, filter, update bson.M) (*entities.User, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var entity entities.User
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&entity)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 )
 
@@ -94,4 +95,151 @@ func TestMongoUserRepository(t *testing.T) {
 			assert.Error(t, err)
 		})
 	})
+	t.Run("UpdateWallet", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testUserDoc}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.UpdateWallet(context.Background(), testUserID, 10, entities.Money{Amount: -5, Currency: "USD"})
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, testUser.ID, result.ID)
+
+			// Savings are only debited in the currency they are held in.
+			command := mt.GetStartedEvent().Command
+			assert.Equal(t, "USD", command.Lookup("query", "wallet.savings.currency").StringValue())
+			assert.Equal(t, int64(5), command.Lookup("query", "wallet.savings.amount", "$gte").Int64())
+			assert.Equal(t, int64(-5), command.Lookup("update", "$inc", "wallet.savings.amount").Int64())
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.UpdateWallet(context.Background(), testUserID, -10, entities.Money{})
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+	t.Run("FindById", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testUserDoc))
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), testUserID)
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, testUser.ID, result.ID)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch))
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.FindById(context.Background(), testUserID)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+	t.Run("AddCharacter", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testUserDoc}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.AddCharacter(context.Background(), testUserID, "char_001")
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, testUser.ID, result.ID)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.AddCharacter(context.Background(), testUserID, "char_001")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+	t.Run("EquipCharacter", func(t *testing.T) {
+		character := entities.Character{ID: "char_001", Name: "Financial Assistant", ImageURL: "https://example.com/char_001.png"}
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testUserDoc}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.EquipCharacter(context.Background(), testUserID, character)
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, testUser.ID, result.ID)
+		})
+		mt.Run("not owned", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.EquipCharacter(context.Background(), testUserID, character)
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("UpdateTimezone", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testUserDoc}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.UpdateTimezone(context.Background(), testUserID, "Asia/Taipei")
+			assert.NoError(t, err)
+			assert.Equal(t, testUser.ID, result.ID)
+		})
+		mt.Run("not found", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.UpdateTimezone(context.Background(), testUserID, "Asia/Taipei")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("UpdateProfile", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: testUserDoc}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.UpdateProfile(context.Background(), testUserID, "New Name", "EUR")
+			assert.NoError(t, err)
+			assert.Equal(t, testUser.ID, result.ID)
+		})
+		mt.Run("savings held", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.UpdateProfile(context.Background(), testUserID, "", "EUR")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindTimezones", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "values", Value: bson.A{"Asia/Taipei", "Europe/Paris"}}})
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.FindTimezones(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"UTC", "Asia/Taipei", "Europe/Paris"}, result)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "database error"}))
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.FindTimezones(context.Background())
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("FindByTimezone", func(t *testing.T) {
+		mt.Run("success", func(mt *mtest.T) {
+			mt.AddMockResponses(
+				mtest.CreateCursorResponse(1, "foo.bar", mtest.FirstBatch, testUserDoc),
+				mtest.CreateCursorResponse(0, "foo.bar", mtest.NextBatch),
+			)
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.FindByTimezone(context.Background(), "UTC", primitive.NilObjectID, 100)
+			assert.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, testUser.ID, result[0].ID)
+		})
+		mt.Run("database error", func(mt *mtest.T) {
+			mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "database error"}))
+			repo := mongodb.NewUserRepository(mt.DB)
+			result, err := repo.FindByTimezone(context.Background(), "Asia/Taipei", primitive.NilObjectID, 100)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+	})
 }
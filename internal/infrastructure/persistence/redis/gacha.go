@@ -0,0 +1,36 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+const (
+	gachaPityCacheKey = "user:%s:gacha:%s:pity"
+	gachaPityCacheTTL = time.Hour * 24
+)
+
+type GachaStore struct {
+	cacheClient RedisClient
+}
+
+func NewGachaStore(cacheClient RedisClient) *GachaStore {
+	return &GachaStore{cacheClient: cacheClient}
+}
+
+func (s *GachaStore) GetPity(ctx context.Context, userID, poolID string) (*entities.GachaPity, error) {
+	var pity entities.GachaPity
+	err := s.cacheClient.Get(ctx, fmt.Sprintf(gachaPityCacheKey, userID, poolID), &pity)
+	if err != nil {
+		return nil, err
+	}
+	return &pity, nil
+}
+
+func (s *GachaStore) SetPity(ctx context.Context, pity *entities.GachaPity) error {
+	key := fmt.Sprintf(gachaPityCacheKey, pity.UserID.Hex(), pity.PoolID.Hex())
+	return s.cacheClient.Set(ctx, key, pity, gachaPityCacheTTL)
+}
@@ -0,0 +1,67 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGachaStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pity := &entities.GachaPity{
+		UserID:    primitive.NewObjectID(),
+		PoolID:    primitive.NewObjectID(),
+		Count:     7,
+		UpdatedAt: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	key := fmt.Sprintf("user:%s:gacha:%s:pity", pity.UserID.Hex(), pity.PoolID.Hex())
+
+	t.Run("GetPitySuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		gachaStore := redis.NewGachaStore(mockRedisClient)
+
+		mockData, _ := json.Marshal(pity)
+		mockRedisClient.EXPECT().Get(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest interface{}) error {
+				return json.Unmarshal(mockData, dest)
+			},
+		)
+
+		result, err := gachaStore.GetPity(context.Background(), pity.UserID.Hex(), pity.PoolID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, pity, result)
+	})
+
+	t.Run("GetPityNotFound", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		gachaStore := redis.NewGachaStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Get(gomock.Any(), key, gomock.Any()).Return(goredis.Nil)
+
+		result, err := gachaStore.GetPity(context.Background(), pity.UserID.Hex(), pity.PoolID.Hex())
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("SetPitySuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		gachaStore := redis.NewGachaStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Set(gomock.Any(), key, pity, gomock.Any()).Return(nil)
+
+		err := gachaStore.SetPity(context.Background(), pity)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,39 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+const (
+	goalCacheKey = "user:%s:goals:active"
+	goalCacheTTL = time.Hour * 24
+)
+
+type GoalStore struct {
+	cacheClient RedisClient
+}
+
+func NewGoalStore(cacheClient RedisClient) *GoalStore {
+	return &GoalStore{cacheClient: cacheClient}
+}
+
+func (s *GoalStore) GetActiveByUserId(ctx context.Context, userID string) ([]entities.Goal, error) {
+	var goals []entities.Goal
+	err := s.cacheClient.Get(ctx, fmt.Sprintf(goalCacheKey, userID), &goals)
+	if err != nil {
+		return nil, err
+	}
+	return goals, nil
+}
+
+func (s *GoalStore) SetActiveByUserId(ctx context.Context, userID string, goals []entities.Goal) error {
+	return s.cacheClient.Set(ctx, fmt.Sprintf(goalCacheKey, userID), goals, goalCacheTTL)
+}
+
+func (s *GoalStore) DeleteByUserId(ctx context.Context, userID string) error {
+	return s.cacheClient.Delete(ctx, fmt.Sprintf(goalCacheKey, userID))
+}
@@ -0,0 +1,80 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGoalStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := primitive.NewObjectID().Hex()
+	goals := []entities.Goal{{
+		ID:            primitive.NewObjectID(),
+		TargetAmount:  entities.Money{Amount: 10000, Currency: "USD"},
+		CurrentAmount: entities.Money{Amount: 2500, Currency: "USD"},
+		Period:        30,
+		Status:        entities.GoalStatusActive,
+		CreatedAt:     time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}}
+
+	t.Run("GetActiveByUserIdSuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		goalStore := redis.NewGoalStore(mockRedisClient)
+
+		mockData, _ := json.Marshal(goals)
+		mockRedisClient.EXPECT().Get(gomock.Any(), fmt.Sprintf("user:%s:goals:active", userID), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest interface{}) error {
+				return json.Unmarshal(mockData, dest)
+			},
+		)
+
+		result, err := goalStore.GetActiveByUserId(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, goals, result)
+	})
+
+	t.Run("GetActiveByUserIdNotFound", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		goalStore := redis.NewGoalStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(goredis.Nil)
+
+		result, err := goalStore.GetActiveByUserId(context.Background(), userID)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("SetActiveByUserIdSuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		goalStore := redis.NewGoalStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Set(gomock.Any(), fmt.Sprintf("user:%s:goals:active", userID), goals, gomock.Any()).Return(nil)
+
+		err := goalStore.SetActiveByUserId(context.Background(), userID, goals)
+		require.NoError(t, err)
+	})
+
+	t.Run("DeleteByUserIdSuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		goalStore := redis.NewGoalStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Delete(gomock.Any(), fmt.Sprintf("user:%s:goals:active", userID)).Return(nil)
+
+		err := goalStore.DeleteByUserId(context.Background(), userID)
+		require.NoError(t, err)
+	})
+}
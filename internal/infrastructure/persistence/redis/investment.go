@@ -11,7 +11,7 @@ import (
 
 const (
 	investmentCacheKey  = "user:%s:investments"
-	opportunityCacheKey = "user:%s:opportunities"
+	opportunityCacheKey = "investment:opportunities"
 	investmentCacheTTL  = time.Hour * 24
 )
 
@@ -23,13 +23,14 @@ func NewInvestmentStore(cacheClient RedisClient) *InvestmentStore {
 	return &InvestmentStore{cacheClient: cacheClient}
 }
 
-func (s *InvestmentStore) SetOpportunities(ctx context.Context, userID string, opportunities []entities.Opportunity) error {
+// SetOpportunities caches the opportunity catalog, which is shared by all users.
+func (s *InvestmentStore) SetOpportunities(ctx context.Context, opportunities []entities.Opportunity) error {
 	data, err := json.Marshal(opportunities)
 	if err != nil {
 		return err
 	}
 
-	return s.cacheClient.Set(ctx, fmt.Sprintf(opportunityCacheKey, userID), data, investmentCacheTTL)
+	return s.cacheClient.Set(ctx, opportunityCacheKey, data, investmentCacheTTL)
 }
 
 func (s *InvestmentStore) SetInvestments(ctx context.Context, userID string, investments []entities.Investment) error {
@@ -45,13 +46,13 @@ func (s *InvestmentStore) DeleteInvestments(ctx context.Context, userID string)
 	return s.cacheClient.Delete(ctx, fmt.Sprintf(investmentCacheKey, userID))
 }
 
-func (s *InvestmentStore) DeleteOpportunities(ctx context.Context, userID string) error {
-	return s.cacheClient.Delete(ctx, fmt.Sprintf(opportunityCacheKey, userID))
+func (s *InvestmentStore) DeleteOpportunities(ctx context.Context) error {
+	return s.cacheClient.Delete(ctx, opportunityCacheKey)
 }
 
-func (s *InvestmentStore) GetOpportunities(ctx context.Context, userID string) ([]entities.Opportunity, error) {
+func (s *InvestmentStore) GetOpportunities(ctx context.Context) ([]entities.Opportunity, error) {
 	var opportunities []entities.Opportunity
-	err := s.cacheClient.Get(ctx, fmt.Sprintf(opportunityCacheKey, userID), &opportunities)
+	err := s.cacheClient.Get(ctx, opportunityCacheKey, &opportunities)
 	if err != nil {
 		return nil, err
 	}
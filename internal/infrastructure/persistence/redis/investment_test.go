@@ -24,7 +24,6 @@ func TestInvestmentStore(t *testing.T) {
 		investmentStore := redis.NewInvestmentStore(mockRedisClient)
 
 		// Mock data
-		userID := primitive.NewObjectID().Hex()
 		opportunities := []entities.Opportunity{
 			{
 				ID:          primitive.NewObjectID(),
@@ -34,7 +33,7 @@ func TestInvestmentStore(t *testing.T) {
 				IsIncrease:  true,
 				Variation:   10,
 				Duration:    "1 year",
-				MinAmount:   1000,
+				MinAmount:   entities.Money{Amount: 1000, Currency: "USD"},
 				CreatedAt:   time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 				UpdatedAt:   time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 			},
@@ -42,7 +41,7 @@ func TestInvestmentStore(t *testing.T) {
 
 		mockRedisClient.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 
-		err := investmentStore.SetOpportunities(context.Background(), userID, opportunities)
+		err := investmentStore.SetOpportunities(context.Background(), opportunities)
 		require.NoError(t, err)
 	})
 
@@ -57,7 +56,7 @@ func TestInvestmentStore(t *testing.T) {
 				ID:            primitive.NewObjectID(),
 				UserID:        primitive.NewObjectID(),
 				OpportunityID: primitive.NewObjectID(),
-				Amount:        1000,
+				Amount:        entities.Money{Amount: 1000, Currency: "USD"},
 				CreatedAt:     time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 				UpdatedAt:     time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 			},
@@ -84,10 +83,9 @@ func TestInvestmentStore(t *testing.T) {
 		mockRedisClient := redis.NewMockRedisClient(ctrl)
 		investmentStore := redis.NewInvestmentStore(mockRedisClient)
 
-		userID := primitive.NewObjectID().Hex()
-		mockRedisClient.EXPECT().Delete(gomock.Any(), fmt.Sprintf("user:%s:opportunities", userID)).Return(nil)
+		mockRedisClient.EXPECT().Delete(gomock.Any(), "investment:opportunities").Return(nil)
 
-		err := investmentStore.DeleteOpportunities(context.Background(), userID)
+		err := investmentStore.DeleteOpportunities(context.Background())
 		require.NoError(t, err)
 	})
 
@@ -103,7 +101,7 @@ func TestInvestmentStore(t *testing.T) {
 				ID:            primitive.NewObjectID(),
 				UserID:        primitive.NewObjectID(),
 				OpportunityID: primitive.NewObjectID(),
-				Amount:        1000,
+				Amount:        entities.Money{Amount: 1000, Currency: "USD"},
 				CreatedAt:     time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 				UpdatedAt:     time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 			},
@@ -128,8 +126,6 @@ func TestInvestmentStore(t *testing.T) {
 		investmentStore := redis.NewInvestmentStore(mockRedisClient)
 
 		// Mock data
-		userID := primitive.NewObjectID().Hex()
-
 		mockOpportunities := []entities.Opportunity{
 			{
 				ID:          primitive.NewObjectID(),
@@ -139,7 +135,7 @@ func TestInvestmentStore(t *testing.T) {
 				IsIncrease:  true,
 				Variation:   10,
 				Duration:    "1 year",
-				MinAmount:   1000,
+				MinAmount:   entities.Money{Amount: 1000, Currency: "USD"},
 				CreatedAt:   time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 				UpdatedAt:   time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
 			},
@@ -149,12 +145,12 @@ func TestInvestmentStore(t *testing.T) {
 		mockData, _ := json.Marshal(mockOpportunities)
 
 		// Mock the Get method to return the serialized JSON data
-		mockRedisClient.EXPECT().Get(gomock.Any(), fmt.Sprintf("user:%s:opportunities", userID), gomock.Any()).DoAndReturn(
+		mockRedisClient.EXPECT().Get(gomock.Any(), "investment:opportunities", gomock.Any()).DoAndReturn(
 			func(_ context.Context, _ string, dest interface{}) error {
 				return json.Unmarshal(mockData, dest)
 			},
 		)
-		opportunities, err := investmentStore.GetOpportunities(context.Background(), userID)
+		opportunities, err := investmentStore.GetOpportunities(context.Background())
 		require.NoError(t, err)
 		assert.NotNil(t, opportunities)
 	})
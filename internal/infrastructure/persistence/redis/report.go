@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+const (
+	reportSummaryCacheKey = "user:%s:report_summary:%d:%d"
+	reportSummaryCacheTTL = time.Hour * 24
+)
+
+type ReportStore struct {
+	cacheClient RedisClient
+}
+
+func NewReportStore(cacheClient RedisClient) *ReportStore {
+	return &ReportStore{cacheClient: cacheClient}
+}
+
+func (s *ReportStore) GetSummary(ctx context.Context, userID string, start, end time.Time) (*entities.ReportSummary, error) {
+	var summary entities.ReportSummary
+	err := s.cacheClient.Get(ctx, fmt.Sprintf(reportSummaryCacheKey, userID, start.Unix(), end.Unix()), &summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// SetSummary caches the summary under the user and the period it covers.
+func (s *ReportStore) SetSummary(ctx context.Context, userID string, summary *entities.ReportSummary) error {
+	key := fmt.Sprintf(reportSummaryCacheKey, userID, summary.Start.Unix(), summary.End.Unix())
+	return s.cacheClient.Set(ctx, key, summary, reportSummaryCacheTTL)
+}
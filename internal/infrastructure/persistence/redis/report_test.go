@@ -0,0 +1,70 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/persistence/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := primitive.NewObjectID().Hex()
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+	key := fmt.Sprintf("user:%s:report_summary:%d:%d", userID, start.Unix(), end.Unix())
+	summary := &entities.ReportSummary{
+		Summary: "You saved 3500.",
+		Start:   start,
+		End:     end,
+		Digest:  "digest",
+	}
+
+	t.Run("GetSummarySuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		reportStore := redis.NewReportStore(mockRedisClient)
+
+		mockData, _ := json.Marshal(summary)
+		mockRedisClient.EXPECT().Get(gomock.Any(), key, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, dest interface{}) error {
+				return json.Unmarshal(mockData, dest)
+			},
+		)
+
+		result, err := reportStore.GetSummary(context.Background(), userID, start, end)
+		require.NoError(t, err)
+		assert.Equal(t, summary, result)
+	})
+
+	t.Run("GetSummaryNotFound", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		reportStore := redis.NewReportStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Get(gomock.Any(), key, gomock.Any()).Return(goredis.Nil)
+
+		result, err := reportStore.GetSummary(context.Background(), userID, start, end)
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("SetSummarySuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		reportStore := redis.NewReportStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Set(gomock.Any(), key, summary, 24*time.Hour).Return(nil)
+
+		err := reportStore.SetSummary(context.Background(), userID, summary)
+		require.NoError(t, err)
+	})
+}
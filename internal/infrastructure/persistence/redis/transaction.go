@@ -2,8 +2,12 @@ package redis
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/Financial-Partner/server/internal/entities"
@@ -11,8 +15,12 @@ import (
 )
 
 const (
-	transactionCacheKey = "user:%s:transactions"
-	transactionCacheTTL = time.Hour * 24
+	// Pages are cached under the user's current cache version, so replacing the version
+	// drops every cached page of the user at once; the stale pages expire on their own.
+	transactionVersionCacheKey = "user:%s:transactions:version"
+	transactionPageCacheKey    = "user:%s:transactions:%s:%s"
+	transactionVersionCacheTTL = time.Hour * 24
+	transactionPageCacheTTL    = time.Hour
 )
 
 type TransactionStore struct {
@@ -23,29 +31,56 @@ func NewTransactionStore(cacheClient RedisClient) *TransactionStore {
 	return &TransactionStore{cacheClient: cacheClient}
 }
 
-func (s *TransactionStore) GetByUserId(ctx context.Context, userID string) ([]entities.Transaction, error) {
-	var transactions []entities.Transaction
-	err := s.cacheClient.Get(ctx, fmt.Sprintf(transactionCacheKey, userID), &transactions)
+func (s *TransactionStore) GetPage(ctx context.Context, userID string, query entities.TransactionQuery) (*entities.TransactionPage, error) {
+	var version string
+	if err := s.cacheClient.Get(ctx, fmt.Sprintf(transactionVersionCacheKey, userID), &version); err != nil {
+		return nil, err
+	}
+
+	key, err := transactionPageKey(userID, version, query)
 	if err != nil {
 		return nil, err
 	}
-	return transactions, nil
+
+	var page entities.TransactionPage
+	if err := s.cacheClient.Get(ctx, key, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
 }
 
-func (s *TransactionStore) SetMultipleByUserId(ctx context.Context, userID string, transactions []entities.Transaction) error {
-	existingTransactions, err := s.GetByUserId(ctx, userID)
-	if err != nil && !errors.Is(err, redis.Nil) {
+// SetPage caches the page of the query under the user's current cache version,
+// starting a new version if the user has none.
+func (s *TransactionStore) SetPage(ctx context.Context, userID string, query entities.TransactionQuery, page *entities.TransactionPage) error {
+	versionKey := fmt.Sprintf(transactionVersionCacheKey, userID)
+
+	var version string
+	err := s.cacheClient.Get(ctx, versionKey, &version)
+	if errors.Is(err, redis.Nil) {
+		version = strconv.FormatInt(time.Now().UnixNano(), 36)
+		err = s.cacheClient.Set(ctx, versionKey, version, transactionVersionCacheTTL)
+	}
+	if err != nil {
 		return err
 	}
 
-	transactions = append(existingTransactions, transactions...)
-	err = s.cacheClient.Set(ctx, fmt.Sprintf(transactionCacheKey, userID), transactions, transactionCacheTTL)
+	key, err := transactionPageKey(userID, version, query)
 	if err != nil {
 		return err
 	}
-	return nil
+	return s.cacheClient.Set(ctx, key, page, transactionPageCacheTTL)
 }
 
+// DeleteByUserId drops every cached page of the user.
 func (s *TransactionStore) DeleteByUserId(ctx context.Context, userID string) error {
-	return s.cacheClient.Delete(ctx, fmt.Sprintf(transactionCacheKey, userID))
+	return s.cacheClient.Delete(ctx, fmt.Sprintf(transactionVersionCacheKey, userID))
+}
+
+func transactionPageKey(userID, version string, query entities.TransactionQuery) (string, error) {
+	data, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf(transactionPageCacheKey, userID, version, hex.EncodeToString(sum[:16])), nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,145 +21,150 @@ func TestTransactionStore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	t.Run("GetByUserIdSuccess", func(t *testing.T) {
-		mockRedisClient := redis.NewMockRedisClient(ctrl)
-		transactionStore := redis.NewTransactionStore(mockRedisClient)
-
-		// Mock data
-		userID := primitive.NewObjectID()
-
-		mockTransactions := []entities.Transaction{
+	userID := primitive.NewObjectID()
+	versionKey := fmt.Sprintf("user:%s:transactions:version", userID.Hex())
+	query := entities.TransactionQuery{Type: entities.TransactionTypeExpense, Limit: 20}
+	page := &entities.TransactionPage{
+		Transactions: []entities.Transaction{
 			{
 				ID:          primitive.NewObjectID(),
 				UserID:      userID,
-				Amount:      100,
+				Amount:      entities.Money{Amount: 100, Currency: "USD"},
 				Description: "Groceries",
-				Date:        time.Now(),
+				Date:        time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
 				Category:    "Food",
 				Type:        "expense",
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
-			},
-			{
-				ID:          primitive.NewObjectID(),
-				UserID:      userID,
-				Amount:      200,
-				Description: "Rent",
-				Date:        time.Now(),
-				Category:    "Housing",
-				Type:        "expense",
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
+				CreatedAt:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
 			},
+		},
+		NextCursor: "cursor",
+	}
+
+	returnVersion := func(version string) func(context.Context, string, interface{}) error {
+		return func(_ context.Context, _ string, dest interface{}) error {
+			*dest.(*string) = version
+			return nil
 		}
+	}
+	pageKeyOf := func(version string) gomock.Matcher {
+		prefix := fmt.Sprintf("user:%s:transactions:%s:", userID.Hex(), version)
+		return gomock.Cond(func(key any) bool {
+			return strings.HasPrefix(key.(string), prefix) && len(key.(string)) > len(prefix)
+		})
+	}
+
+	t.Run("GetPageSuccess", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		transactionStore := redis.NewTransactionStore(mockRedisClient)
 
-		// Serialize mockTransactions to JSON
-		mockData, _ := json.Marshal(mockTransactions)
-
-		// Mock the Get method to return the serialized JSON data
-		mockRedisClient.EXPECT().Get(gomock.Any(), fmt.Sprintf("user:%s:transactions", userID.Hex()), gomock.Any()).DoAndReturn(
+		mockData, _ := json.Marshal(page)
+		mockRedisClient.EXPECT().Get(gomock.Any(), versionKey, gomock.Any()).DoAndReturn(returnVersion("v1"))
+		mockRedisClient.EXPECT().Get(gomock.Any(), pageKeyOf("v1"), gomock.Any()).DoAndReturn(
 			func(_ context.Context, _ string, dest interface{}) error {
 				return json.Unmarshal(mockData, dest)
 			},
 		)
-		transactions, err := transactionStore.GetByUserId(context.Background(), userID.Hex())
+
+		result, err := transactionStore.GetPage(context.Background(), userID.Hex(), query)
 		require.NoError(t, err)
-		assert.NotNil(t, transactions)
+		assert.Equal(t, page, result)
 	})
 
-	t.Run("GetByUserIdNotFound", func(t *testing.T) {
+	t.Run("GetPageWithoutVersion", func(t *testing.T) {
 		mockRedisClient := redis.NewMockRedisClient(ctrl)
 		transactionStore := redis.NewTransactionStore(mockRedisClient)
 
-		userID := primitive.NewObjectID()
-		mockRedisClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(goredis.Nil)
+		mockRedisClient.EXPECT().Get(gomock.Any(), versionKey, gomock.Any()).Return(goredis.Nil)
 
-		transactions, err := transactionStore.GetByUserId(context.Background(), userID.Hex())
-		require.Error(t, err)
-		assert.Nil(t, transactions)
+		result, err := transactionStore.GetPage(context.Background(), userID.Hex(), query)
+		require.ErrorIs(t, err, goredis.Nil)
+		assert.Nil(t, result)
 	})
 
-	t.Run("SetMultipleByUserIdWithExistingTransactions", func(t *testing.T) {
+	t.Run("GetPageNotFound", func(t *testing.T) {
 		mockRedisClient := redis.NewMockRedisClient(ctrl)
 		transactionStore := redis.NewTransactionStore(mockRedisClient)
 
-		// Mock data
-		userID := primitive.NewObjectID()
-		existingTransactions := []entities.Transaction{
-			{
-				ID:          primitive.NewObjectID(),
-				UserID:      userID,
-				Amount:      100,
-				Description: "Groceries",
-				Date:        time.Now(),
-				Category:    "Food",
-				Type:        "expense",
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
-			},
-		}
-		newTransactions := []entities.Transaction{
-			{
-				ID:          primitive.NewObjectID(),
-				UserID:      userID,
-				Amount:      200,
-				Description: "Rent",
-				Date:        time.Now(),
-				Category:    "Housing",
-				Type:        "expense",
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
-			},
-			{
-				ID:          primitive.NewObjectID(),
-				UserID:      userID,
-				Amount:      50,
-				Description: "Utilities",
-				Date:        time.Now(),
-				Category:    "Bills",
-				Type:        "expense",
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
-			},
-		}
+		mockRedisClient.EXPECT().Get(gomock.Any(), versionKey, gomock.Any()).DoAndReturn(returnVersion("v1"))
+		mockRedisClient.EXPECT().Get(gomock.Any(), pageKeyOf("v1"), gomock.Any()).Return(goredis.Nil)
 
-		// Serialize existing transactions to JSON
-		mockData, _ := json.Marshal(existingTransactions)
+		result, err := transactionStore.GetPage(context.Background(), userID.Hex(), query)
+		require.ErrorIs(t, err, goredis.Nil)
+		assert.Nil(t, result)
+	})
 
-		// Mock the Get method to return the existing transactions
-		mockRedisClient.EXPECT().Get(
-			gomock.Any(),
-			fmt.Sprintf("user:%s:transactions", userID.Hex()),
-			gomock.Any(),
-		).DoAndReturn(
-			func(_ context.Context, _ string, dest interface{}) error {
-				return json.Unmarshal(mockData, dest)
-			},
-		)
+	t.Run("SetPageUnderCurrentVersion", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		transactionStore := redis.NewTransactionStore(mockRedisClient)
 
-		// Mock the Set method to save the updated transactions
-		mockRedisClient.EXPECT().Set(
-			gomock.Any(),
-			fmt.Sprintf("user:%s:transactions", userID.Hex()),
-			gomock.Any(),
-			gomock.Any(),
-		).DoAndReturn(
+		mockRedisClient.EXPECT().Get(gomock.Any(), versionKey, gomock.Any()).DoAndReturn(returnVersion("v1"))
+		mockRedisClient.EXPECT().Set(gomock.Any(), pageKeyOf("v1"), page, time.Hour).Return(nil)
+
+		err := transactionStore.SetPage(context.Background(), userID.Hex(), query, page)
+		require.NoError(t, err)
+	})
+
+	t.Run("SetPageStartsVersion", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		transactionStore := redis.NewTransactionStore(mockRedisClient)
+
+		var version string
+		mockRedisClient.EXPECT().Get(gomock.Any(), versionKey, gomock.Any()).Return(goredis.Nil)
+		mockRedisClient.EXPECT().Set(gomock.Any(), versionKey, gomock.Any(), 24*time.Hour).DoAndReturn(
 			func(_ context.Context, _ string, value interface{}, _ time.Duration) error {
+				version = value.(string)
+				return nil
+			},
+		)
+		mockRedisClient.EXPECT().Set(gomock.Any(), gomock.Any(), page, time.Hour).DoAndReturn(
+			func(_ context.Context, key string, _ interface{}, _ time.Duration) error {
+				assert.NotEmpty(t, version)
+				assert.True(t, strings.HasPrefix(key, fmt.Sprintf("user:%s:transactions:%s:", userID.Hex(), version)))
 				return nil
 			},
 		)
 
-		// Call SetMultipleByUserId
-		err := transactionStore.SetMultipleByUserId(context.Background(), userID.Hex(), newTransactions)
+		err := transactionStore.SetPage(context.Background(), userID.Hex(), query, page)
 		require.NoError(t, err)
 	})
 
-	t.Run("DeleteTransactionSuccess", func(t *testing.T) {
+	t.Run("SetPageVersionError", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		transactionStore := redis.NewTransactionStore(mockRedisClient)
+
+		mockRedisClient.EXPECT().Get(gomock.Any(), versionKey, gomock.Any()).Return(assert.AnError)
+
+		err := transactionStore.SetPage(context.Background(), userID.Hex(), query, page)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("PagesOfDifferentQueriesHaveDifferentKeys", func(t *testing.T) {
+		mockRedisClient := redis.NewMockRedisClient(ctrl)
+		transactionStore := redis.NewTransactionStore(mockRedisClient)
+
+		var keys []string
+		mockRedisClient.EXPECT().Get(gomock.Any(), versionKey, gomock.Any()).DoAndReturn(returnVersion("v1")).Times(2)
+		mockRedisClient.EXPECT().Set(gomock.Any(), pageKeyOf("v1"), page, time.Hour).DoAndReturn(
+			func(_ context.Context, key string, _ interface{}, _ time.Duration) error {
+				keys = append(keys, key)
+				return nil
+			},
+		).Times(2)
+
+		next := query
+		next.After = &entities.TransactionCursor{Date: page.Transactions[0].Date, ID: page.Transactions[0].ID}
+		require.NoError(t, transactionStore.SetPage(context.Background(), userID.Hex(), query, page))
+		require.NoError(t, transactionStore.SetPage(context.Background(), userID.Hex(), next, page))
+		require.Len(t, keys, 2)
+		assert.NotEqual(t, keys[0], keys[1])
+	})
+
+	t.Run("DeleteByUserIdDropsVersion", func(t *testing.T) {
 		mockRedisClient := redis.NewMockRedisClient(ctrl)
 		transactionStore := redis.NewTransactionStore(mockRedisClient)
 
-		userID := primitive.NewObjectID()
-		mockRedisClient.EXPECT().Delete(gomock.Any(), fmt.Sprintf("user:%s:transactions", userID.Hex())).Return(nil)
+		mockRedisClient.EXPECT().Delete(gomock.Any(), versionKey).Return(nil)
 
 		err := transactionStore.DeleteByUserId(context.Background(), userID.Hex())
 		require.NoError(t, err)
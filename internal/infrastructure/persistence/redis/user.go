@@ -35,5 +35,5 @@ func (s *UserStore) Set(ctx context.Context, user *entities.User) error {
 }
 
 func (s *UserStore) Delete(ctx context.Context, email string) error {
-	return s.cacheClient.Delete(ctx, email)
+	return s.cacheClient.Delete(ctx, fmt.Sprintf(userCacheKey, email))
 }
@@ -76,7 +76,7 @@ func TestLogin(t *testing.T) {
 			Name:  "Test User",
 			Wallet: entities.Wallet{
 				Diamonds: 100,
-				Savings:  5000,
+				Savings:  entities.Money{Amount: 5000, Currency: "USD"},
 			},
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
@@ -2,13 +2,88 @@ package dto
 
 type GachaResponse struct {
 	ID     string `json:"id" example:"60d6ec33f777b123e4567890"`
+	Name   string `json:"name" example:"Golden piggy bank"`
 	ImgSrc string `json:"img_src" example:"https://example.com/image.png"`
+	Rarity string `json:"rarity" example:"rare"`
 }
 
 type DrawGachaRequest struct {
-	Amount int64 `json:"amount" example:"100" binding:"required"`
+	PoolID string `json:"pool_id,omitempty" example:"60d6ec33f777b123e4567891"`
+	Amount int64  `json:"amount" example:"100" binding:"required"`
+}
+
+type GachaDropRateResponse struct {
+	Rarity string  `json:"rarity" example:"legendary"`
+	Rate   float64 `json:"rate" example:"1.5"`
+}
+
+type GachaPityResponse struct {
+	Rarity    string `json:"rarity" example:"rare"`
+	Threshold int    `json:"threshold" example:"10"`
+	Count     int    `json:"count" example:"3"`
+}
+
+type BatchDrawGachaRequest struct {
+	PoolID string `json:"pool_id,omitempty" example:"60d6ec33f777b123e4567891"`
+	Count  int    `json:"count" example:"10" binding:"required"`
+	Amount int64  `json:"amount" example:"900" binding:"required"`
+}
+
+type GachaDrawResponse struct {
+	ID        string `json:"id" example:"60d6ec33f777b123e4567890"`
+	Name      string `json:"name" example:"Golden piggy bank"`
+	ImgSrc    string `json:"img_src" example:"https://example.com/image.png"`
+	Rarity    string `json:"rarity" example:"rare"`
+	Duplicate bool   `json:"duplicate" example:"false"`
+	Shards    int64  `json:"shards" example:"0"`
+}
+
+type BatchDrawGachaResponse struct {
+	Draws  []GachaDrawResponse `json:"draws"`
+	Shards int64               `json:"shards" example:"6"`
 }
 
 type PreviewGachasResponse struct {
-	Gachas []GachaResponse `json:"gachas"`
+	PoolID    string                  `json:"pool_id" example:"60d6ec33f777b123e4567891"`
+	Cost      int64                   `json:"cost" example:"100"`
+	Gachas    []GachaResponse         `json:"gachas"`
+	DropRates []GachaDropRateResponse `json:"drop_rates"`
+	Pity      GachaPityResponse       `json:"pity"`
+}
+
+type GachaInventoryItemResponse struct {
+	ID      string `json:"id" example:"60d6ec33f777b123e4567892"`
+	PoolID  string `json:"pool_id" example:"60d6ec33f777b123e4567891"`
+	GachaID string `json:"gacha_id" example:"60d6ec33f777b123e4567890"`
+	Name    string `json:"name" example:"Golden piggy bank"`
+	ImgSrc  string `json:"img_src" example:"https://example.com/image.png"`
+	Rarity  string `json:"rarity" example:"rare"`
+	DrawnAt string `json:"drawn_at" example:"2023-01-01T00:00:00Z"`
+}
+
+type GetGachaInventoryResponse struct {
+	Items  []GachaInventoryItemResponse `json:"items"`
+	Shards int64                        `json:"shards" example:"25"`
+}
+
+type CreateGachaItemRequest struct {
+	Name        string `json:"name" example:"Golden piggy bank" binding:"required"`
+	ImgSrc      string `json:"img_src" example:"https://example.com/image.png"`
+	Rarity      string `json:"rarity" example:"rare" binding:"required"`
+	Weight      int    `json:"weight" example:"10" binding:"required"`
+	CharacterID string `json:"character_id,omitempty" example:"piggy"`
+}
+
+type CreateGachaPoolRequest struct {
+	Name  string                   `json:"name" example:"Spring festival" binding:"required"`
+	Cost  int64                    `json:"cost" example:"100" binding:"required"`
+	Items []CreateGachaItemRequest `json:"items" binding:"required"`
+}
+
+type GachaPoolResponse struct {
+	ID        string          `json:"id" example:"60d6ec33f777b123e4567891"`
+	Name      string          `json:"name" example:"Spring festival"`
+	Cost      int64           `json:"cost" example:"100"`
+	Items     []GachaResponse `json:"items"`
+	CreatedAt string          `json:"created_at" example:"2023-01-01T00:00:00Z"`
 }
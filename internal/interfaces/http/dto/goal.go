@@ -7,28 +7,64 @@ type GoalSuggestionRequest struct {
 	WeeklyIncome    int64 `json:"weekly_income" example:"14000" binding:"required"`
 	MonthlyExpenses int64 `json:"monthly_expenses" example:"30000" binding:"required"`
 	MonthlyIncome   int64 `json:"monthly_income" example:"50000" binding:"required"`
+	// Currency of the amounts above, in its minor units; defaults to USD.
+	Currency string `json:"currency,omitempty" example:"TWD"`
 }
 
 type GoalSuggestionResponse struct {
-	SuggestedAmount int64  `json:"suggested_amount" example:"15000"`
+	SuggestedAmount Money  `json:"suggested_amount"`
 	Period          int    `json:"period" example:"30"`
-	Message         string `json:"message" example:"Based on your income and expense analysis, we recommend that you can save 15,000 yuan per month."`
+	Message         string `json:"message" example:"Based on your income and expense analysis, we recommend that you can save 150.00 TWD per month."`
 }
 
 type CreateGoalRequest struct {
-	TargetAmount int64 `json:"target_amount" example:"10000" binding:"required"`
-	Period       int   `json:"period" example:"30" binding:"required"`
+	Name              string `json:"name" example:"Trip to Japan"`
+	TargetAmount      Money  `json:"target_amount" binding:"required"`
+	Period            int    `json:"period" example:"30" binding:"required"`
+	Priority          int    `json:"priority" example:"1"`
+	AllocationPercent int    `json:"allocation_percent" example:"20"`
+}
+
+type UpdateGoalRequest struct {
+	Name              string `json:"name" example:"Trip to Japan"`
+	TargetAmount      Money  `json:"target_amount" binding:"required"`
+	Period            int    `json:"period" example:"30" binding:"required"`
+	Priority          int    `json:"priority" example:"1"`
+	AllocationPercent int    `json:"allocation_percent" example:"20"`
 }
 
 type GoalResponse struct {
-	TargetAmount  int64  `json:"target_amount" example:"10000"`
-	CurrentAmount int64  `json:"current_amount" example:"5000"`
-	Period        int    `json:"period" example:"30"`
-	Status        string `json:"status" example:"Need to work harder"`
-	CreatedAt     string `json:"created_at" example:"2023-01-01T00:00:00Z"`
-	UpdatedAt     string `json:"updated_at" example:"2023-06-01T00:00:00Z"`
+	ID                string `json:"id" example:"60d6ec33f777b123e4567890"`
+	Name              string `json:"name" example:"Trip to Japan"`
+	TargetAmount      Money  `json:"target_amount"`
+	CurrentAmount     Money  `json:"current_amount"`
+	Period            int    `json:"period" example:"30"`
+	Priority          int    `json:"priority" example:"1"`
+	AllocationPercent int    `json:"allocation_percent" example:"20"`
+	Status            string `json:"status" example:"active"`
+	CreatedAt         string `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt         string `json:"updated_at" example:"2023-06-01T00:00:00Z"`
 }
 
 type GetGoalResponse struct {
 	Goal GoalResponse `json:"goal"`
 }
+
+type GetGoalsResponse struct {
+	Goals []GoalResponse `json:"goals"`
+}
+
+type GoalMilestoneResponse struct {
+	GoalID         string `json:"goal_id,omitempty" example:"60d6ec33f777b123e4567890"` // set when milestones of several goals are listed
+	Title          string `json:"title" example:"Halfway there"`
+	TargetPercent  int    `json:"target_percent" example:"50"`
+	Reward         int64  `json:"reward" example:"20"`
+	IsCompleted    bool   `json:"is_completed" example:"true"`
+	CompletedAt    string `json:"completed_at,omitempty" example:"2023-01-15T00:00:00Z"`
+	CharacterID    string `json:"character_id,omitempty" example:"char_001"`
+	RewardWithheld bool   `json:"reward_withheld,omitempty" example:"false"`
+}
+
+type GetGoalMilestonesResponse struct {
+	Milestones []GoalMilestoneResponse `json:"milestones"`
+}
@@ -8,14 +8,19 @@ type OpportunityResponse struct {
 	IsIncrease    bool     `json:"is_increase" example:"true"`
 	Variation     int64    `json:"variation" example:"20"`
 	Duration      string   `json:"duration" example:"a month"`
-	MinAmount     int64    `json:"min_amount" example:"1000"`
+	DurationDays  int      `json:"duration_days" example:"30"`
+	MinAmount     Money    `json:"min_amount"`
 	CreatedAt     string   `json:"created_at" example:"2023-01-01T00:00:00Z"`
 	UpdatedAt     string   `json:"updated_at" example:"2023-06-01T00:00:00Z"`
 }
 
 type InvestmentResponse struct {
 	OpportunityID string `json:"opportunity_id" example:"60d6ec33f777b123e4567890"`
-	Amount        int64  `json:"amount" example:"1000"`
+	Amount        Money  `json:"amount"`
+	Status        string `json:"status" example:"settled"`
+	MaturesAt     string `json:"matures_at" example:"2023-01-31T00:00:00Z"`
+	Payout        *Money `json:"payout,omitempty"`
+	SettledAt     string `json:"settled_at,omitempty" example:"2023-01-31T00:01:00Z"`
 	CreatedAt     string `json:"created_at" example:"2023-01-01T00:00:00Z"`
 	UpdatedAt     string `json:"updated_at" example:"2023-06-01T00:00:00Z"`
 }
@@ -26,7 +31,7 @@ type GetOpportunitiesResponse struct {
 
 type CreateUserInvestmentRequest struct {
 	OpportunityID string `json:"opportunity_id" example:"60d6ec33f777b123e4567890" binding:"required"`
-	Amount        int64  `json:"amount" example:"1000" binding:"required"`
+	Amount        Money  `json:"amount" binding:"required"`
 }
 
 type CreateUserInvestmentResponse struct {
@@ -38,15 +43,42 @@ type GetUserInvestmentsResponse struct {
 }
 
 type CreateOpportunityRequest struct {
-	Title       string   `json:"title" example:"Real Estate" binding:"required"`
-	Description string   `json:"description" example:"Investment in stock market is a good way to make money" binding:"required"`
-	Tags        []string `json:"tags" example:"high risk,long term" binding:"required"`
-	IsIncrease  bool     `json:"is_increase" example:"true" binding:"required"`
-	Variation   int64    `json:"variation" example:"20" binding:"required"`
-	Duration    string   `json:"duration" example:"a month" binding:"required"`
-	MinAmount   int64    `json:"min_amount" example:"1000" binding:"required"`
+	Title        string   `json:"title" example:"Real Estate" binding:"required"`
+	Description  string   `json:"description" example:"Investment in stock market is a good way to make money" binding:"required"`
+	Tags         []string `json:"tags" example:"high risk,long term" binding:"required"`
+	IsIncrease   bool     `json:"is_increase" example:"true" binding:"required"`
+	Variation    int64    `json:"variation" example:"20" binding:"required"`
+	Duration     string   `json:"duration" example:"a month" binding:"required"`
+	DurationDays int      `json:"duration_days" example:"30" binding:"required"`
+	MinAmount    Money    `json:"min_amount" binding:"required"`
 }
 
 type CreateOpportunityResponse struct {
 	Opportunity OpportunityResponse `json:"opportunity"`
 }
+
+type HoldingResponse struct {
+	OpportunityID string   `json:"opportunity_id" example:"60d6ec33f777b123e4567890"`
+	Title         string   `json:"title" example:"Real Estate"`
+	Tags          []string `json:"tags" example:"high risk,long term"`
+	CostBasis     int64    `json:"cost_basis" example:"1000"`
+	CurrentValue  int64    `json:"current_value" example:"1080"`
+	UnrealizedPnL int64    `json:"unrealized_pnl" example:"80"`
+	RealizedPnL   int64    `json:"realized_pnl" example:"200"`
+}
+
+type TagAllocationResponse struct {
+	Tag     string  `json:"tag" example:"high risk"`
+	Value   int64   `json:"value" example:"540"`
+	Percent float64 `json:"percent" example:"50"`
+}
+
+type GetPortfolioResponse struct {
+	Currency      string                  `json:"currency" example:"USD"`
+	Holdings      []HoldingResponse       `json:"holdings"`
+	CostBasis     int64                   `json:"cost_basis" example:"1000"`
+	CurrentValue  int64                   `json:"current_value" example:"1080"`
+	UnrealizedPnL int64                   `json:"unrealized_pnl" example:"80"`
+	RealizedPnL   int64                   `json:"realized_pnl" example:"200"`
+	Allocations   []TagAllocationResponse `json:"allocations"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type PricePointResponse struct {
+	Date  string  `json:"date" example:"2023-01-01"`
+	Price float64 `json:"price" example:"101.25"`
+}
+
+type GetOpportunityPricesResponse struct {
+	OpportunityID string               `json:"opportunity_id" example:"60d6ec33f777b123e4567890"`
+	Prices        []PricePointResponse `json:"prices"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// Money is an amount in the minor units of an ISO 4217 currency, e.g. 1050 USD is $10.50.
+type Money struct {
+	Amount   int64  `json:"amount" example:"1000"`
+	Currency string `json:"currency" example:"USD"`
+}
@@ -1,14 +1,49 @@
 package dto
 
 type ReportResponse struct {
-	Revenue     int64     `json:"revenue" example:"10000" binding:"required"`
-	Expenses    int64     `json:"expenses" example:"5000" binding:"required"`
-	NetProfit   int64     `json:"net_profit" example:"5000" binding:"required"`
-	Categories  []string  `json:"categories" example:"Food,Transport" binding:"required"`
-	Amounts     []int64   `json:"amounts" example:"1000,2000" binding:"required"`
-	Percentages []float64 `json:"percentages" example:"0.33,0.67" binding:"required"`
+	Start       string                   `json:"start" example:"2025-03-01T00:00:00Z"`
+	End         string                   `json:"end" example:"2025-04-01T00:00:00Z"`
+	Currency    string                   `json:"currency" example:"USD"`
+	Revenue     int64                    `json:"revenue" example:"10000" binding:"required"`
+	Expenses    int64                    `json:"expenses" example:"5000" binding:"required"`
+	NetProfit   int64                    `json:"net_profit" example:"5000" binding:"required"`
+	Categories  []string                 `json:"categories" example:"Food,Transport" binding:"required"`
+	Amounts     []int64                  `json:"amounts" example:"1000,2000" binding:"required"`
+	Percentages []float64                `json:"percentages" example:"0.33,0.67" binding:"required"`
+	Interval    string                   `json:"interval" example:"daily"`
+	Series      []ReportBucketResponse   `json:"series"`
+	Previous    ReportComparisonResponse `json:"previous"`
+}
+
+type ReportBucketResponse struct {
+	Start   string `json:"start" example:"2025-03-01T00:00:00Z"`
+	Income  int64  `json:"income" example:"3000"`
+	Expense int64  `json:"expense" example:"1200"`
+	Net     int64  `json:"net" example:"1800"`
+}
+
+type ReportComparisonResponse struct {
+	Revenue         int64    `json:"revenue" example:"8000"`
+	Expenses        int64    `json:"expenses" example:"6000"`
+	NetProfit       int64    `json:"net_profit" example:"2000"`
+	RevenueChange   *float64 `json:"revenue_change,omitempty" example:"25"`
+	ExpensesChange  *float64 `json:"expenses_change,omitempty" example:"-16.67"`
+	NetProfitChange *float64 `json:"net_profit_change,omitempty" example:"150"`
 }
 
 type ReportSummaryResponse struct {
 	Summary string `json:"summary" example:"Report generated by AI"`
+	Start   string `json:"start" example:"2025-03-01T00:00:00Z"`
+	End     string `json:"end" example:"2025-04-01T00:00:00Z"`
+}
+
+type ReportSnapshotResponse struct {
+	ID        string         `json:"id" example:"60d6ec33f777b123e4567890"`
+	Timezone  string         `json:"timezone" example:"Asia/Taipei"`
+	Report    ReportResponse `json:"report"`
+	CreatedAt string         `json:"created_at" example:"2025-03-31T16:05:00Z"`
+}
+
+type ReportHistoryResponse struct {
+	Snapshots []ReportSnapshotResponse `json:"snapshots"`
 }
@@ -1,7 +1,9 @@
 package dto
 
 type TransactionResponse struct {
-	Amount      int    `json:"amount" example:"1000" binding:"required"`
+	ID          string `json:"id" example:"60d6ec33f777b123e4567890"`
+	Amount      Money  `json:"amount" binding:"required"`
+	BaseAmount  Money  `json:"base_amount"` // in the user's base currency
 	Category    string `json:"category" example:"Food" binding:"required"`
 	Type        string `json:"transaction_type" example:"Expense" binding:"required"`
 	Date        string `json:"date" example:"2023-01-01" binding:"required"`
@@ -12,12 +14,77 @@ type TransactionResponse struct {
 
 type GetTransactionsResponse struct {
 	Transactions []TransactionResponse `json:"transactions"`
+	NextCursor   string                `json:"next_cursor,omitempty" example:"AAABhYJ8hQBg1uwz93eyE-RWeJA"`
+}
+
+// GetTransactionsQuery holds the query parameters of a transaction listing as given.
+type GetTransactionsQuery struct {
+	From      string
+	To        string
+	Category  string
+	Type      string
+	MinAmount string
+	MaxAmount string
+	Search    string
+	Sort      string
+	Limit     string
+	Cursor    string
 }
 
 type CreateTransactionRequest struct {
-	Amount      int    `json:"amount" example:"1000" binding:"required"`
+	Amount      Money  `json:"amount" binding:"required"`
+	Category    string `json:"category" example:"Food" binding:"required"`
+	Type        string `json:"transaction_type" example:"Expense" binding:"required"`
+	Date        string `json:"date" example:"2023-01-01" binding:"required"`
+	Description string `json:"description" example:"Lunch" binding:"required"`
+}
+
+type UpdateTransactionRequest struct {
+	Amount      Money  `json:"amount" binding:"required"`
 	Category    string `json:"category" example:"Food" binding:"required"`
 	Type        string `json:"transaction_type" example:"Expense" binding:"required"`
 	Date        string `json:"date" example:"2023-01-01" binding:"required"`
 	Description string `json:"description" example:"Lunch" binding:"required"`
 }
+
+// ImportTransactionsRequest holds the form fields of a bank statement import besides
+// the file itself.
+type ImportTransactionsRequest struct {
+	Format  string // csv, ofx or qfx
+	Mapping *CSVMapping
+	// Currency applies to amounts the statement gives no currency for; it defaults
+	// to the user's base currency.
+	Currency string
+	// Commit saves the new rows; otherwise the import is only previewed.
+	Commit bool
+}
+
+// CSVMapping names the columns of a CSV statement by their header. Amounts are signed,
+// negative for expenses, unless a type column tells income from expenses.
+type CSVMapping struct {
+	Date        string `json:"date" example:"Booking Date"`
+	Amount      string `json:"amount" example:"Amount"`
+	Description string `json:"description,omitempty" example:"Payee"`
+	Category    string `json:"category,omitempty" example:"Category"`
+	Type        string `json:"type,omitempty" example:"Debit/Credit"`
+	Currency    string `json:"currency,omitempty" example:"Currency"`
+	// DateFormat is one of YYYY-MM-DD (the default), YYYY/MM/DD, YYYYMMDD, MM/DD/YYYY,
+	// DD/MM/YYYY and DD.MM.YYYY.
+	DateFormat string `json:"date_format,omitempty" example:"DD/MM/YYYY"`
+}
+
+type ImportTransactionsResponse struct {
+	// Committed tells whether the new rows were saved.
+	Committed  bool                `json:"committed"`
+	New        int                 `json:"new" example:"12"`
+	Duplicates int                 `json:"duplicates" example:"3"`
+	Invalid    int                 `json:"invalid" example:"1"`
+	Rows       []ImportRowResponse `json:"rows"`
+}
+
+type ImportRowResponse struct {
+	Line        int                  `json:"line" example:"2"`
+	Status      string               `json:"status" example:"new"` // new, duplicate or invalid
+	Error       string               `json:"error,omitempty" example:"amount \"12,5\" is not a number"`
+	Transaction *TransactionResponse `json:"transaction,omitempty"`
+}
@@ -10,27 +10,33 @@ type CreateUserResponse struct {
 	Email     string `json:"email" example:"user@example.com"`
 	Name      string `json:"name" example:"User Name"`
 	Diamonds  int64  `json:"diamonds" example:"100"`
-	Savings   int64  `json:"savings" example:"0"`
+	Savings   Money  `json:"savings"`
 	CreatedAt string `json:"created_at" example:"2025-03-07T12:00:00Z"`
 }
 
 type UpdateUserRequest struct {
-	Name string `json:"name" binding:"required" example:"New User Name"`
+	Name     string `json:"name,omitempty" example:"New User Name"`
+	Currency string `json:"currency,omitempty" example:"EUR"` // only while savings are empty
 }
 
 type UpdateUserResponse struct {
-	ID        string `json:"id" example:"60d6ec33f777b123e4567890"`
-	Email     string `json:"email" example:"user@example.com"`
-	Name      string `json:"name" example:"New User Name"`
-	Diamonds  int64  `json:"diamonds" example:"100"`
-	Savings   int64  `json:"savings" example:"5000"`
-	UpdatedAt string `json:"updated_at" example:"2025-03-07T12:00:00Z"`
+	ID        string             `json:"id" example:"60d6ec33f777b123e4567890"`
+	Email     string             `json:"email" example:"user@example.com"`
+	Name      string             `json:"name" example:"New User Name"`
+	Currency  string             `json:"currency" example:"EUR"`
+	Diamonds  int64              `json:"diamonds" example:"100"`
+	Savings   Money              `json:"savings"`
+	Character *CharacterResponse `json:"character,omitempty"`
+	UpdatedAt string             `json:"updated_at" example:"2025-03-07T12:00:00Z"`
 }
 
 type GetUserResponse struct {
 	ID        string             `json:"id" example:"60d6ec33f777b123e4567890"`
 	Email     *string            `json:"email,omitempty" example:"user@example.com"`
 	Name      *string            `json:"name,omitempty" example:"User Name"`
+	Role      *string            `json:"role,omitempty" example:"user"`
+	Timezone  *string            `json:"timezone,omitempty" example:"Asia/Taipei"`
+	Currency  *string            `json:"currency,omitempty" example:"USD"`
 	Wallet    *WalletResponse    `json:"wallet,omitempty"`
 	Character *CharacterResponse `json:"character,omitempty"`
 	CreatedAt string             `json:"created_at" example:"2025-03-07T12:00:00Z"`
@@ -39,7 +45,7 @@ type GetUserResponse struct {
 
 type WalletResponse struct {
 	Diamonds int64 `json:"diamonds" example:"100"`
-	Savings  int64 `json:"savings" example:"5000"`
+	Savings  Money `json:"savings"`
 }
 
 type CharacterResponse struct {
@@ -47,3 +53,21 @@ type CharacterResponse struct {
 	Name     string `json:"name" example:"Character Name"`
 	ImageURL string `json:"image_url" example:"https://example.com/characters/advisor.png"`
 }
+
+type GetCharactersResponse struct {
+	Characters []CharacterResponse `json:"characters"`
+}
+
+type EquipCharacterRequest struct {
+	CharacterID string `json:"character_id" binding:"required" example:"char_001"`
+}
+
+type UpdateTimezoneRequest struct {
+	Timezone string `json:"timezone" binding:"required" example:"Asia/Taipei"`
+}
+
+type CreateCharacterRequest struct {
+	ID       string `json:"id" binding:"required" example:"char_001"`
+	Name     string `json:"name" binding:"required" example:"Character Name"`
+	ImageURL string `json:"image_url" example:"https://example.com/characters/advisor.png"`
+}
@@ -13,19 +13,68 @@ const (
 	ErrFailedToCreateUser           = "Failed to create user"
 	ErrFailedToUpdateUser           = "Failed to update user"
 	ErrFailedToGetUser              = "Failed to get user"
+	ErrFailedToGetCharacters        = "Failed to get characters"
+	ErrFailedToEquipCharacter       = "Failed to equip character"
+	ErrCharacterNotFound            = "Character not found"
+	ErrCharacterNotOwned            = "Character has not been unlocked"
+	ErrFailedToCreateCharacter      = "Failed to create character"
+	ErrCharacterExists              = "Character already exists"
 	ErrFailedToLogout               = "Failed to logout"
 	ErrFailedToGetGoalSuggestion    = "Failed to get goal suggestion"
 	ErrFailedToCreateGoal           = "Failed to create goal"
 	ErrFailedToUpdateGoal           = "Failed to update goal"
 	ErrFailedToGetGoal              = "Failed to get goal"
+	ErrFailedToGetGoals             = "Failed to get goals"
+	ErrFailedToDeleteGoal           = "Failed to delete goal"
+	ErrGoalNotFound                 = "Goal not found"
+	ErrGoalNotActive                = "Goal is no longer active"
+	ErrGoalAllocationExceeded       = "Allocation percents of active goals exceed 100"
+	ErrFailedToGetGoalMilestones    = "Failed to get goal milestones"
 	ErrFailedToGetOpportunities     = "Failed to get investment opportunities"
 	ErrFailedToCreateOpportunity    = "Failed to create investment opportunity"
 	ErrFailedToCreateUserInvestment = "Failed to create an user investment"
 	ErrFailedToGetUserInvestments   = "Failed to get user investments"
+	ErrInvalidOpportunityID         = "Invalid opportunity ID"
+	ErrOpportunityNotFound          = "Investment opportunity not found"
+	ErrAmountBelowMinimum           = "Amount is below the opportunity's minimum"
+	ErrInsufficientSavings          = "Not enough savings"
+	ErrCurrencyMismatch             = "Amount must be in the opportunity's currency"
+	ErrSavingsCurrency              = "Opportunity is in another currency than your savings"
+	ErrInvalidOpportunity           = "Duration days must be positive and variation and minimum amount must not be negative"
+	ErrInvalidCurrency              = "Currency must be an ISO 4217 code such as USD"
+	ErrCurrencyLocked               = "Currency can only change while savings are empty"
+	ErrFailedToGetPrices            = "Failed to get opportunity prices"
+	ErrFailedToGetPortfolio         = "Failed to get portfolio"
 	ErrFailedToGetTransactions      = "Failed to get transactions"
 	ErrFailedToCreateTransaction    = "Failed to create a transaction"
+	ErrFailedToGetTransaction       = "Failed to get transaction"
+	ErrFailedToUpdateTransaction    = "Failed to update transaction"
+	ErrFailedToDeleteTransaction    = "Failed to delete transaction"
+	ErrTransactionNotFound          = "Transaction not found"
+	ErrInvalidTransactionDate       = "Transaction date must be formatted as YYYY-MM-DD"
+	ErrInvalidTransactionAmount     = "Transaction amount needs an ISO 4217 currency such as USD"
+	ErrExchangeRateNotFound         = "No exchange rate into your base currency is known for the transaction's currency and date"
+	ErrFailedToImportTransactions   = "Failed to import transactions"
+	ErrInvalidStatement             = "Statement must be an OFX or QFX file, or a CSV file with the columns named by the mapping"
+	ErrStatementTooLarge            = "Statement must be at most 5 MB with at most 1000 transactions"
 	ErrFailedToDrawGacha            = "Failed to draw a gacha"
 	ErrFailedToPreviewGachas        = "Failed to preview gachas"
+	ErrFailedToGetGachaInventory    = "Failed to get gacha inventory"
+	ErrGachaPoolNotFound            = "Gacha pool not found"
+	ErrFailedToCreateGachaPool      = "Failed to create gacha pool"
+	ErrInvalidGachaPool             = "Gacha pool needs a positive cost and drawable items of known rarities"
+	ErrInvalidDrawAmount            = "Draw amount must equal the pool's cost"
+	ErrInvalidDrawCount             = "Invalid number of draws"
+	ErrInsufficientDiamonds         = "Not enough diamonds"
 	ErrFailedToGetReport            = "Failed to get report"
 	ErrFailedToGetReportSummary     = "Failed to get report summary"
+	ErrInvalidReportType            = "Report type must be daily, weekly, monthly or yearly"
+	ErrInvalidReportPeriod          = "Report end must be after its start"
+	ErrInvalidReportInterval        = "Interval must be daily, weekly, monthly or yearly and split the report into at most 366 buckets"
+	ErrInvalidTimezone              = "Timezone must be an IANA timezone name such as Asia/Taipei"
+	ErrFailedToUpdateTimezone       = "Failed to update timezone"
+	ErrFailedToGetReportHistory     = "Failed to get report history"
+	ErrFailedToExportReport         = "Failed to export report"
+	ErrInvalidExportFormat          = "Export format must be csv or pdf"
+	ErrExportNotAcceptable          = "Report can only be exported as text/csv or application/pdf"
 )
@@ -3,31 +3,40 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/Financial-Partner/server/internal/contextutil"
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
 	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+	gacha_domain "github.com/Financial-Partner/server/internal/module/gacha/domain"
 )
 
 //go:generate mockgen -source=gacha.go -destination=gacha_mock.go -package=handler
 
 type GachaService interface {
-	DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.Gacha, error)
-	PreviewGachas(ctx context.Context, userID string) ([]entities.Gacha, error)
+	DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.GachaDraw, error)
+	DrawGachaBatch(ctx context.Context, userID string, req *dto.BatchDrawGachaRequest) ([]entities.GachaDraw, error)
+	PreviewGachas(ctx context.Context, userID, poolID string) (*entities.GachaPreview, error)
+	GetInventory(ctx context.Context, userID string) (*entities.GachaInventory, error)
+	CreatePool(ctx context.Context, req *dto.CreateGachaPoolRequest) (*entities.GachaPool, error)
 }
 
-// @Summary Decrease user's gacha amount and return gacha result
-// @Description Decrease user's gacha amount and return gacha result
+// @Summary Spend diamonds to draw a gacha
+// @Description Debit the pool's cost from the user's diamonds and add a randomly drawn item to their inventory, or shards if they already own it. The latest pool is used when no pool is given.
 // @Tags gacha
 // @Accept json
 // @Produce json
 // @Param request body dto.DrawGachaRequest true "Draw gacha request"
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
-// @Success 200 {object} dto.GachaResponse
+// @Success 200 {object} dto.GachaDrawResponse
+// @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /gacha/draw [post]
 func (h *Handler) DrawGacha(w http.ResponseWriter, r *http.Request) {
@@ -45,29 +54,72 @@ func (h *Handler) DrawGacha(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	gacha, err := h.gachaService.DrawGacha(r.Context(), userID, &req)
+	draw, err := h.gachaService.DrawGacha(r.Context(), userID, &req)
 	if err != nil {
-		h.log.WithError(err).Warnf("failed to draw a gacha")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToDrawGacha, http.StatusInternalServerError)
+		h.respondWithGachaError(w, r, err, httperror.ErrFailedToDrawGacha)
 		return
 	}
 
-	resp := dto.GachaResponse{
-		ID:     gacha.ID.Hex(),
-		ImgSrc: gacha.ImgSrc,
+	respond.WithJSON(w, r, buildGachaDrawResponse(draw), http.StatusOK)
+}
+
+// @Summary Spend diamonds to draw several gachas at once
+// @Description Make up to 10 draws from a pool at a discount. Either all draws succeed or no diamonds are spent. Duplicates are converted into shards.
+// @Tags gacha
+// @Accept json
+// @Produce json
+// @Param request body dto.BatchDrawGachaRequest true "Batch draw gacha request"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.BatchDrawGachaResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /gacha/draw/batch [post]
+func (h *Handler) DrawGachaBatch(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.BatchDrawGachaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.WithError(err).Warnf("failed to decode request body")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	draws, err := h.gachaService.DrawGachaBatch(r.Context(), userID, &req)
+	if err != nil {
+		h.respondWithGachaError(w, r, err, httperror.ErrFailedToDrawGacha)
+		return
+	}
+
+	resp := dto.BatchDrawGachaResponse{
+		Draws: make([]dto.GachaDrawResponse, 0, len(draws)),
+	}
+
+	for i := range draws {
+		resp.Draws = append(resp.Draws, buildGachaDrawResponse(&draws[i]))
+		resp.Shards += draws[i].Shards
 	}
 
 	respond.WithJSON(w, r, resp, http.StatusOK)
 }
 
 // @Summary Get 9 gacha images for preview
-// @Description Get 9 gacha images for preview
+// @Description Get up to 9 items of a gacha pool with the pool's drop rates and the user's pity progress. The latest pool is previewed when no pool is given.
 // @Tags gacha
 // @Accept json
 // @Produce json
+// @Param pool_id query string false "Gacha pool ID"
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
 // @Success 200 {object} dto.PreviewGachasResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /gacha/preview [get]
 func (h *Handler) PreviewGachas(w http.ResponseWriter, r *http.Request) {
@@ -78,23 +130,159 @@ func (h *Handler) PreviewGachas(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	gachas, err := h.gachaService.PreviewGachas(r.Context(), userID)
+	preview, err := h.gachaService.PreviewGachas(r.Context(), userID, r.URL.Query().Get("pool_id"))
 	if err != nil {
-		h.log.WithError(err).Warnf("failed to get preview gacha images")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToPreviewGachas, http.StatusInternalServerError)
+		h.respondWithGachaError(w, r, err, httperror.ErrFailedToPreviewGachas)
 		return
 	}
 
 	resp := dto.PreviewGachasResponse{
-		Gachas: make([]dto.GachaResponse, 0, 9),
+		PoolID:    preview.PoolID.Hex(),
+		Cost:      preview.Cost,
+		Gachas:    make([]dto.GachaResponse, 0, len(preview.Items)),
+		DropRates: make([]dto.GachaDropRateResponse, 0, len(preview.DropRates)),
+		Pity: dto.GachaPityResponse{
+			Rarity:    preview.PityRarity,
+			Threshold: preview.PityThreshold,
+			Count:     preview.PityCount,
+		},
+	}
+
+	for i := range preview.Items {
+		resp.Gachas = append(resp.Gachas, buildGachaResponse(&preview.Items[i]))
+	}
+	for _, rate := range preview.DropRates {
+		resp.DropRates = append(resp.DropRates, dto.GachaDropRateResponse{
+			Rarity: rate.Rarity,
+			Rate:   rate.Rate,
+		})
+	}
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
+
+// @Summary Get the user's gacha inventory
+// @Description List the items the user has drawn, newest first, and their shard balance
+// @Tags gacha
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GetGachaInventoryResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /gacha/inventory [get]
+func (h *Handler) GetGachaInventory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	inventory, err := h.gachaService.GetInventory(r.Context(), userID)
+	if err != nil {
+		h.log.WithError(err).Warnf("failed to get gacha inventory")
+		respond.WithError(w, r, h.log, err, httperror.ErrFailedToGetGachaInventory, http.StatusInternalServerError)
+		return
+	}
+
+	resp := dto.GetGachaInventoryResponse{
+		Items:  make([]dto.GachaInventoryItemResponse, 0, len(inventory.Items)),
+		Shards: inventory.Shards,
 	}
 
-	for _, gacha := range gachas {
-		resp.Gachas = append(resp.Gachas, dto.GachaResponse{
-			ID:     gacha.ID.Hex(),
-			ImgSrc: gacha.ImgSrc,
+	for _, item := range inventory.Items {
+		resp.Items = append(resp.Items, dto.GachaInventoryItemResponse{
+			ID:      item.ID.Hex(),
+			PoolID:  item.PoolID.Hex(),
+			GachaID: item.GachaID.Hex(),
+			Name:    item.Name,
+			ImgSrc:  item.ImgSrc,
+			Rarity:  item.Rarity,
+			DrawnAt: item.DrawnAt.Format(time.RFC3339),
 		})
 	}
 
 	respond.WithJSON(w, r, resp, http.StatusOK)
 }
+
+// @Summary Create a gacha pool
+// @Description Add a pool of items that can be drawn for a fixed diamond cost. Admin only.
+// @Tags gacha
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateGachaPoolRequest true "Create gacha pool request"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GachaPoolResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse "Admin role required"
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /gacha/pools [post]
+func (h *Handler) CreateGachaPool(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateGachaPoolRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.WithError(err).Warnf("failed to decode request body")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	pool, err := h.gachaService.CreatePool(r.Context(), &req)
+	if err != nil {
+		h.respondWithGachaError(w, r, err, httperror.ErrFailedToCreateGachaPool)
+		return
+	}
+
+	resp := dto.GachaPoolResponse{
+		ID:        pool.ID.Hex(),
+		Name:      pool.Name,
+		Cost:      pool.Cost,
+		Items:     make([]dto.GachaResponse, 0, len(pool.Items)),
+		CreatedAt: pool.CreatedAt.Format(time.RFC3339),
+	}
+	for _, item := range pool.Items {
+		resp.Items = append(resp.Items, buildGachaResponse(&item))
+	}
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
+
+// respondWithGachaError maps gacha domain errors to their HTTP status and anything else
+// to an internal error with the given message.
+func (h *Handler) respondWithGachaError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case errors.Is(err, gacha_domain.ErrInvalidDrawAmount):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidDrawAmount, http.StatusBadRequest)
+	case errors.Is(err, gacha_domain.ErrInvalidDrawCount):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidDrawCount, http.StatusBadRequest)
+	case errors.Is(err, gacha_domain.ErrPoolNotFound):
+		respond.WithError(w, r, h.log, err, httperror.ErrGachaPoolNotFound, http.StatusNotFound)
+	case errors.Is(err, gacha_domain.ErrInvalidPool):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidGachaPool, http.StatusBadRequest)
+	case errors.Is(err, gacha_domain.ErrInsufficientDiamonds):
+		respond.WithError(w, r, h.log, err, httperror.ErrInsufficientDiamonds, http.StatusConflict)
+	default:
+		h.log.WithError(err).Warnf("gacha request failed")
+		respond.WithError(w, r, h.log, err, message, http.StatusInternalServerError)
+	}
+}
+
+func buildGachaResponse(gacha *entities.Gacha) dto.GachaResponse {
+	return dto.GachaResponse{
+		ID:     gacha.ID.Hex(),
+		Name:   gacha.Name,
+		ImgSrc: gacha.ImgSrc,
+		Rarity: gacha.Rarity,
+	}
+}
+
+func buildGachaDrawResponse(draw *entities.GachaDraw) dto.GachaDrawResponse {
+	return dto.GachaDrawResponse{
+		ID:        draw.Gacha.ID.Hex(),
+		Name:      draw.Gacha.Name,
+		ImgSrc:    draw.Gacha.ImgSrc,
+		Rarity:    draw.Gacha.Rarity,
+		Duplicate: draw.Duplicate,
+		Shards:    draw.Shards,
+	}
+}
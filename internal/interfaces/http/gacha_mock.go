@@ -42,11 +42,26 @@ func (m *MockGachaService) EXPECT() *MockGachaServiceMockRecorder {
 	return m.recorder
 }
 
+// CreatePool mocks base method.
+func (m *MockGachaService) CreatePool(ctx context.Context, req *dto.CreateGachaPoolRequest) (*entities.GachaPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePool", ctx, req)
+	ret0, _ := ret[0].(*entities.GachaPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePool indicates an expected call of CreatePool.
+func (mr *MockGachaServiceMockRecorder) CreatePool(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePool", reflect.TypeOf((*MockGachaService)(nil).CreatePool), ctx, req)
+}
+
 // DrawGacha mocks base method.
-func (m *MockGachaService) DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.Gacha, error) {
+func (m *MockGachaService) DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.GachaDraw, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DrawGacha", ctx, userID, req)
-	ret0, _ := ret[0].(*entities.Gacha)
+	ret0, _ := ret[0].(*entities.GachaDraw)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -57,17 +72,47 @@ func (mr *MockGachaServiceMockRecorder) DrawGacha(ctx, userID, req any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrawGacha", reflect.TypeOf((*MockGachaService)(nil).DrawGacha), ctx, userID, req)
 }
 
+// DrawGachaBatch mocks base method.
+func (m *MockGachaService) DrawGachaBatch(ctx context.Context, userID string, req *dto.BatchDrawGachaRequest) ([]entities.GachaDraw, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrawGachaBatch", ctx, userID, req)
+	ret0, _ := ret[0].([]entities.GachaDraw)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrawGachaBatch indicates an expected call of DrawGachaBatch.
+func (mr *MockGachaServiceMockRecorder) DrawGachaBatch(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrawGachaBatch", reflect.TypeOf((*MockGachaService)(nil).DrawGachaBatch), ctx, userID, req)
+}
+
+// GetInventory mocks base method.
+func (m *MockGachaService) GetInventory(ctx context.Context, userID string) (*entities.GachaInventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventory", ctx, userID)
+	ret0, _ := ret[0].(*entities.GachaInventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventory indicates an expected call of GetInventory.
+func (mr *MockGachaServiceMockRecorder) GetInventory(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventory", reflect.TypeOf((*MockGachaService)(nil).GetInventory), ctx, userID)
+}
+
 // PreviewGachas mocks base method.
-func (m *MockGachaService) PreviewGachas(ctx context.Context, userID string) ([]entities.Gacha, error) {
+func (m *MockGachaService) PreviewGachas(ctx context.Context, userID, poolID string) (*entities.GachaPreview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PreviewGachas", ctx, userID)
-	ret0, _ := ret[0].([]entities.Gacha)
+	ret := m.ctrl.Call(m, "PreviewGachas", ctx, userID, poolID)
+	ret0, _ := ret[0].(*entities.GachaPreview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PreviewGachas indicates an expected call of PreviewGachas.
-func (mr *MockGachaServiceMockRecorder) PreviewGachas(ctx, userID any) *gomock.Call {
+func (mr *MockGachaServiceMockRecorder) PreviewGachas(ctx, userID, poolID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewGachas", reflect.TypeOf((*MockGachaService)(nil).PreviewGachas), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewGachas", reflect.TypeOf((*MockGachaService)(nil).PreviewGachas), ctx, userID, poolID)
 }
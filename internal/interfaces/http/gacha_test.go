@@ -9,12 +9,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/Financial-Partner/server/internal/contextutil"
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	gacha_domain "github.com/Financial-Partner/server/internal/module/gacha/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/mock/gomock"
 )
@@ -99,6 +102,42 @@ func TestDrawGacha(t *testing.T) {
 		assert.Equal(t, httperror.ErrFailedToDrawGacha, errorResp.Message)
 	})
 
+	t.Run("Domain errors", func(t *testing.T) {
+		testCases := []struct {
+			err     error
+			code    int
+			message string
+		}{
+			{gacha_domain.ErrInvalidDrawAmount, http.StatusBadRequest, httperror.ErrInvalidDrawAmount},
+			{gacha_domain.ErrPoolNotFound, http.StatusNotFound, httperror.ErrGachaPoolNotFound},
+			{gacha_domain.ErrInsufficientDiamonds, http.StatusConflict, httperror.ErrInsufficientDiamonds},
+		}
+
+		for _, tc := range testCases {
+			h, mockServices := newTestHandler(t)
+
+			userID := primitive.NewObjectID().Hex()
+
+			mockServices.GachaService.EXPECT().
+				DrawGacha(gomock.Any(), userID, gomock.Any()).
+				Return(nil, tc.err)
+
+			body, _ := json.Marshal(dto.DrawGachaRequest{Amount: 100})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/gacha/draw", bytes.NewBuffer(body))
+			r = r.WithContext(newContext(userID, "test@example.com"))
+
+			h.DrawGacha(w, r)
+
+			assert.Equal(t, tc.code, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.message, errorResp.Message)
+		}
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 
@@ -108,14 +147,18 @@ func TestDrawGacha(t *testing.T) {
 		objectID := primitive.NewObjectID()
 		gacha := &entities.Gacha{
 			ID:     objectID,
+			Name:   "Golden piggy bank",
 			ImgSrc: "https://example.com/image.png",
+			Rarity: entities.GachaRarityRare,
 		}
 
+		poolID := primitive.NewObjectID().Hex()
 		mockServices.GachaService.EXPECT().
-			DrawGacha(gomock.Any(), userID, gomock.Any()).
-			Return(gacha, nil)
+			DrawGacha(gomock.Any(), userID, &dto.DrawGachaRequest{PoolID: poolID, Amount: 100}).
+			Return(&entities.GachaDraw{Gacha: *gacha, Duplicate: true, Shards: 5}, nil)
 
 		req := dto.DrawGachaRequest{
+			PoolID: poolID,
 			Amount: 100,
 		}
 		body, _ := json.Marshal(req)
@@ -129,11 +172,109 @@ func TestDrawGacha(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
 
-		var response dto.GachaResponse
+		var response dto.GachaDrawResponse
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
 		assert.Equal(t, gacha.ID.Hex(), response.ID)
 		assert.Equal(t, gacha.ImgSrc, response.ImgSrc)
+		assert.Equal(t, gacha.Rarity, response.Rarity)
+		assert.True(t, response.Duplicate)
+		assert.Equal(t, int64(5), response.Shards)
+	})
+}
+
+func TestDrawGachaBatch(t *testing.T) {
+	t.Run("Invalid request format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/gacha/draw/batch", bytes.NewBufferString(`{invalid json`))
+		r = r.WithContext(newContext(primitive.NewObjectID().Hex(), "test@example.com"))
+
+		h.DrawGachaBatch(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		body, _ := json.Marshal(dto.BatchDrawGachaRequest{Count: 10, Amount: 900})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/gacha/draw/batch", bytes.NewBuffer(body))
+
+		h.DrawGachaBatch(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUnauthorized, errorResp.Message)
+	})
+
+	t.Run("Invalid count", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.GachaService.EXPECT().
+			DrawGachaBatch(gomock.Any(), userID, gomock.Any()).
+			Return(nil, gacha_domain.ErrInvalidDrawCount)
+
+		body, _ := json.Marshal(dto.BatchDrawGachaRequest{Count: 50, Amount: 4500})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/gacha/draw/batch", bytes.NewBuffer(body))
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.DrawGachaBatch(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidDrawCount, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		common := entities.Gacha{ID: primitive.NewObjectID(), Name: "Piggy bank", Rarity: entities.GachaRarityCommon}
+		rare := entities.Gacha{ID: primitive.NewObjectID(), Name: "Silver coin", Rarity: entities.GachaRarityRare}
+
+		mockServices.GachaService.EXPECT().
+			DrawGachaBatch(gomock.Any(), userID, &dto.BatchDrawGachaRequest{Count: 3, Amount: 270}).
+			Return([]entities.GachaDraw{
+				{Gacha: common},
+				{Gacha: rare, Duplicate: true, Shards: 5},
+				{Gacha: common, Duplicate: true, Shards: 1},
+			}, nil)
+
+		body, _ := json.Marshal(dto.BatchDrawGachaRequest{Count: 3, Amount: 270})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/gacha/draw/batch", bytes.NewBuffer(body))
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.DrawGachaBatch(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.BatchDrawGachaResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		require.Len(t, response.Draws, 3)
+		assert.Equal(t, common.ID.Hex(), response.Draws[0].ID)
+		assert.False(t, response.Draws[0].Duplicate)
+		assert.Equal(t, rare.Name, response.Draws[1].Name)
+		assert.True(t, response.Draws[1].Duplicate)
+		assert.Equal(t, int64(6), response.Shards)
 	})
 }
 
@@ -166,7 +307,7 @@ func TestPreviewGacha(t *testing.T) {
 		userEmail := "test@example.com"
 
 		mockServices.GachaService.EXPECT().
-			PreviewGachas(gomock.Any(), userID).
+			PreviewGachas(gomock.Any(), userID, "").
 			Return(nil, errors.New("service error"))
 
 		w := httptest.NewRecorder()
@@ -201,9 +342,19 @@ func TestPreviewGacha(t *testing.T) {
 			}
 		}
 
+		preview := &entities.GachaPreview{
+			PoolID:        primitive.NewObjectID(),
+			Cost:          100,
+			Items:         gachas,
+			DropRates:     []entities.GachaDropRate{{Rarity: entities.GachaRarityCommon, Rate: 90}, {Rarity: entities.GachaRarityRare, Rate: 10}},
+			PityRarity:    entities.GachaRarityRare,
+			PityThreshold: 10,
+			PityCount:     3,
+		}
+
 		mockServices.GachaService.EXPECT().
-			PreviewGachas(gomock.Any(), userID).
-			Return(gachas, nil)
+			PreviewGachas(gomock.Any(), userID, "").
+			Return(preview, nil)
 
 		w := httptest.NewRecorder()
 		r := httptest.NewRequest("GET", "/gacha/preview", nil)
@@ -223,5 +374,212 @@ func TestPreviewGacha(t *testing.T) {
 			assert.Equal(t, gacha.ID.Hex(), response.Gachas[i].ID)
 			assert.Equal(t, gacha.ImgSrc, response.Gachas[i].ImgSrc)
 		}
+		assert.Equal(t, preview.PoolID.Hex(), response.PoolID)
+		assert.Equal(t, int64(100), response.Cost)
+		assert.Equal(t, []dto.GachaDropRateResponse{{Rarity: "common", Rate: 90}, {Rarity: "rare", Rate: 10}}, response.DropRates)
+		assert.Equal(t, dto.GachaPityResponse{Rarity: "rare", Threshold: 10, Count: 3}, response.Pity)
+	})
+}
+
+func TestPreviewGachaPoolNotFound(t *testing.T) {
+	h, mockServices := newTestHandler(t)
+
+	userID := primitive.NewObjectID().Hex()
+	poolID := primitive.NewObjectID().Hex()
+
+	mockServices.GachaService.EXPECT().
+		PreviewGachas(gomock.Any(), userID, poolID).
+		Return(nil, gacha_domain.ErrPoolNotFound)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/gacha/preview?pool_id="+poolID, nil)
+	r = r.WithContext(newContext(userID, "test@example.com"))
+
+	h.PreviewGachas(w, r)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	var errorResp dto.ErrorResponse
+	err := json.NewDecoder(w.Body).Decode(&errorResp)
+	assert.NoError(t, err)
+	assert.Equal(t, httperror.ErrGachaPoolNotFound, errorResp.Message)
+}
+
+func TestGetGachaInventory(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/gacha/inventory", nil)
+
+		h.GetGachaInventory(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUnauthorized, errorResp.Message)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.GachaService.EXPECT().
+			GetInventory(gomock.Any(), userID).
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/gacha/inventory", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetGachaInventory(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetGachaInventory, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID()
+		item := entities.GachaInventoryItem{
+			ID:      primitive.NewObjectID(),
+			UserID:  userID,
+			PoolID:  primitive.NewObjectID(),
+			GachaID: primitive.NewObjectID(),
+			Name:    "Golden piggy bank",
+			ImgSrc:  "https://example.com/image.png",
+			Rarity:  entities.GachaRarityRare,
+			DrawnAt: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		mockServices.GachaService.EXPECT().
+			GetInventory(gomock.Any(), userID.Hex()).
+			Return(&entities.GachaInventory{Items: []entities.GachaInventoryItem{item}, Shards: 25}, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/gacha/inventory", nil)
+		r = r.WithContext(newContext(userID.Hex(), "test@example.com"))
+
+		h.GetGachaInventory(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetGachaInventoryResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.GachaInventoryItemResponse{{
+			ID:      item.ID.Hex(),
+			PoolID:  item.PoolID.Hex(),
+			GachaID: item.GachaID.Hex(),
+			Name:    item.Name,
+			ImgSrc:  item.ImgSrc,
+			Rarity:  item.Rarity,
+			DrawnAt: "2023-01-01T00:00:00Z",
+		}}, response.Items)
+		assert.Equal(t, int64(25), response.Shards)
+	})
+}
+
+func TestCreateGachaPool(t *testing.T) {
+	newRequest := func() dto.CreateGachaPoolRequest {
+		return dto.CreateGachaPoolRequest{
+			Name: "Spring festival",
+			Cost: 100,
+			Items: []dto.CreateGachaItemRequest{
+				{Name: "Piggy bank", ImgSrc: "https://example.com/piggy.png", Rarity: entities.GachaRarityCommon, Weight: 90},
+			},
+		}
+	}
+
+	t.Run("Invalid request format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/gacha/pools", bytes.NewBufferString(`{invalid json`))
+
+		h.CreateGachaPool(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantMsg    string
+	}{
+		{"Invalid pool", gacha_domain.ErrInvalidPool, http.StatusBadRequest, httperror.ErrInvalidGachaPool},
+		{"Create pool failed", errors.New("db error"), http.StatusInternalServerError, httperror.ErrFailedToCreateGachaPool},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, mockServices := newTestHandler(t)
+			req := newRequest()
+
+			mockServices.GachaService.EXPECT().
+				CreatePool(gomock.Any(), &req).
+				Return(nil, tc.err)
+
+			body, _ := json.Marshal(req)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/gacha/pools", bytes.NewBuffer(body))
+
+			h.CreateGachaPool(w, r)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMsg, errorResp.Message)
+		})
+	}
+
+	t.Run("Create pool successful", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		req := newRequest()
+
+		pool := &entities.GachaPool{
+			ID:   primitive.NewObjectID(),
+			Name: req.Name,
+			Cost: req.Cost,
+			Items: []entities.Gacha{
+				{ID: primitive.NewObjectID(), Name: "Piggy bank", ImgSrc: "https://example.com/piggy.png", Rarity: entities.GachaRarityCommon, Weight: 90},
+			},
+			CreatedAt: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+		mockServices.GachaService.EXPECT().
+			CreatePool(gomock.Any(), &req).
+			Return(pool, nil)
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/gacha/pools", bytes.NewBuffer(body))
+
+		h.CreateGachaPool(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GachaPoolResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.Equal(t, pool.ID.Hex(), response.ID)
+		assert.Equal(t, int64(100), response.Cost)
+		assert.Equal(t, "2023-01-01T00:00:00Z", response.CreatedAt)
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, pool.Items[0].ID.Hex(), response.Items[0].ID)
 	})
 }
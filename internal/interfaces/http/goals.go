@@ -3,23 +3,31 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
+	"github.com/gorilla/mux"
+
 	"github.com/Financial-Partner/server/internal/contextutil"
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
 	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+	goal_domain "github.com/Financial-Partner/server/internal/module/goal/domain"
 )
 
 //go:generate mockgen -source=goals.go -destination=goals_mock.go -package=handler
 
 type GoalService interface {
-	GetGoalSuggestion(ctx context.Context, userID string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error)
-	GetAutoGoalSuggestion(ctx context.Context, userID string) (*entities.GoalSuggestion, error)
+	GetGoalSuggestion(ctx context.Context, userID, locale string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error)
+	GetAutoGoalSuggestion(ctx context.Context, userID, locale string) (*entities.GoalSuggestion, error)
 	CreateGoal(ctx context.Context, userID string, req *dto.CreateGoalRequest) (*entities.Goal, error)
-	GetGoal(ctx context.Context, userID string) (*entities.Goal, error)
+	GetGoals(ctx context.Context, userID, status string) ([]entities.Goal, error)
+	GetGoal(ctx context.Context, userID, goalID string) (*entities.Goal, error)
+	UpdateGoal(ctx context.Context, userID, goalID string, req *dto.UpdateGoalRequest) (*entities.Goal, error)
+	DeleteGoal(ctx context.Context, userID, goalID string) error
+	GetGoalMilestones(ctx context.Context, userID, goalID string) ([]entities.GoalMilestone, error)
 }
 
 // @Summary Calculate and return suggested saving goals based on user's input expense data
@@ -29,7 +37,9 @@ type GoalService interface {
 // @Produce json
 // @Param request body dto.GoalSuggestionRequest true "Goal suggestion request"
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Param Accept-Language header string false "Language of the suggestion message, e.g. zh-TW"
 // @Success 200 {object} dto.GoalSuggestionResponse
+// @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /goals/suggestion [post]
@@ -48,15 +58,14 @@ func (h *Handler) GetGoalSuggestion(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	suggestion, err := h.goalService.GetGoalSuggestion(r.Context(), userID, &req)
+	suggestion, err := h.goalService.GetGoalSuggestion(r.Context(), userID, r.Header.Get("Accept-Language"), &req)
 	if err != nil {
-		h.log.WithError(err).Warnf("failed to get goal suggestion")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToGetGoalSuggestion, http.StatusInternalServerError)
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToGetGoalSuggestion)
 		return
 	}
 
 	resp := dto.GoalSuggestionResponse{
-		SuggestedAmount: suggestion.SuggestedAmount,
+		SuggestedAmount: buildMoneyResponse(suggestion.SuggestedAmount),
 		Period:          suggestion.Period,
 		Message:         suggestion.Message,
 	}
@@ -65,11 +74,12 @@ func (h *Handler) GetGoalSuggestion(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Calculate and return suggested saving goals based on user's expense data
-// @Description Calculate and return suggested saving goals based on user's expense data
+// @Description Calculate and return suggested saving goals from the average income and expenses of the user's recorded transactions
 // @Tags goals
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Param Accept-Language header string false "Language of the suggestion message, e.g. zh-TW"
 // @Success 200 {object} dto.GoalSuggestionResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -82,7 +92,7 @@ func (h *Handler) GetAutoGoalSuggestion(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	suggestion, err := h.goalService.GetAutoGoalSuggestion(r.Context(), userID)
+	suggestion, err := h.goalService.GetAutoGoalSuggestion(r.Context(), userID, r.Header.Get("Accept-Language"))
 	if err != nil {
 		h.log.WithError(err).Warnf("failed to get auto goal suggestion")
 		respond.WithError(w, r, h.log, err, httperror.ErrFailedToGetGoalSuggestion, http.StatusInternalServerError)
@@ -90,7 +100,7 @@ func (h *Handler) GetAutoGoalSuggestion(w http.ResponseWriter, r *http.Request)
 	}
 
 	resp := dto.GoalSuggestionResponse{
-		SuggestedAmount: suggestion.SuggestedAmount,
+		SuggestedAmount: buildMoneyResponse(suggestion.SuggestedAmount),
 		Period:          suggestion.Period,
 		Message:         suggestion.Message,
 	}
@@ -98,8 +108,8 @@ func (h *Handler) GetAutoGoalSuggestion(w http.ResponseWriter, r *http.Request)
 	respond.WithJSON(w, r, resp, http.StatusOK)
 }
 
-// @Summary Create user's saving goal
-// @Description Set user's saving goal amount and period
+// @Summary Create a saving goal
+// @Description Create a new saving goal. A user can have several active goals; each income is allocated across them by allocation percent first and then by priority.
 // @Tags goals
 // @Accept json
 // @Produce json
@@ -127,33 +137,61 @@ func (h *Handler) CreateGoal(w http.ResponseWriter, r *http.Request) {
 
 	goal, err := h.goalService.CreateGoal(r.Context(), userID, &req)
 	if err != nil {
-		h.log.WithError(err).Warnf("failed to create or update goal")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToCreateGoal, http.StatusInternalServerError)
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToCreateGoal)
 		return
 	}
 
-	resp := dto.GoalResponse{
-		TargetAmount:  goal.TargetAmount,
-		CurrentAmount: goal.CurrentAmount,
-		Period:        goal.Period,
-		Status:        goal.Status,
-		CreatedAt:     goal.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:     goal.UpdatedAt.Format(time.RFC3339),
+	respond.WithJSON(w, r, buildGoalResponse(goal), http.StatusOK)
+}
+
+// @Summary List saving goals
+// @Description List the user's saving goals in funding order, optionally filtered by status
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Param status query string false "Goal status" Enums(active, completed, failed)
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GetGoalsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /goals [get]
+func (h *Handler) GetGoals(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	goals, err := h.goalService.GetGoals(r.Context(), userID, r.URL.Query().Get("status"))
+	if err != nil {
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToGetGoals)
+		return
+	}
+
+	resp := dto.GetGoalsResponse{
+		Goals: make([]dto.GoalResponse, 0, len(goals)),
+	}
+	for i := range goals {
+		resp.Goals = append(resp.Goals, buildGoalResponse(&goals[i]))
 	}
 
 	respond.WithJSON(w, r, resp, http.StatusOK)
 }
 
-// @Summary Get current saving goal
-// @Description Get user's current saving goal and status
+// @Summary Get a saving goal
+// @Description Get one of the user's saving goals and its status
 // @Tags goals
 // @Accept json
 // @Produce json
+// @Param id path string true "Goal ID"
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
 // @Success 200 {object} dto.GetGoalResponse
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /goals [get]
+// @Router /goals/{id} [get]
 func (h *Handler) GetGoal(w http.ResponseWriter, r *http.Request) {
 	userID, ok := contextutil.GetUserID(r.Context())
 	if !ok {
@@ -162,23 +200,212 @@ func (h *Handler) GetGoal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	goal, err := h.goalService.GetGoal(r.Context(), userID)
+	goal, err := h.goalService.GetGoal(r.Context(), userID, mux.Vars(r)["id"])
 	if err != nil {
-		h.log.WithError(err).Warnf("failed to get goal")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToGetGoal, http.StatusInternalServerError)
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToGetGoal)
 		return
 	}
 
 	resp := dto.GetGoalResponse{
-		Goal: dto.GoalResponse{
-			TargetAmount:  goal.TargetAmount,
-			CurrentAmount: goal.CurrentAmount,
-			Period:        goal.Period,
-			Status:        goal.Status,
-			CreatedAt:     goal.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:     goal.UpdatedAt.Format(time.RFC3339),
-		},
+		Goal: buildGoalResponse(goal),
 	}
 
 	respond.WithJSON(w, r, resp, http.StatusOK)
 }
+
+// @Summary Update a saving goal
+// @Description Change the name, target, period, priority or allocation percent of an active saving goal
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Param id path string true "Goal ID"
+// @Param request body dto.UpdateGoalRequest true "Update goal request"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GoalResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /goals/{id} [put]
+func (h *Handler) UpdateGoal(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.UpdateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.WithError(err).Warnf("failed to decode request body")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	goal, err := h.goalService.UpdateGoal(r.Context(), userID, mux.Vars(r)["id"], &req)
+	if err != nil {
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToUpdateGoal)
+		return
+	}
+
+	respond.WithJSON(w, r, buildGoalResponse(goal), http.StatusOK)
+}
+
+// @Summary Delete a saving goal
+// @Description Delete one of the user's saving goals
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Param id path string true "Goal ID"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /goals/{id} [delete]
+func (h *Handler) DeleteGoal(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.goalService.DeleteGoal(r.Context(), userID, mux.Vars(r)["id"]); err != nil {
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToDeleteGoal)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary Get milestones of a saving goal
+// @Description Get the progress track of a saving goal and the rewards of each milestone
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Param id path string true "Goal ID"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GetGoalMilestonesResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /goals/{id}/milestones [get]
+func (h *Handler) GetGoalMilestones(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	milestones, err := h.goalService.GetGoalMilestones(r.Context(), userID, mux.Vars(r)["id"])
+	if err != nil {
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToGetGoalMilestones)
+		return
+	}
+
+	resp := dto.GetGoalMilestonesResponse{
+		Milestones: make([]dto.GoalMilestoneResponse, 0, len(milestones)),
+	}
+	for _, milestone := range milestones {
+		resp.Milestones = append(resp.Milestones, buildGoalMilestoneResponse(milestone))
+	}
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
+
+// @Summary Get milestones of the active saving goals
+// @Description Get the progress tracks of the user's active saving goals, in the order the goals are funded. Each milestone names its goal.
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GetGoalMilestonesResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /goals/milestones [get]
+func (h *Handler) GetActiveGoalMilestones(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	goals, err := h.goalService.GetGoals(r.Context(), userID, entities.GoalStatusActive)
+	if err == nil && len(goals) == 0 {
+		err = goal_domain.ErrGoalNotFound
+	}
+	if err != nil {
+		h.respondWithGoalError(w, r, err, httperror.ErrFailedToGetGoalMilestones)
+		return
+	}
+
+	resp := dto.GetGoalMilestonesResponse{
+		Milestones: make([]dto.GoalMilestoneResponse, 0),
+	}
+	for _, goal := range goals {
+		for _, milestone := range goal.Milestones {
+			milestoneResp := buildGoalMilestoneResponse(milestone)
+			milestoneResp.GoalID = goal.ID.Hex()
+			resp.Milestones = append(resp.Milestones, milestoneResp)
+		}
+	}
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
+
+// respondWithGoalError maps goal domain errors to client errors and anything else
+// to an internal error with the given message.
+func (h *Handler) respondWithGoalError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case errors.Is(err, goal_domain.ErrInvalidGoal):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+	case errors.Is(err, goal_domain.ErrInvalidCurrency):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidCurrency, http.StatusBadRequest)
+	case errors.Is(err, goal_domain.ErrInvalidGoalStatus):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidParameter, http.StatusBadRequest)
+	case errors.Is(err, goal_domain.ErrAllocationExceeded):
+		respond.WithError(w, r, h.log, err, httperror.ErrGoalAllocationExceeded, http.StatusBadRequest)
+	case errors.Is(err, goal_domain.ErrGoalNotFound):
+		respond.WithError(w, r, h.log, err, httperror.ErrGoalNotFound, http.StatusNotFound)
+	case errors.Is(err, goal_domain.ErrGoalNotActive):
+		respond.WithError(w, r, h.log, err, httperror.ErrGoalNotActive, http.StatusConflict)
+	default:
+		h.log.WithError(err).Warnf("goal request failed")
+		respond.WithError(w, r, h.log, err, message, http.StatusInternalServerError)
+	}
+}
+
+func buildGoalResponse(goal *entities.Goal) dto.GoalResponse {
+	return dto.GoalResponse{
+		ID:                goal.ID.Hex(),
+		Name:              goal.Name,
+		TargetAmount:      buildMoneyResponse(goal.TargetAmount),
+		CurrentAmount:     buildMoneyResponse(goal.CurrentAmount),
+		Period:            goal.Period,
+		Priority:          goal.Priority,
+		AllocationPercent: goal.AllocationPercent,
+		Status:            goal.Status,
+		CreatedAt:         goal.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         goal.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func buildGoalMilestoneResponse(milestone entities.GoalMilestone) dto.GoalMilestoneResponse {
+	resp := dto.GoalMilestoneResponse{
+		Title:          milestone.Title,
+		TargetPercent:  milestone.TargetPercent,
+		Reward:         milestone.Reward,
+		IsCompleted:    milestone.IsCompleted,
+		CharacterID:    milestone.CharacterID,
+		RewardWithheld: milestone.RewardWithheld,
+	}
+	if milestone.CompletedAt != nil {
+		resp.CompletedAt = milestone.CompletedAt.Format(time.RFC3339)
+	}
+	return resp
+}
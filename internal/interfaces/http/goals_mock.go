@@ -57,47 +57,106 @@ func (mr *MockGoalServiceMockRecorder) CreateGoal(ctx, userID, req any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockGoalService)(nil).CreateGoal), ctx, userID, req)
 }
 
+// DeleteGoal mocks base method.
+func (m *MockGoalService) DeleteGoal(ctx context.Context, userID, goalID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, userID, goalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockGoalServiceMockRecorder) DeleteGoal(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockGoalService)(nil).DeleteGoal), ctx, userID, goalID)
+}
+
 // GetAutoGoalSuggestion mocks base method.
-func (m *MockGoalService) GetAutoGoalSuggestion(ctx context.Context, userID string) (*entities.GoalSuggestion, error) {
+func (m *MockGoalService) GetAutoGoalSuggestion(ctx context.Context, userID, locale string) (*entities.GoalSuggestion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutoGoalSuggestion", ctx, userID)
+	ret := m.ctrl.Call(m, "GetAutoGoalSuggestion", ctx, userID, locale)
 	ret0, _ := ret[0].(*entities.GoalSuggestion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAutoGoalSuggestion indicates an expected call of GetAutoGoalSuggestion.
-func (mr *MockGoalServiceMockRecorder) GetAutoGoalSuggestion(ctx, userID any) *gomock.Call {
+func (mr *MockGoalServiceMockRecorder) GetAutoGoalSuggestion(ctx, userID, locale any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoGoalSuggestion", reflect.TypeOf((*MockGoalService)(nil).GetAutoGoalSuggestion), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoGoalSuggestion", reflect.TypeOf((*MockGoalService)(nil).GetAutoGoalSuggestion), ctx, userID, locale)
 }
 
 // GetGoal mocks base method.
-func (m *MockGoalService) GetGoal(ctx context.Context, userID string) (*entities.Goal, error) {
+func (m *MockGoalService) GetGoal(ctx context.Context, userID, goalID string) (*entities.Goal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGoal", ctx, userID)
+	ret := m.ctrl.Call(m, "GetGoal", ctx, userID, goalID)
 	ret0, _ := ret[0].(*entities.Goal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGoal indicates an expected call of GetGoal.
-func (mr *MockGoalServiceMockRecorder) GetGoal(ctx, userID any) *gomock.Call {
+func (mr *MockGoalServiceMockRecorder) GetGoal(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockGoalService)(nil).GetGoal), ctx, userID, goalID)
+}
+
+// GetGoalMilestones mocks base method.
+func (m *MockGoalService) GetGoalMilestones(ctx context.Context, userID, goalID string) ([]entities.GoalMilestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalMilestones", ctx, userID, goalID)
+	ret0, _ := ret[0].([]entities.GoalMilestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalMilestones indicates an expected call of GetGoalMilestones.
+func (mr *MockGoalServiceMockRecorder) GetGoalMilestones(ctx, userID, goalID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockGoalService)(nil).GetGoal), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalMilestones", reflect.TypeOf((*MockGoalService)(nil).GetGoalMilestones), ctx, userID, goalID)
 }
 
 // GetGoalSuggestion mocks base method.
-func (m *MockGoalService) GetGoalSuggestion(ctx context.Context, userID string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error) {
+func (m *MockGoalService) GetGoalSuggestion(ctx context.Context, userID, locale string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGoalSuggestion", ctx, userID, req)
+	ret := m.ctrl.Call(m, "GetGoalSuggestion", ctx, userID, locale, req)
 	ret0, _ := ret[0].(*entities.GoalSuggestion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetGoalSuggestion indicates an expected call of GetGoalSuggestion.
-func (mr *MockGoalServiceMockRecorder) GetGoalSuggestion(ctx, userID, req any) *gomock.Call {
+func (mr *MockGoalServiceMockRecorder) GetGoalSuggestion(ctx, userID, locale, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalSuggestion", reflect.TypeOf((*MockGoalService)(nil).GetGoalSuggestion), ctx, userID, locale, req)
+}
+
+// GetGoals mocks base method.
+func (m *MockGoalService) GetGoals(ctx context.Context, userID, status string) ([]entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoals", ctx, userID, status)
+	ret0, _ := ret[0].([]entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoals indicates an expected call of GetGoals.
+func (mr *MockGoalServiceMockRecorder) GetGoals(ctx, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoals", reflect.TypeOf((*MockGoalService)(nil).GetGoals), ctx, userID, status)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockGoalService) UpdateGoal(ctx context.Context, userID, goalID string, req *dto.UpdateGoalRequest) (*entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, userID, goalID, req)
+	ret0, _ := ret[0].(*entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockGoalServiceMockRecorder) UpdateGoal(ctx, userID, goalID, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalSuggestion", reflect.TypeOf((*MockGoalService)(nil).GetGoalSuggestion), ctx, userID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockGoalService)(nil).UpdateGoal), ctx, userID, goalID, req)
 }
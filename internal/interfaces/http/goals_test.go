@@ -14,6 +14,8 @@ import (
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	goal_domain "github.com/Financial-Partner/server/internal/module/goal/domain"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/mock/gomock"
@@ -73,13 +75,37 @@ func TestGetGoalSuggestion(t *testing.T) {
 		assert.Equal(t, httperror.ErrUnauthorized, errorResp.Message)
 	})
 
+	t.Run("Invalid currency", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		userID := primitive.NewObjectID().Hex()
+		userEmail := "test@example.com"
+
+		mockServices.GoalService.EXPECT().
+			GetGoalSuggestion(gomock.Any(), userID, gomock.Any(), gomock.Any()).
+			Return(nil, goal_domain.ErrInvalidCurrency)
+
+		body, _ := json.Marshal(dto.GoalSuggestionRequest{MonthlyIncome: 50000, Currency: "XYZ"})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/goals/suggestion", bytes.NewBuffer(body))
+		r = r.WithContext(newContext(userID, userEmail))
+
+		h.GetGoalSuggestion(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidCurrency, errorResp.Message)
+	})
+
 	t.Run("Service error", func(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 		userID := primitive.NewObjectID().Hex()
 		userEmail := "test@example.com"
 
 		mockServices.GoalService.EXPECT().
-			GetGoalSuggestion(gomock.Any(), userID, gomock.Any()).
+			GetGoalSuggestion(gomock.Any(), userID, gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("service error"))
 
 		req := dto.GoalSuggestionRequest{
@@ -115,13 +141,13 @@ func TestGetGoalSuggestion(t *testing.T) {
 		userEmail := "test@example.com"
 
 		suggestion := &entities.GoalSuggestion{
-			SuggestedAmount: 15000,
+			SuggestedAmount: entities.Money{Amount: 15000, Currency: "TWD"},
 			Period:          30,
-			Message:         "Based on your income and expense analysis, we recommend that you can save 15,000 yuan per month.",
+			Message:         "Based on your income and expense analysis, we recommend that you can save 150.00 TWD per month.",
 		}
 
 		mockServices.GoalService.EXPECT().
-			GetGoalSuggestion(gomock.Any(), userID, gomock.Any()).
+			GetGoalSuggestion(gomock.Any(), userID, "zh-TW", gomock.Any()).
 			Return(suggestion, nil)
 
 		req := dto.GoalSuggestionRequest{
@@ -135,6 +161,7 @@ func TestGetGoalSuggestion(t *testing.T) {
 		body, _ := json.Marshal(req)
 		w := httptest.NewRecorder()
 		r := httptest.NewRequest("POST", "/goals/suggestion", bytes.NewBuffer(body))
+		r.Header.Set("Accept-Language", "zh-TW")
 		ctx := newContext(userID, userEmail)
 		r = r.WithContext(ctx)
 
@@ -146,7 +173,7 @@ func TestGetGoalSuggestion(t *testing.T) {
 		var response dto.GoalSuggestionResponse
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
-		assert.Equal(t, suggestion.SuggestedAmount, response.SuggestedAmount)
+		assert.Equal(t, dto.Money{Amount: 15000, Currency: "TWD"}, response.SuggestedAmount)
 		assert.Equal(t, suggestion.Period, response.Period)
 		assert.Equal(t, suggestion.Message, response.Message)
 	})
@@ -181,7 +208,7 @@ func TestGetAutoGoalSuggestion(t *testing.T) {
 		userEmail := "test@example.com"
 
 		mockServices.GoalService.EXPECT().
-			GetAutoGoalSuggestion(gomock.Any(), userID).
+			GetAutoGoalSuggestion(gomock.Any(), userID, gomock.Any()).
 			Return(nil, errors.New("service error"))
 
 		w := httptest.NewRecorder()
@@ -208,17 +235,18 @@ func TestGetAutoGoalSuggestion(t *testing.T) {
 		userEmail := "test@example.com"
 
 		suggestion := &entities.GoalSuggestion{
-			SuggestedAmount: 15000,
+			SuggestedAmount: entities.Money{Amount: 15000, Currency: "TWD"},
 			Period:          30,
-			Message:         "Based on your past financial data analysis, we recommend that you can save 15,000 yuan per month.",
+			Message:         "Based on your past financial data analysis, we recommend that you can save 150.00 TWD per month.",
 		}
 
 		mockServices.GoalService.EXPECT().
-			GetAutoGoalSuggestion(gomock.Any(), userID).
+			GetAutoGoalSuggestion(gomock.Any(), userID, "zh-TW").
 			Return(suggestion, nil)
 
 		w := httptest.NewRecorder()
 		r := httptest.NewRequest("GET", "/goals/suggestion/me", nil)
+		r.Header.Set("Accept-Language", "zh-TW")
 		ctx := newContext(userID, userEmail)
 		r = r.WithContext(ctx)
 
@@ -230,7 +258,7 @@ func TestGetAutoGoalSuggestion(t *testing.T) {
 		var response dto.GoalSuggestionResponse
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
-		assert.Equal(t, suggestion.SuggestedAmount, response.SuggestedAmount)
+		assert.Equal(t, dto.Money{Amount: 15000, Currency: "TWD"}, response.SuggestedAmount)
 		assert.Equal(t, suggestion.Period, response.Period)
 		assert.Equal(t, suggestion.Message, response.Message)
 	})
@@ -267,7 +295,7 @@ func TestCreateGoal(t *testing.T) {
 		h, _ := newTestHandler(t)
 
 		req := dto.CreateGoalRequest{
-			TargetAmount: 10000,
+			TargetAmount: dto.Money{Amount: 10000, Currency: "USD"},
 			Period:       30,
 		}
 		body, _ := json.Marshal(req)
@@ -297,7 +325,7 @@ func TestCreateGoal(t *testing.T) {
 			Return(nil, errors.New("service error"))
 
 		req := dto.CreateGoalRequest{
-			TargetAmount: 10000,
+			TargetAmount: dto.Money{Amount: 10000, Currency: "USD"},
 			Period:       30,
 		}
 		body, _ := json.Marshal(req)
@@ -318,6 +346,37 @@ func TestCreateGoal(t *testing.T) {
 		assert.Equal(t, httperror.ErrFailedToCreateGoal, errorResp.Message)
 	})
 
+	t.Run("Invalid goal", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		userEmail := "test@example.com"
+
+		mockServices.GoalService.EXPECT().
+			CreateGoal(gomock.Any(), userID, gomock.Any()).
+			Return(nil, goal_domain.ErrInvalidGoal)
+
+		req := dto.CreateGoalRequest{
+			TargetAmount: dto.Money{Amount: -1, Currency: "USD"},
+			Period:       30,
+		}
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/goals", bytes.NewBuffer(body))
+		ctx := newContext(userID, userEmail)
+		r = r.WithContext(ctx)
+
+		h.CreateGoal(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, errorResp.Code)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 
@@ -328,8 +387,8 @@ func TestCreateGoal(t *testing.T) {
 		goal := &entities.Goal{
 			ID:            primitive.NewObjectID(),
 			UserID:        primitive.NewObjectID(),
-			TargetAmount:  10000,
-			CurrentAmount: 0,
+			TargetAmount:  entities.Money{Amount: 10000, Currency: "USD"},
+			CurrentAmount: entities.Money{Amount: 0, Currency: "USD"},
 			Period:        30,
 			Status:        "",
 			CreatedAt:     now,
@@ -341,7 +400,7 @@ func TestCreateGoal(t *testing.T) {
 			Return(goal, nil)
 
 		req := dto.CreateGoalRequest{
-			TargetAmount: 10000,
+			TargetAmount: dto.Money{Amount: 10000, Currency: "USD"},
 			Period:       30,
 		}
 		body, _ := json.Marshal(req)
@@ -358,8 +417,9 @@ func TestCreateGoal(t *testing.T) {
 		var response dto.GoalResponse
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
-		assert.Equal(t, goal.TargetAmount, response.TargetAmount)
-		assert.Equal(t, goal.CurrentAmount, response.CurrentAmount)
+		assert.Equal(t, goal.ID.Hex(), response.ID)
+		assert.Equal(t, dto.Money(goal.TargetAmount), response.TargetAmount)
+		assert.Equal(t, dto.Money(goal.CurrentAmount), response.CurrentAmount)
 		assert.Equal(t, goal.Period, response.Period)
 		assert.Equal(t, goal.Status, response.Status)
 		assert.Equal(t, goal.CreatedAt.Format(time.RFC3339), response.CreatedAt)
@@ -367,16 +427,107 @@ func TestCreateGoal(t *testing.T) {
 	})
 }
 
-func TestGetGoal(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
+func TestGetGoals(t *testing.T) {
 	t.Run("Unauthorized request", func(t *testing.T) {
 		h, _ := newTestHandler(t)
 
 		w := httptest.NewRecorder()
 		r := httptest.NewRequest("GET", "/goals", nil)
 
+		h.GetGoals(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUnauthorized, errorResp.Message)
+	})
+
+	t.Run("Invalid status", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			GetGoals(gomock.Any(), userID, "archived").
+			Return(nil, goal_domain.ErrInvalidGoalStatus)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals?status=archived", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetGoals(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidParameter, errorResp.Message)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			GetGoals(gomock.Any(), userID, "").
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetGoals(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetGoals, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		goals := []entities.Goal{
+			{ID: primitive.NewObjectID(), Name: "Trip", TargetAmount: entities.Money{Amount: 10000, Currency: "USD"}, Status: entities.GoalStatusCompleted},
+			{ID: primitive.NewObjectID(), Name: "Emergency fund", TargetAmount: entities.Money{Amount: 50000, Currency: "USD"}, Status: entities.GoalStatusCompleted},
+		}
+
+		mockServices.GoalService.EXPECT().
+			GetGoals(gomock.Any(), userID, entities.GoalStatusCompleted).
+			Return(goals, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals?status=completed", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetGoals(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetGoalsResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Goals, 2)
+		assert.Equal(t, goals[0].ID.Hex(), response.Goals[0].ID)
+		assert.Equal(t, "Emergency fund", response.Goals[1].Name)
+	})
+}
+
+func TestGetGoal(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/id", nil)
+
 		h.GetGoal(w, r)
 
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
@@ -393,21 +544,19 @@ func TestGetGoal(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 
 		userID := primitive.NewObjectID().Hex()
-		userEmail := "test@example.com"
+		goalID := primitive.NewObjectID().Hex()
 
 		mockServices.GoalService.EXPECT().
-			GetGoal(gomock.Any(), userID).
+			GetGoal(gomock.Any(), userID, goalID).
 			Return(nil, errors.New("service error"))
 
 		w := httptest.NewRecorder()
-		r := httptest.NewRequest("GET", "/goals", nil)
-		ctx := newContext(userID, userEmail)
-		r = r.WithContext(ctx)
+		r := httptest.NewRequest("GET", "/goals/"+goalID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
 
 		h.GetGoal(w, r)
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
-		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
 
 		var errorResp dto.ErrorResponse
 		err := json.NewDecoder(w.Body).Decode(&errorResp)
@@ -416,32 +565,58 @@ func TestGetGoal(t *testing.T) {
 		assert.Equal(t, httperror.ErrFailedToGetGoal, errorResp.Message)
 	})
 
+	t.Run("Goal not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goalID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			GetGoal(gomock.Any(), userID, goalID).
+			Return(nil, goal_domain.ErrGoalNotFound)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/"+goalID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+		h.GetGoal(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, errorResp.Code)
+		assert.Equal(t, httperror.ErrGoalNotFound, errorResp.Message)
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 
 		userID := primitive.NewObjectID().Hex()
-		userEmail := "test@example.com"
 
 		now := time.Now()
 		goal := &entities.Goal{
-			ID:            primitive.NewObjectID(),
-			UserID:        primitive.NewObjectID(),
-			TargetAmount:  10000,
-			CurrentAmount: 5000,
-			Period:        30,
-			Status:        "Good progress",
-			CreatedAt:     now.AddDate(0, -1, 0),
-			UpdatedAt:     now,
+			ID:                primitive.NewObjectID(),
+			UserID:            primitive.NewObjectID(),
+			Name:              "Trip",
+			TargetAmount:      entities.Money{Amount: 10000, Currency: "USD"},
+			CurrentAmount:     entities.Money{Amount: 5000, Currency: "USD"},
+			Period:            30,
+			Priority:          1,
+			AllocationPercent: 20,
+			Status:            entities.GoalStatusActive,
+			CreatedAt:         now.AddDate(0, -1, 0),
+			UpdatedAt:         now,
 		}
 
 		mockServices.GoalService.EXPECT().
-			GetGoal(gomock.Any(), userID).
+			GetGoal(gomock.Any(), userID, goal.ID.Hex()).
 			Return(goal, nil)
 
 		w := httptest.NewRecorder()
-		r := httptest.NewRequest("GET", "/goals", nil)
-		ctx := newContext(userID, userEmail)
-		r = r.WithContext(ctx)
+		r := httptest.NewRequest("GET", "/goals/"+goal.ID.Hex(), nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goal.ID.Hex()})
 
 		h.GetGoal(w, r)
 
@@ -451,11 +626,412 @@ func TestGetGoal(t *testing.T) {
 		var response dto.GetGoalResponse
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
-		assert.Equal(t, goal.TargetAmount, response.Goal.TargetAmount)
-		assert.Equal(t, goal.CurrentAmount, response.Goal.CurrentAmount)
+		assert.Equal(t, goal.ID.Hex(), response.Goal.ID)
+		assert.Equal(t, goal.Name, response.Goal.Name)
+		assert.Equal(t, dto.Money(goal.TargetAmount), response.Goal.TargetAmount)
+		assert.Equal(t, dto.Money(goal.CurrentAmount), response.Goal.CurrentAmount)
 		assert.Equal(t, goal.Period, response.Goal.Period)
+		assert.Equal(t, goal.Priority, response.Goal.Priority)
+		assert.Equal(t, goal.AllocationPercent, response.Goal.AllocationPercent)
 		assert.Equal(t, goal.Status, response.Goal.Status)
 		assert.Equal(t, goal.CreatedAt.Format(time.RFC3339), response.Goal.CreatedAt)
 		assert.Equal(t, goal.UpdatedAt.Format(time.RFC3339), response.Goal.UpdatedAt)
 	})
 }
+
+func TestUpdateGoal(t *testing.T) {
+	req := dto.UpdateGoalRequest{Name: "Trip", TargetAmount: dto.Money{Amount: 20000, Currency: "USD"}, Period: 60, Priority: 1, AllocationPercent: 30}
+
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/goals/id", bytes.NewBuffer(body))
+
+		h.UpdateGoal(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Invalid request format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/goals/id", bytes.NewBufferString(`{invalid json`))
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.UpdateGoal(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		statusCode int
+		message    string
+	}{
+		{"Invalid goal", goal_domain.ErrInvalidGoal, http.StatusBadRequest, httperror.ErrInvalidRequest},
+		{"Allocation exceeded", goal_domain.ErrAllocationExceeded, http.StatusBadRequest, httperror.ErrGoalAllocationExceeded},
+		{"Goal not found", goal_domain.ErrGoalNotFound, http.StatusNotFound, httperror.ErrGoalNotFound},
+		{"Goal not active", goal_domain.ErrGoalNotActive, http.StatusConflict, httperror.ErrGoalNotActive},
+		{"Service error", errors.New("service error"), http.StatusInternalServerError, httperror.ErrFailedToUpdateGoal},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, mockServices := newTestHandler(t)
+
+			userID := primitive.NewObjectID().Hex()
+			goalID := primitive.NewObjectID().Hex()
+
+			mockServices.GoalService.EXPECT().
+				UpdateGoal(gomock.Any(), userID, goalID, &req).
+				Return(nil, tc.err)
+
+			body, _ := json.Marshal(req)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "/goals/"+goalID, bytes.NewBuffer(body))
+			r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+			h.UpdateGoal(w, r)
+
+			assert.Equal(t, tc.statusCode, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.message, errorResp.Message)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goal := &entities.Goal{
+			ID:                primitive.NewObjectID(),
+			Name:              req.Name,
+			TargetAmount:      entities.Money{Amount: req.TargetAmount.Amount, Currency: req.TargetAmount.Currency},
+			Period:            req.Period,
+			Priority:          req.Priority,
+			AllocationPercent: req.AllocationPercent,
+			Status:            entities.GoalStatusActive,
+		}
+
+		mockServices.GoalService.EXPECT().
+			UpdateGoal(gomock.Any(), userID, goal.ID.Hex(), &req).
+			Return(goal, nil)
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/goals/"+goal.ID.Hex(), bytes.NewBuffer(body))
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goal.ID.Hex()})
+
+		h.UpdateGoal(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GoalResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Equal(t, goal.ID.Hex(), response.ID)
+		assert.Equal(t, req.TargetAmount, response.TargetAmount)
+		assert.Equal(t, req.AllocationPercent, response.AllocationPercent)
+	})
+}
+
+func TestDeleteGoal(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/goals/id", nil)
+
+		h.DeleteGoal(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Goal not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goalID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			DeleteGoal(gomock.Any(), userID, goalID).
+			Return(goal_domain.ErrGoalNotFound)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/goals/"+goalID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+		h.DeleteGoal(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goalID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			DeleteGoal(gomock.Any(), userID, goalID).
+			Return(errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/goals/"+goalID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+		h.DeleteGoal(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToDeleteGoal, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goalID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			DeleteGoal(gomock.Any(), userID, goalID).
+			Return(nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/goals/"+goalID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+		h.DeleteGoal(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
+
+func TestGetGoalMilestones(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/id/milestones", nil)
+
+		h.GetGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUnauthorized, errorResp.Message)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goalID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			GetGoalMilestones(gomock.Any(), userID, goalID).
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/"+goalID+"/milestones", nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+		h.GetGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetGoalMilestones, errorResp.Message)
+	})
+
+	t.Run("Goal not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goalID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			GetGoalMilestones(gomock.Any(), userID, goalID).
+			Return(nil, goal_domain.ErrGoalNotFound)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/"+goalID+"/milestones", nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+		h.GetGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrGoalNotFound, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		goalID := primitive.NewObjectID().Hex()
+
+		completedAt := time.Now()
+		milestones := []entities.GoalMilestone{
+			{Title: "First steps", TargetPercent: 25, Reward: 10, IsCompleted: true, CompletedAt: &completedAt, RewardWithheld: true},
+			{Title: "Halfway there", TargetPercent: 50, Reward: 20},
+		}
+
+		mockServices.GoalService.EXPECT().
+			GetGoalMilestones(gomock.Any(), userID, goalID).
+			Return(milestones, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/"+goalID+"/milestones", nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": goalID})
+
+		h.GetGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetGoalMilestonesResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Milestones, 2)
+		assert.Equal(t, "First steps", response.Milestones[0].Title)
+		assert.Equal(t, int64(10), response.Milestones[0].Reward)
+		assert.True(t, response.Milestones[0].IsCompleted)
+		assert.Equal(t, completedAt.Format(time.RFC3339), response.Milestones[0].CompletedAt)
+		assert.True(t, response.Milestones[0].RewardWithheld)
+		assert.False(t, response.Milestones[1].IsCompleted)
+		assert.Empty(t, response.Milestones[1].CompletedAt)
+	})
+}
+
+func TestGetActiveGoalMilestones(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/milestones", nil)
+
+		h.GetActiveGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUnauthorized, errorResp.Message)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			GetGoals(gomock.Any(), userID, entities.GoalStatusActive).
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/milestones", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetActiveGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetGoalMilestones, errorResp.Message)
+	})
+
+	t.Run("No active goals", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.GoalService.EXPECT().
+			GetGoals(gomock.Any(), userID, entities.GoalStatusActive).
+			Return([]entities.Goal{}, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/milestones", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetActiveGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrGoalNotFound, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		completedAt := time.Now()
+		goals := []entities.Goal{
+			{
+				ID: primitive.NewObjectID(),
+				Milestones: []entities.GoalMilestone{
+					{Title: "First steps", TargetPercent: 25, Reward: 10, IsCompleted: true, CompletedAt: &completedAt},
+					{Title: "Halfway there", TargetPercent: 50, Reward: 20},
+				},
+			},
+			{
+				ID:         primitive.NewObjectID(),
+				Milestones: []entities.GoalMilestone{{Title: "First steps", TargetPercent: 25, Reward: 10}},
+			},
+		}
+
+		mockServices.GoalService.EXPECT().
+			GetGoals(gomock.Any(), userID, entities.GoalStatusActive).
+			Return(goals, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/goals/milestones", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetActiveGoalMilestones(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetGoalMilestonesResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Milestones, 3)
+		assert.Equal(t, goals[0].ID.Hex(), response.Milestones[0].GoalID)
+		assert.True(t, response.Milestones[0].IsCompleted)
+		assert.Equal(t, completedAt.Format(time.RFC3339), response.Milestones[0].CompletedAt)
+		assert.Equal(t, goals[0].ID.Hex(), response.Milestones[1].GoalID)
+		assert.Equal(t, "Halfway there", response.Milestones[1].Title)
+		assert.Equal(t, goals[1].ID.Hex(), response.Milestones[2].GoalID)
+		assert.False(t, response.Milestones[2].IsCompleted)
+	})
+}
@@ -12,10 +12,11 @@ type Handler struct {
 	transactionService TransactionService
 	gachaService       GachaService
 	reportService      ReportService
+	marketService      MarketService
 	log                logger.Logger
 }
 
-func NewHandler(us UserService, as AuthService, gs GoalService, is InvestmentService, ts TransactionService, gcs GachaService, rs ReportService, log logger.Logger) *Handler {
+func NewHandler(us UserService, as AuthService, gs GoalService, is InvestmentService, ts TransactionService, gcs GachaService, rs ReportService, ms MarketService, log logger.Logger) *Handler {
 	return &Handler{
 		userService:        us,
 		authService:        as,
@@ -24,6 +25,7 @@ func NewHandler(us UserService, as AuthService, gs GoalService, is InvestmentSer
 		transactionService: ts,
 		gachaService:       gcs,
 		reportService:      rs,
+		marketService:      ms,
 		log:                log,
 	}
 }
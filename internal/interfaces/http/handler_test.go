@@ -19,6 +19,7 @@ type MockServices struct {
 	TransactionService *handler.MockTransactionService
 	GachaService       *handler.MockGachaService
 	ReportService      *handler.MockReportService
+	MarketService      *handler.MockMarketService
 }
 
 func newTestHandler(t *testing.T) (*handler.Handler, *MockServices) {
@@ -33,8 +34,9 @@ func newTestHandler(t *testing.T) (*handler.Handler, *MockServices) {
 		TransactionService: handler.NewMockTransactionService(ctrl),
 		GachaService:       handler.NewMockGachaService(ctrl),
 		ReportService:      handler.NewMockReportService(ctrl),
+		MarketService:      handler.NewMockMarketService(ctrl),
 	}
-	h := handler.NewHandler(ms.UserService, ms.AuthService, ms.GoalService, ms.InvestmentService, ms.TransactionService, ms.GachaService, ms.ReportService, logger.NewNopLogger())
+	h := handler.NewHandler(ms.UserService, ms.AuthService, ms.GoalService, ms.InvestmentService, ms.TransactionService, ms.GachaService, ms.ReportService, ms.MarketService, logger.NewNopLogger())
 
 	return h, ms
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
 	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+	investment_domain "github.com/Financial-Partner/server/internal/module/investment/domain"
 )
 
 //go:generate mockgen -source=investment.go -destination=investment_mock.go -package=handler
@@ -19,7 +21,8 @@ type InvestmentService interface {
 	GetOpportunities(ctx context.Context, userID string) ([]entities.Opportunity, error)
 	CreateUserInvestment(ctx context.Context, userID string, req *dto.CreateUserInvestmentRequest) (*entities.Investment, error)
 	GetUserInvestments(ctx context.Context, userID string) ([]entities.Investment, error)
-	CreateOpportunity(ctx context.Context, userID string, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error)
+	CreateOpportunity(ctx context.Context, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error)
+	GetPortfolio(ctx context.Context, userID string) (*entities.Portfolio, error)
 }
 
 // @Summary Get investment opportunities
@@ -49,17 +52,7 @@ func (h *Handler) GetOpportunities(w http.ResponseWriter, r *http.Request) {
 
 	var opportunitiesResponses []dto.OpportunityResponse
 	for _, opportunity := range opportunities {
-		opportunitiesResponses = append(opportunitiesResponses, dto.OpportunityResponse{
-			Title:       opportunity.Title,
-			Description: opportunity.Description,
-			Tags:        opportunity.Tags,
-			IsIncrease:  opportunity.IsIncrease,
-			Variation:   opportunity.Variation,
-			Duration:    opportunity.Duration,
-			MinAmount:   opportunity.MinAmount,
-			CreatedAt:   opportunity.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:   opportunity.UpdatedAt.Format(time.RFC3339),
-		})
+		opportunitiesResponses = append(opportunitiesResponses, buildOpportunityResponse(&opportunity))
 	}
 
 	resp := dto.GetOpportunitiesResponse{
@@ -77,8 +70,10 @@ func (h *Handler) GetOpportunities(w http.ResponseWriter, r *http.Request) {
 // @Param request body dto.CreateUserInvestmentRequest true "Create user investment request"
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
 // @Success 201 {object} dto.CreateUserInvestmentResponse
-// @Failure 400 {object} dto.ErrorResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid opportunity ID, amount below the minimum or currency other than the opportunity's or savings'"
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse "Opportunity not found"
+// @Failure 409 {object} dto.ErrorResponse "Not enough savings"
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /users/me/investments [post]
 func (h *Handler) CreateUserInvestment(w http.ResponseWriter, r *http.Request) {
@@ -98,17 +93,11 @@ func (h *Handler) CreateUserInvestment(w http.ResponseWriter, r *http.Request) {
 
 	investment, err := h.investmentService.CreateUserInvestment(r.Context(), userID, &req)
 	if err != nil {
-		h.log.WithError(err).Warnf("failed to create an user investment")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToCreateUserInvestment, http.StatusInternalServerError)
+		h.respondWithInvestmentError(w, r, err, httperror.ErrFailedToCreateUserInvestment)
 		return
 	}
 
-	resp := dto.InvestmentResponse{
-		OpportunityID: investment.OpportunityID.Hex(),
-		Amount:        investment.Amount,
-		CreatedAt:     investment.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:     investment.UpdatedAt.Format(time.RFC3339),
-	}
+	resp := buildInvestmentResponse(investment)
 
 	respond.WithJSON(w, r, resp, http.StatusOK)
 }
@@ -140,12 +129,7 @@ func (h *Handler) GetUserInvestments(w http.ResponseWriter, r *http.Request) {
 
 	var investmentsResponse []dto.InvestmentResponse
 	for _, investment := range investments {
-		investmentsResponse = append(investmentsResponse, dto.InvestmentResponse{
-			OpportunityID: investment.OpportunityID.Hex(),
-			Amount:        investment.Amount,
-			CreatedAt:     investment.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:     investment.UpdatedAt.Format(time.RFC3339),
-		})
+		investmentsResponse = append(investmentsResponse, buildInvestmentResponse(&investment))
 	}
 
 	resp := dto.GetUserInvestmentsResponse{
@@ -156,20 +140,20 @@ func (h *Handler) GetUserInvestments(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Create an investment opportunity
-// @Description Create an investment opportunity
+// @Description Create an investment opportunity. Admin only.
 // @Tags investments
 // @Accept json
 // @Produce json
 // @Param request body dto.CreateOpportunityRequest true "Create opportunity request"
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
 // @Success 201 {object} dto.CreateOpportunityResponse
-// @Failure 400 {object} dto.ErrorResponse
+// @Failure 400 {object} dto.ErrorResponse "Invalid currency, duration days, variation or minimum amount"
 // @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse "Admin role required"
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /investments [post]
 func (h *Handler) CreateOpportunity(w http.ResponseWriter, r *http.Request) {
-	userID, ok := contextutil.GetUserID(r.Context())
-	if !ok {
+	if _, ok := contextutil.GetUserID(r.Context()); !ok {
 		h.log.Warnf("failed to get user ID from context")
 		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
 		return
@@ -182,14 +166,100 @@ func (h *Handler) CreateOpportunity(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	opportunity, err := h.investmentService.CreateOpportunity(r.Context(), userID, &req)
+	opportunity, err := h.investmentService.CreateOpportunity(r.Context(), &req)
+	if err != nil {
+		h.respondWithInvestmentError(w, r, err, httperror.ErrFailedToCreateOpportunity)
+		return
+	}
+
+	resp := buildOpportunityResponse(opportunity)
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
+
+// @Summary Get user portfolio
+// @Description Get the user's investments aggregated by opportunity, with profit and loss and allocation by tag
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GetPortfolioResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /users/me/portfolio [get]
+func (h *Handler) GetPortfolio(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	portfolio, err := h.investmentService.GetPortfolio(r.Context(), userID)
 	if err != nil {
-		h.log.WithError(err).Warnf("failed to create an user investment")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToCreateOpportunity, http.StatusInternalServerError)
+		h.respondWithInvestmentError(w, r, err, httperror.ErrFailedToGetPortfolio)
 		return
 	}
 
-	resp := dto.OpportunityResponse{
+	resp := dto.GetPortfolioResponse{
+		Currency:      portfolio.Currency,
+		Holdings:      make([]dto.HoldingResponse, 0, len(portfolio.Holdings)),
+		CostBasis:     portfolio.CostBasis,
+		CurrentValue:  portfolio.CurrentValue,
+		UnrealizedPnL: portfolio.UnrealizedPnL,
+		RealizedPnL:   portfolio.RealizedPnL,
+		Allocations:   make([]dto.TagAllocationResponse, 0, len(portfolio.Allocations)),
+	}
+	for _, holding := range portfolio.Holdings {
+		resp.Holdings = append(resp.Holdings, dto.HoldingResponse{
+			OpportunityID: holding.OpportunityID.Hex(),
+			Title:         holding.Title,
+			Tags:          holding.Tags,
+			CostBasis:     holding.CostBasis,
+			CurrentValue:  holding.CurrentValue,
+			UnrealizedPnL: holding.UnrealizedPnL,
+			RealizedPnL:   holding.RealizedPnL,
+		})
+	}
+	for _, allocation := range portfolio.Allocations {
+		resp.Allocations = append(resp.Allocations, dto.TagAllocationResponse{
+			Tag:     allocation.Tag,
+			Value:   allocation.Value,
+			Percent: allocation.Percent,
+		})
+	}
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
+
+// respondWithInvestmentError maps investment domain errors to their HTTP status and
+// anything else to an internal error with the given message.
+func (h *Handler) respondWithInvestmentError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case errors.Is(err, investment_domain.ErrInvalidOpportunityID):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidOpportunityID, http.StatusBadRequest)
+	case errors.Is(err, investment_domain.ErrAmountBelowMinimum):
+		respond.WithError(w, r, h.log, err, httperror.ErrAmountBelowMinimum, http.StatusBadRequest)
+	case errors.Is(err, investment_domain.ErrOpportunityNotFound):
+		respond.WithError(w, r, h.log, err, httperror.ErrOpportunityNotFound, http.StatusNotFound)
+	case errors.Is(err, investment_domain.ErrInsufficientSavings):
+		respond.WithError(w, r, h.log, err, httperror.ErrInsufficientSavings, http.StatusConflict)
+	case errors.Is(err, investment_domain.ErrCurrencyMismatch):
+		respond.WithError(w, r, h.log, err, httperror.ErrCurrencyMismatch, http.StatusBadRequest)
+	case errors.Is(err, investment_domain.ErrSavingsCurrency):
+		respond.WithError(w, r, h.log, err, httperror.ErrSavingsCurrency, http.StatusBadRequest)
+	case errors.Is(err, investment_domain.ErrInvalidOpportunity):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidOpportunity, http.StatusBadRequest)
+	case errors.Is(err, entities.ErrInvalidCurrency):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidCurrency, http.StatusBadRequest)
+	default:
+		h.log.WithError(err).Warnf("investment request failed")
+		respond.WithError(w, r, h.log, err, message, http.StatusInternalServerError)
+	}
+}
+
+func buildOpportunityResponse(opportunity *entities.Opportunity) dto.OpportunityResponse {
+	return dto.OpportunityResponse{
 		OpportunityID: opportunity.ID.Hex(),
 		Title:         opportunity.Title,
 		Description:   opportunity.Description,
@@ -197,10 +267,26 @@ func (h *Handler) CreateOpportunity(w http.ResponseWriter, r *http.Request) {
 		IsIncrease:    opportunity.IsIncrease,
 		Variation:     opportunity.Variation,
 		Duration:      opportunity.Duration,
-		MinAmount:     opportunity.MinAmount,
+		DurationDays:  opportunity.DurationDays,
+		MinAmount:     buildMoneyResponse(opportunity.MinAmount),
 		CreatedAt:     opportunity.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:     opportunity.UpdatedAt.Format(time.RFC3339),
 	}
+}
 
-	respond.WithJSON(w, r, resp, http.StatusOK)
+func buildInvestmentResponse(investment *entities.Investment) dto.InvestmentResponse {
+	resp := dto.InvestmentResponse{
+		OpportunityID: investment.OpportunityID.Hex(),
+		Amount:        buildMoneyResponse(investment.Amount),
+		Status:        investment.Status,
+		MaturesAt:     investment.MaturesAt.Format(time.RFC3339),
+		CreatedAt:     investment.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     investment.UpdatedAt.Format(time.RFC3339),
+	}
+	if investment.SettledAt != nil {
+		payout := buildMoneyResponse(investment.Payout)
+		resp.Payout = &payout
+		resp.SettledAt = investment.SettledAt.Format(time.RFC3339)
+	}
+	return resp
 }
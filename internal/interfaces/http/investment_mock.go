@@ -43,18 +43,18 @@ func (m *MockInvestmentService) EXPECT() *MockInvestmentServiceMockRecorder {
 }
 
 // CreateOpportunity mocks base method.
-func (m *MockInvestmentService) CreateOpportunity(ctx context.Context, userID string, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error) {
+func (m *MockInvestmentService) CreateOpportunity(ctx context.Context, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOpportunity", ctx, userID, req)
+	ret := m.ctrl.Call(m, "CreateOpportunity", ctx, req)
 	ret0, _ := ret[0].(*entities.Opportunity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateOpportunity indicates an expected call of CreateOpportunity.
-func (mr *MockInvestmentServiceMockRecorder) CreateOpportunity(ctx, userID, req any) *gomock.Call {
+func (mr *MockInvestmentServiceMockRecorder) CreateOpportunity(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpportunity", reflect.TypeOf((*MockInvestmentService)(nil).CreateOpportunity), ctx, userID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpportunity", reflect.TypeOf((*MockInvestmentService)(nil).CreateOpportunity), ctx, req)
 }
 
 // CreateUserInvestment mocks base method.
@@ -87,6 +87,21 @@ func (mr *MockInvestmentServiceMockRecorder) GetOpportunities(ctx, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpportunities", reflect.TypeOf((*MockInvestmentService)(nil).GetOpportunities), ctx, userID)
 }
 
+// GetPortfolio mocks base method.
+func (m *MockInvestmentService) GetPortfolio(ctx context.Context, userID string) (*entities.Portfolio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPortfolio", ctx, userID)
+	ret0, _ := ret[0].(*entities.Portfolio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPortfolio indicates an expected call of GetPortfolio.
+func (mr *MockInvestmentServiceMockRecorder) GetPortfolio(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortfolio", reflect.TypeOf((*MockInvestmentService)(nil).GetPortfolio), ctx, userID)
+}
+
 // GetUserInvestments mocks base method.
 func (m *MockInvestmentService) GetUserInvestments(ctx context.Context, userID string) ([]entities.Investment, error) {
 	m.ctrl.T.Helper()
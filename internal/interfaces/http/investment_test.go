@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	investment_domain "github.com/Financial-Partner/server/internal/module/investment/domain"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/mock/gomock"
@@ -83,7 +85,7 @@ func TestGetOpportunities(t *testing.T) {
 				IsIncrease:  true,
 				Variation:   30,
 				Duration:    "a month",
-				MinAmount:   1000,
+				MinAmount:   entities.Money{Amount: 1000, Currency: "USD"},
 				CreatedAt:   now.AddDate(0, -1, 0),
 				UpdatedAt:   now,
 			},
@@ -114,7 +116,7 @@ func TestGetOpportunities(t *testing.T) {
 		assert.Equal(t, opportunities[0].IsIncrease, response.Opportunities[0].IsIncrease)
 		assert.Equal(t, opportunities[0].Variation, response.Opportunities[0].Variation)
 		assert.Equal(t, opportunities[0].Duration, response.Opportunities[0].Duration)
-		assert.Equal(t, opportunities[0].MinAmount, response.Opportunities[0].MinAmount)
+		assert.Equal(t, dto.Money(opportunities[0].MinAmount), response.Opportunities[0].MinAmount)
 		assert.Equal(t, opportunities[0].CreatedAt.Format(time.RFC3339), response.Opportunities[0].CreatedAt)
 		assert.Equal(t, opportunities[0].UpdatedAt.Format(time.RFC3339), response.Opportunities[0].UpdatedAt)
 	})
@@ -152,7 +154,7 @@ func TestCreateUserInvestment(t *testing.T) {
 
 		req := dto.CreateUserInvestmentRequest{
 			OpportunityID: primitive.NewObjectID().Hex(),
-			Amount:        1000,
+			Amount:        dto.Money{Amount: 1000, Currency: "USD"},
 		}
 		body, _ := json.Marshal(req)
 		w := httptest.NewRecorder()
@@ -182,7 +184,7 @@ func TestCreateUserInvestment(t *testing.T) {
 
 		req := dto.CreateUserInvestmentRequest{
 			OpportunityID: primitive.NewObjectID().Hex(),
-			Amount:        1000,
+			Amount:        dto.Money{Amount: 1000, Currency: "USD"},
 		}
 		body, _ := json.Marshal(req)
 		w := httptest.NewRecorder()
@@ -202,6 +204,49 @@ func TestCreateUserInvestment(t *testing.T) {
 		assert.Equal(t, httperror.ErrFailedToCreateUserInvestment, errorResp.Message)
 	})
 
+	t.Run("Domain errors", func(t *testing.T) {
+		testCases := []struct {
+			err     error
+			code    int
+			message string
+		}{
+			{investment_domain.ErrInvalidOpportunityID, http.StatusBadRequest, httperror.ErrInvalidOpportunityID},
+			{investment_domain.ErrAmountBelowMinimum, http.StatusBadRequest, httperror.ErrAmountBelowMinimum},
+			{investment_domain.ErrOpportunityNotFound, http.StatusNotFound, httperror.ErrOpportunityNotFound},
+			{investment_domain.ErrInsufficientSavings, http.StatusConflict, httperror.ErrInsufficientSavings},
+			{investment_domain.ErrCurrencyMismatch, http.StatusBadRequest, httperror.ErrCurrencyMismatch},
+			{investment_domain.ErrSavingsCurrency, http.StatusBadRequest, httperror.ErrSavingsCurrency},
+			{investment_domain.ErrInvalidOpportunity, http.StatusBadRequest, httperror.ErrInvalidOpportunity},
+		}
+
+		for _, tc := range testCases {
+			h, mockServices := newTestHandler(t)
+
+			userID := primitive.NewObjectID().Hex()
+			mockServices.InvestmentService.EXPECT().
+				CreateUserInvestment(gomock.Any(), userID, gomock.Any()).
+				Return(nil, tc.err)
+
+			body, _ := json.Marshal(dto.CreateUserInvestmentRequest{
+				OpportunityID: primitive.NewObjectID().Hex(),
+				Amount:        dto.Money{Amount: 1000, Currency: "USD"},
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/me/investments", bytes.NewBuffer(body))
+			r = r.WithContext(newContext(userID, "test@example.com"))
+
+			h.CreateUserInvestment(w, r)
+
+			assert.Equal(t, tc.code, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.code, errorResp.Code)
+			assert.Equal(t, tc.message, errorResp.Message)
+		}
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 
@@ -213,7 +258,7 @@ func TestCreateUserInvestment(t *testing.T) {
 			ID:            primitive.NewObjectID(),
 			UserID:        primitive.NewObjectID(),
 			OpportunityID: primitive.NewObjectID(),
-			Amount:        1000,
+			Amount:        entities.Money{Amount: 1000, Currency: "USD"},
 			CreatedAt:     now,
 			UpdatedAt:     now,
 		}
@@ -224,7 +269,7 @@ func TestCreateUserInvestment(t *testing.T) {
 
 		req := dto.CreateUserInvestmentRequest{
 			OpportunityID: primitive.NewObjectID().Hex(),
-			Amount:        1000,
+			Amount:        dto.Money{Amount: 1000, Currency: "USD"},
 		}
 
 		body, _ := json.Marshal(req)
@@ -307,7 +352,18 @@ func TestGetUserInvestments(t *testing.T) {
 			{
 				ID:            primitive.NewObjectID(),
 				OpportunityID: primitive.NewObjectID(),
-				Amount:        1000,
+				Amount:        entities.Money{Amount: 1000, Currency: "USD"},
+				CreatedAt:     now.AddDate(0, -1, 0),
+				UpdatedAt:     now,
+			},
+			{
+				ID:            primitive.NewObjectID(),
+				OpportunityID: primitive.NewObjectID(),
+				Amount:        entities.Money{Amount: 1000, Currency: "USD"},
+				Status:        entities.InvestmentStatusSettled,
+				MaturesAt:     now.AddDate(0, 0, -1),
+				Payout:        entities.Money{Amount: 1200, Currency: "USD"},
+				SettledAt:     &now,
 				CreatedAt:     now.AddDate(0, -1, 0),
 				UpdatedAt:     now,
 			},
@@ -331,11 +387,16 @@ func TestGetUserInvestments(t *testing.T) {
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
 		// Compare the response with the expected data
-		assert.Len(t, response.Investments, 1)
+		assert.Len(t, response.Investments, 2)
 		assert.Equal(t, investments[0].OpportunityID.Hex(), response.Investments[0].OpportunityID)
-		assert.Equal(t, investments[0].Amount, response.Investments[0].Amount)
+		assert.Equal(t, dto.Money(investments[0].Amount), response.Investments[0].Amount)
 		assert.Equal(t, investments[0].CreatedAt.Format(time.RFC3339), response.Investments[0].CreatedAt)
 		assert.Equal(t, investments[0].UpdatedAt.Format(time.RFC3339), response.Investments[0].UpdatedAt)
+		assert.Empty(t, response.Investments[0].SettledAt)
+		assert.Nil(t, response.Investments[0].Payout)
+		assert.Equal(t, entities.InvestmentStatusSettled, response.Investments[1].Status)
+		assert.Equal(t, &dto.Money{Amount: 1200, Currency: "USD"}, response.Investments[1].Payout)
+		assert.Equal(t, now.Format(time.RFC3339), response.Investments[1].SettledAt)
 	})
 }
 
@@ -353,7 +414,7 @@ func TestCreateOpportunity(t *testing.T) {
 			IsIncrease:  true,
 			Variation:   30,
 			Duration:    "a month",
-			MinAmount:   1000,
+			MinAmount:   dto.Money{Amount: 1000, Currency: "USD"},
 		}
 		body, _ := json.Marshal(req)
 		w := httptest.NewRecorder()
@@ -401,7 +462,7 @@ func TestCreateOpportunity(t *testing.T) {
 		userEmail := "test@example.com"
 
 		mockServices.InvestmentService.EXPECT().
-			CreateOpportunity(gomock.Any(), userID, gomock.Any()).
+			CreateOpportunity(gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("service error"))
 
 		req := dto.CreateOpportunityRequest{
@@ -411,7 +472,7 @@ func TestCreateOpportunity(t *testing.T) {
 			IsIncrease:  true,
 			Variation:   30,
 			Duration:    "a month",
-			MinAmount:   1000,
+			MinAmount:   dto.Money{Amount: 1000, Currency: "USD"},
 		}
 		body, _ := json.Marshal(req)
 		w := httptest.NewRecorder()
@@ -430,4 +491,107 @@ func TestCreateOpportunity(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, errorResp.Code)
 		assert.Equal(t, httperror.ErrFailedToCreateOpportunity, errorResp.Message)
 	})
+
+	t.Run("Invalid currency", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.InvestmentService.EXPECT().
+			CreateOpportunity(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("invalid minimum amount: %w", entities.ErrInvalidCurrency))
+
+		body, _ := json.Marshal(dto.CreateOpportunityRequest{
+			Title:     "Test investments",
+			MinAmount: dto.Money{Amount: 1000, Currency: "XYZ"},
+		})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/investments", bytes.NewBuffer(body))
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.CreateOpportunity(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidCurrency, errorResp.Message)
+	})
+}
+
+func TestGetPortfolio(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/users/me/portfolio", nil)
+
+		h.GetPortfolio(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		mockServices.InvestmentService.EXPECT().
+			GetPortfolio(gomock.Any(), userID).
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/users/me/portfolio", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetPortfolio(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetPortfolio, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		opportunityID := primitive.NewObjectID()
+		mockServices.InvestmentService.EXPECT().
+			GetPortfolio(gomock.Any(), userID).
+			Return(&entities.Portfolio{
+				Holdings: []entities.Holding{
+					{OpportunityID: opportunityID, Title: "Real Estate", Tags: []string{"high risk"}, CostBasis: 1000, CurrentValue: 1080, UnrealizedPnL: 80, RealizedPnL: 200},
+				},
+				CostBasis:     1000,
+				CurrentValue:  1080,
+				UnrealizedPnL: 80,
+				RealizedPnL:   200,
+				Allocations:   []entities.TagAllocation{{Tag: "high risk", Value: 1080, Percent: 100}},
+			}, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/users/me/portfolio", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.GetPortfolio(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetPortfolioResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Equal(t, dto.GetPortfolioResponse{
+			Holdings: []dto.HoldingResponse{
+				{OpportunityID: opportunityID.Hex(), Title: "Real Estate", Tags: []string{"high risk"}, CostBasis: 1000, CurrentValue: 1080, UnrealizedPnL: 80, RealizedPnL: 200},
+			},
+			CostBasis:     1000,
+			CurrentValue:  1080,
+			UnrealizedPnL: 80,
+			RealizedPnL:   200,
+			Allocations:   []dto.TagAllocationResponse{{Tag: "high risk", Value: 1080, Percent: 100}},
+		}, response)
+	})
 }
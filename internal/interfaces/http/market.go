@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Financial-Partner/server/internal/contextutil"
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+)
+
+//go:generate mockgen -source=market.go -destination=market_mock.go -package=handler
+
+type MarketService interface {
+	GetPrices(ctx context.Context, opportunityID string) ([]entities.PricePoint, error)
+}
+
+// @Summary Get opportunity prices
+// @Description Get the simulated daily market prices of an investment opportunity
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Param id path string true "Opportunity ID"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GetOpportunityPricesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /investments/{id}/prices [get]
+func (h *Handler) GetOpportunityPrices(w http.ResponseWriter, r *http.Request) {
+	if _, ok := contextutil.GetUserID(r.Context()); !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	opportunityID := mux.Vars(r)["id"]
+	points, err := h.marketService.GetPrices(r.Context(), opportunityID)
+	if err != nil {
+		h.respondWithInvestmentError(w, r, err, httperror.ErrFailedToGetPrices)
+		return
+	}
+
+	prices := make([]dto.PricePointResponse, 0, len(points))
+	for _, point := range points {
+		prices = append(prices, dto.PricePointResponse{
+			Date:  point.Date.Format("2006-01-02"),
+			Price: math.Round(point.Price*100) / 100,
+		})
+	}
+
+	resp := dto.GetOpportunityPricesResponse{
+		OpportunityID: opportunityID,
+		Prices:        prices,
+	}
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
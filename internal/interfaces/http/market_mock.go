@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: market.go
+//
+// Generated by this command:
+//
+//	mockgen -source=market.go -destination=market_mock.go -package=handler
+//
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMarketService is a mock of MarketService interface.
+type MockMarketService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarketServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMarketServiceMockRecorder is the mock recorder for MockMarketService.
+type MockMarketServiceMockRecorder struct {
+	mock *MockMarketService
+}
+
+// NewMockMarketService creates a new mock instance.
+func NewMockMarketService(ctrl *gomock.Controller) *MockMarketService {
+	mock := &MockMarketService{ctrl: ctrl}
+	mock.recorder = &MockMarketServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarketService) EXPECT() *MockMarketServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPrices mocks base method.
+func (m *MockMarketService) GetPrices(ctx context.Context, opportunityID string) ([]entities.PricePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrices", ctx, opportunityID)
+	ret0, _ := ret[0].([]entities.PricePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrices indicates an expected call of GetPrices.
+func (mr *MockMarketServiceMockRecorder) GetPrices(ctx, opportunityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrices", reflect.TypeOf((*MockMarketService)(nil).GetPrices), ctx, opportunityID)
+}
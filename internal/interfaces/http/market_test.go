@@ -0,0 +1,85 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	investment_domain "github.com/Financial-Partner/server/internal/module/investment/domain"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetOpportunityPrices(t *testing.T) {
+	opportunityID := primitive.NewObjectID()
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/investments/"+opportunityID.Hex()+"/prices", nil)
+		r = r.WithContext(newContext(primitive.NewObjectID().Hex(), "test@example.com"))
+		return mux.SetURLVars(r, map[string]string{"id": opportunityID.Hex()})
+	}
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/investments/"+opportunityID.Hex()+"/prices", nil)
+
+		h.GetOpportunityPrices(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		testCases := []struct {
+			err     error
+			code    int
+			message string
+		}{
+			{investment_domain.ErrOpportunityNotFound, http.StatusNotFound, httperror.ErrOpportunityNotFound},
+			{investment_domain.ErrInvalidOpportunityID, http.StatusBadRequest, httperror.ErrInvalidOpportunityID},
+			{errors.New("db error"), http.StatusInternalServerError, httperror.ErrFailedToGetPrices},
+		}
+
+		for _, tc := range testCases {
+			h, mockServices := newTestHandler(t)
+			mockServices.MarketService.EXPECT().GetPrices(gomock.Any(), opportunityID.Hex()).Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			h.GetOpportunityPrices(w, newRequest())
+
+			assert.Equal(t, tc.code, w.Code)
+			var errorResp dto.ErrorResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&errorResp))
+			assert.Equal(t, tc.message, errorResp.Message)
+		}
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		mockServices.MarketService.EXPECT().GetPrices(gomock.Any(), opportunityID.Hex()).Return([]entities.PricePoint{
+			{OpportunityID: opportunityID, Date: start, Price: 100},
+			{OpportunityID: opportunityID, Date: start.AddDate(0, 0, 1), Price: 101.23456},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		h.GetOpportunityPrices(w, newRequest())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp dto.GetOpportunityPricesResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		assert.Equal(t, opportunityID.Hex(), resp.OpportunityID)
+		assert.Equal(t, []dto.PricePointResponse{
+			{Date: "2025-01-01", Price: 100},
+			{Date: "2025-01-02", Price: 101.23},
+		}, resp.Prices)
+	})
+}
@@ -63,6 +63,26 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 		}).Infof("User authenticated successfully")
 		ctx := context.WithValue(r.Context(), contextutil.UserIDKey, claims.ID)
 		ctx = context.WithValue(ctx, contextutil.UserEmailKey, claims.Email)
+		ctx = context.WithValue(ctx, contextutil.UserRoleKey, claims.Role)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// RequireRole only lets through requests authenticated with the given role. It
+// must run after Authenticate.
+func (m *AuthMiddleware) RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if userRole, _ := contextutil.GetUserRole(r.Context()); userRole != role {
+				id, _ := contextutil.GetUserID(r.Context())
+				m.log.WithFields(map[string]any{
+					"id":   id,
+					"role": userRole,
+				}).Warnf("Authorization failed: %s role required", role)
+				http.Error(w, "Insufficient permissions", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/Financial-Partner/server/internal/contextutil"
+	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/infrastructure/auth"
 	"github.com/Financial-Partner/server/internal/infrastructure/logger"
 	"github.com/Financial-Partner/server/internal/interfaces/http/middleware"
@@ -214,6 +215,7 @@ func TestAuthMiddlewareAuthenticate(t *testing.T) {
 		token := &auth.Claims{
 			ID:    "test-id",
 			Email: "test@example.com",
+			Role:  entities.UserRoleAdmin,
 		}
 		mockJWTValidator.EXPECT().ValidateToken("valid-token").Return(token, nil)
 
@@ -243,5 +245,71 @@ func TestAuthMiddlewareAuthenticate(t *testing.T) {
 		id, ok := contextutil.GetUserID(capturedCtx)
 		assert.True(t, ok)
 		assert.Equal(t, "test-id", id)
+
+		role, ok := contextutil.GetUserRole(capturedCtx)
+		assert.True(t, ok)
+		assert.Equal(t, entities.UserRoleAdmin, role)
+	})
+}
+
+func TestAuthMiddlewareRequireRole(t *testing.T) {
+	newRequest := func(role string) *http.Request {
+		ctx := context.WithValue(context.Background(), contextutil.UserIDKey, "test-id")
+		if role != "" {
+			ctx = context.WithValue(ctx, contextutil.UserRoleKey, role)
+		}
+		return httptest.NewRequest("POST", "/api/test", nil).WithContext(ctx)
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		middleware := middleware.NewAuthMiddleware(middleware.NewMockJWTValidator(ctrl), logger.NewNopLogger())
+
+		called := false
+		nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		middleware.RequireRole(entities.UserRoleAdmin)(nextHandler).ServeHTTP(w, newRequest(entities.UserRoleAdmin))
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("WrongRole", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		middleware := middleware.NewAuthMiddleware(middleware.NewMockJWTValidator(ctrl), logger.NewNopLogger())
+
+		nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("Next handler should not be called")
+		})
+
+		w := httptest.NewRecorder()
+		middleware.RequireRole(entities.UserRoleAdmin)(nextHandler).ServeHTTP(w, newRequest(entities.UserRoleUser))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "Insufficient permissions")
+	})
+
+	t.Run("NoRole", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		middleware := middleware.NewAuthMiddleware(middleware.NewMockJWTValidator(ctrl), logger.NewNopLogger())
+
+		nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("Next handler should not be called")
+		})
+
+		w := httptest.NewRecorder()
+		middleware.RequireRole(entities.UserRoleAdmin)(nextHandler).ServeHTTP(w, newRequest(""))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
 	})
 }
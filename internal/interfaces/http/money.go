@@ -0,0 +1,10 @@
+package handler
+
+import (
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+)
+
+func buildMoneyResponse(money entities.Money) dto.Money {
+	return dto.Money{Amount: money.Amount, Currency: money.Currency}
+}
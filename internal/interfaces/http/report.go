@@ -2,6 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
@@ -11,13 +14,16 @@ import (
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
 	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
 )
 
 //go:generate mockgen -source=report.go -destination=report_mock.go -package=handler
 
 type ReportService interface {
-	GetReport(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string) (*entities.Report, error)
-	GetReportSummary(ctx context.Context, userID string) (*entities.ReportSummary, error)
+	GetReport(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string, interval string) (*entities.Report, error)
+	GetReportSummary(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string) (*entities.ReportSummary, error)
+	GetReportExport(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string, interval string) (*entities.ReportExport, error)
+	GetReportHistory(ctx context.Context, userID string) ([]entities.ReportSnapshot, error)
 }
 
 // @Summary Get report
@@ -26,40 +32,21 @@ type ReportService interface {
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer {token}" default
-// @Param type query string true "Type of report (summary or detailed)"
-// @Param start query int64 false "Start time as Unix timestamp (seconds since epoch)"
-// @Param end query int64 false "End time as Unix timestamp (seconds since epoch)"
+// @Param type query string false "Period of the report: daily, weekly, monthly (default) or yearly"
+// @Param start query int64 false "Start time as Unix timestamp (seconds since epoch), defaults to the start of the current period"
+// @Param end query int64 false "End time as Unix timestamp (seconds since epoch), exclusive, defaults to one period after start"
+// @Param interval query string false "Bucket length of the time series: daily, weekly, monthly or yearly, picked from the period length by default"
 // @Success 200 {object} dto.ReportResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /reports/finance [get]
 func (h *Handler) GetReport(w http.ResponseWriter, r *http.Request) {
-	// Parse query parameters
 	reportType := r.URL.Query().Get("type")
-	start := r.URL.Query().Get("start")
-	end := r.URL.Query().Get("end")
-
-	var startDate, endDate time.Time
-	var err error
-	if start != "" {
-		startTimestamp, err := strconv.ParseInt(start, 10, 64) // Parse Unix timestamp
-		if err != nil {
-			h.log.Warnf("Invalid start timestamp format. Use a valid Unix timestamp.")
-			respond.WithError(w, r, h.log, err, httperror.ErrInvalidParameter, http.StatusBadRequest)
-			return
-		}
-		startDate = time.Unix(startTimestamp, 0).UTC() // Convert to time.Time in UTC
-	}
-
-	if end != "" {
-		endTimestamp, err := strconv.ParseInt(end, 10, 64) // Parse Unix timestamp
-		if err != nil {
-			h.log.Warnf("Invalid end timestamp format. Use a valid Unix timestamp.")
-			respond.WithError(w, r, h.log, err, httperror.ErrInvalidParameter, http.StatusBadRequest)
-			return
-		}
-		endDate = time.Unix(endTimestamp, 0).UTC() // Convert to time.Time in UTC
+	interval := r.URL.Query().Get("interval")
+	startDate, endDate, ok := h.parseReportPeriod(w, r)
+	if !ok {
+		return
 	}
 
 	userID, ok := contextutil.GetUserID(r.Context())
@@ -69,36 +56,36 @@ func (h *Handler) GetReport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	report, err := h.reportService.GetReport(r.Context(), userID, startDate, endDate, reportType)
+	report, err := h.reportService.GetReport(r.Context(), userID, startDate, endDate, reportType, interval)
 	if err != nil {
-		h.log.Errorf("failed to get report")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToGetReport, http.StatusInternalServerError)
+		h.respondWithReportError(w, r, err, httperror.ErrFailedToGetReport)
 		return
 	}
 
-	resp := dto.ReportResponse{
-		Revenue:     report.Revenue,
-		Expenses:    report.Expenses,
-		NetProfit:   report.NetProfit,
-		Categories:  report.Categories,
-		Amounts:     report.Amounts,
-		Percentages: report.Percentages,
-	}
-
-	respond.WithJSON(w, r, resp, http.StatusOK)
+	respond.WithJSON(w, r, buildReportResponse(report), http.StatusOK)
 }
 
 // @Summary Get report summary
-// @Description Get report summary generated by AI for a user
+// @Description Get a plain-language summary of the user's report for a period, generated by AI or from a template depending on the server configuration
 // @Tags reports
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer {token}" default
+// @Param type query string false "Period of the report: daily, weekly, monthly (default) or yearly"
+// @Param start query int64 false "Start time as Unix timestamp (seconds since epoch), defaults to the start of the current period"
+// @Param end query int64 false "End time as Unix timestamp (seconds since epoch), exclusive, defaults to one period after start"
 // @Success 200 {object} dto.ReportSummaryResponse
+// @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /reports/analysis [get]
 func (h *Handler) GetReportSummary(w http.ResponseWriter, r *http.Request) {
+	reportType := r.URL.Query().Get("type")
+	startDate, endDate, ok := h.parseReportPeriod(w, r)
+	if !ok {
+		return
+	}
+
 	userID, ok := contextutil.GetUserID(r.Context())
 	if !ok {
 		h.log.Warnf("failed to get user ID from context")
@@ -106,16 +93,175 @@ func (h *Handler) GetReportSummary(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	reportSummary, err := h.reportService.GetReportSummary(r.Context(), userID)
+	reportSummary, err := h.reportService.GetReportSummary(r.Context(), userID, startDate, endDate, reportType)
 	if err != nil {
-		h.log.Errorf("failed to get report summary")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToGetReportSummary, http.StatusInternalServerError)
+		h.respondWithReportError(w, r, err, httperror.ErrFailedToGetReportSummary)
 		return
 	}
 
 	resp := dto.ReportSummaryResponse{
 		Summary: reportSummary.Summary,
+		Start:   reportSummary.Start.Format(time.RFC3339),
+		End:     reportSummary.End.Format(time.RFC3339),
 	}
 
 	respond.WithJSON(w, r, resp, http.StatusOK)
 }
+
+// @Summary Export report
+// @Description Download the user's report for a period together with its transactions as CSV or as a PDF with a category chart. The format is taken from the format parameter, or negotiated from the Accept header when it is missing
+// @Tags reports
+// @Produce text/csv,application/pdf
+// @Param Authorization header string true "Bearer {token}" default
+// @Param format query string false "Export format: csv or pdf"
+// @Param type query string false "Period of the report: daily, weekly, monthly (default) or yearly"
+// @Param start query int64 false "Start time as Unix timestamp (seconds since epoch), defaults to the start of the current period"
+// @Param end query int64 false "End time as Unix timestamp (seconds since epoch), exclusive, defaults to one period after start"
+// @Param interval query string false "Bucket length of the time series: daily, weekly, monthly or yearly, picked from the period length by default"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 406 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /reports/finance/export [get]
+func (h *Handler) ExportReport(w http.ResponseWriter, r *http.Request) {
+	format, ok := h.negotiateReportExport(w, r)
+	if !ok {
+		return
+	}
+
+	reportType := r.URL.Query().Get("type")
+	interval := r.URL.Query().Get("interval")
+	startDate, endDate, ok := h.parseReportPeriod(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	export, err := h.reportService.GetReportExport(r.Context(), userID, startDate, endDate, reportType, interval)
+	if err != nil {
+		h.respondWithReportError(w, r, err, httperror.ErrFailedToExportReport)
+		return
+	}
+
+	filename := fmt.Sprintf("report-%s-%s.%s",
+		export.Report.Start.Format(time.DateOnly),
+		export.Report.End.Add(-time.Nanosecond).Format(time.DateOnly),
+		format.name)
+	respond.WithAttachment(w, r, h.log, format.contentType, filename, func(out io.Writer) error {
+		return format.write(out, export)
+	})
+}
+
+// @Summary Get report history
+// @Description List the user's monthly report snapshots, most recent first. A snapshot is taken when a month ends in the user's timezone and doesn't change afterwards
+// @Tags reports
+// @Produce json
+// @Param Authorization header string true "Bearer {token}" default
+// @Success 200 {object} dto.ReportHistoryResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /reports/history [get]
+func (h *Handler) GetReportHistory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	snapshots, err := h.reportService.GetReportHistory(r.Context(), userID)
+	if err != nil {
+		h.respondWithReportError(w, r, err, httperror.ErrFailedToGetReportHistory)
+		return
+	}
+
+	resp := dto.ReportHistoryResponse{
+		Snapshots: make([]dto.ReportSnapshotResponse, 0, len(snapshots)),
+	}
+	for i := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, dto.ReportSnapshotResponse{
+			ID:        snapshots[i].ID.Hex(),
+			Timezone:  snapshots[i].Timezone,
+			Report:    buildReportResponse(&snapshots[i].Report),
+			CreatedAt: snapshots[i].CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	respond.WithJSON(w, r, resp, http.StatusOK)
+}
+
+// parseReportPeriod reads the optional start and end Unix timestamps of a report. It
+// responds with an error and reports false when either is malformed.
+func (h *Handler) parseReportPeriod(w http.ResponseWriter, r *http.Request) (time.Time, time.Time, bool) {
+	var period [2]time.Time
+	for i, name := range []string{"start", "end"} {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			h.log.Warnf("Invalid %s timestamp format. Use a valid Unix timestamp.", name)
+			respond.WithError(w, r, h.log, err, httperror.ErrInvalidParameter, http.StatusBadRequest)
+			return time.Time{}, time.Time{}, false
+		}
+		period[i] = time.Unix(timestamp, 0).UTC()
+	}
+	return period[0], period[1], true
+}
+
+// respondWithReportError maps report domain errors to their HTTP status and anything else
+// to an internal error with the given message.
+func (h *Handler) respondWithReportError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case errors.Is(err, report_domain.ErrInvalidReportType):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidReportType, http.StatusBadRequest)
+	case errors.Is(err, report_domain.ErrInvalidPeriod):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidReportPeriod, http.StatusBadRequest)
+	case errors.Is(err, report_domain.ErrInvalidInterval):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidReportInterval, http.StatusBadRequest)
+	default:
+		h.log.WithError(err).Errorf("report request failed")
+		respond.WithError(w, r, h.log, err, message, http.StatusInternalServerError)
+	}
+}
+
+func buildReportResponse(report *entities.Report) dto.ReportResponse {
+	resp := dto.ReportResponse{
+		Start:       report.Start.Format(time.RFC3339),
+		End:         report.End.Format(time.RFC3339),
+		Currency:    report.Currency,
+		Revenue:     report.Revenue,
+		Expenses:    report.Expenses,
+		NetProfit:   report.NetProfit,
+		Categories:  report.Categories,
+		Amounts:     report.Amounts,
+		Percentages: report.Percentages,
+		Interval:    report.Interval,
+		Series:      make([]dto.ReportBucketResponse, 0, len(report.Series)),
+		Previous: dto.ReportComparisonResponse{
+			Revenue:         report.Previous.Revenue,
+			Expenses:        report.Previous.Expenses,
+			NetProfit:       report.Previous.NetProfit,
+			RevenueChange:   report.Previous.RevenueChange,
+			ExpensesChange:  report.Previous.ExpensesChange,
+			NetProfitChange: report.Previous.NetProfitChange,
+		},
+	}
+	for _, bucket := range report.Series {
+		resp.Series = append(resp.Series, dto.ReportBucketResponse{
+			Start:   bucket.Start.Format(time.RFC3339),
+			Income:  bucket.Income,
+			Expense: bucket.Expense,
+			Net:     bucket.Net,
+		})
+	}
+	return resp
+}
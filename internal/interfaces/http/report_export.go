@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/pdf"
+	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+)
+
+type reportExportFormat struct {
+	name        string
+	contentType string
+	write       func(io.Writer, *entities.ReportExport) error
+}
+
+// reportExportFormats lists the formats a report can be exported in, preferred first.
+var reportExportFormats = []reportExportFormat{
+	{name: "csv", contentType: "text/csv", write: writeReportCSV},
+	{name: "pdf", contentType: "application/pdf", write: writeReportPDF},
+}
+
+// negotiateReportExport picks the export format named by the format parameter or, when
+// it is missing, the one the Accept header prefers. It responds with an error and
+// reports false when the format is unknown or no format is acceptable.
+func (h *Handler) negotiateReportExport(w http.ResponseWriter, r *http.Request) (reportExportFormat, bool) {
+	if name := r.URL.Query().Get("format"); name != "" {
+		for _, format := range reportExportFormats {
+			if format.name == strings.ToLower(name) {
+				return format, true
+			}
+		}
+		respond.WithError(w, r, h.log, nil, httperror.ErrInvalidExportFormat, http.StatusBadRequest)
+		return reportExportFormat{}, false
+	}
+
+	contentTypes := make([]string, len(reportExportFormats))
+	for i, format := range reportExportFormats {
+		contentTypes[i] = format.contentType
+	}
+	contentType := respond.Negotiate(r, contentTypes...)
+	for _, format := range reportExportFormats {
+		if format.contentType == contentType {
+			return format, true
+		}
+	}
+	respond.WithError(w, r, h.log, nil, httperror.ErrExportNotAcceptable, http.StatusNotAcceptable)
+	return reportExportFormat{}, false
+}
+
+// writeReportCSV writes the report as consecutive tables separated by blank lines: the
+// totals, the expense categories, the time series and the transactions.
+func writeReportCSV(w io.Writer, export *entities.ReportExport) error {
+	report := export.Report
+	out := csv.NewWriter(w)
+
+	rows := [][]string{
+		{"Start", report.Start.Format(time.RFC3339)},
+		{"End", report.End.Format(time.RFC3339)},
+		{"Currency", report.Currency},
+		{"Income", formatAmount(report.Revenue, report.Currency)},
+		{"Expenses", formatAmount(report.Expenses, report.Currency)},
+		{"Net", formatAmount(report.NetProfit, report.Currency)},
+		{"Previous income", formatAmount(report.Previous.Revenue, report.Currency)},
+		{"Previous expenses", formatAmount(report.Previous.Expenses, report.Currency)},
+		{"Previous net", formatAmount(report.Previous.NetProfit, report.Currency)},
+		{},
+		{"Category", "Amount", "Percentage"},
+	}
+	for i, category := range report.Categories {
+		rows = append(rows, []string{
+			csvText(category),
+			formatAmount(report.Amounts[i], report.Currency),
+			strconv.FormatFloat(report.Percentages[i]*100, 'f', 2, 64),
+		})
+	}
+
+	rows = append(rows, []string{}, []string{"Interval start", "Income", "Expense", "Net"})
+	for _, bucket := range report.Series {
+		rows = append(rows, []string{
+			bucket.Start.Format(time.RFC3339),
+			formatAmount(bucket.Income, report.Currency),
+			formatAmount(bucket.Expense, report.Currency),
+			formatAmount(bucket.Net, report.Currency),
+		})
+	}
+
+	rows = append(rows, []string{}, []string{"Date", "Type", "Category", "Description", "Amount", "Currency", "Base Amount", "Base Currency"})
+	for _, row := range rows {
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+
+	for _, transaction := range export.Transactions {
+		if err := out.Write([]string{
+			transaction.Date.Format(time.RFC3339),
+			csvText(transaction.Type),
+			csvText(transaction.Category),
+			csvText(transaction.Description),
+			transaction.Amount.Decimal(),
+			transaction.Amount.Currency,
+			transaction.BaseAmount.Decimal(),
+			transaction.BaseAmount.Currency,
+		}); err != nil {
+			return err
+		}
+	}
+
+	out.Flush()
+	return out.Error()
+}
+
+// csvText keeps spreadsheets from evaluating user-entered text as a formula.
+func csvText(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// formatAmount formats minor units of the currency in major units, e.g. 1050 USD as
+// "10.50".
+func formatAmount(amount int64, currency string) string {
+	return entities.Money{Amount: amount, Currency: currency}.Decimal()
+}
+
+const (
+	pdfMargin     = 50.0
+	pdfRowHeight  = 16.0
+	pdfChartWidth = 260.0
+)
+
+// pdfChartColors are cycled through for the bars of the category chart.
+var pdfChartColors = []pdf.Color{
+	{R: 79, G: 129, B: 189},
+	{R: 192, G: 80, B: 77},
+	{R: 155, G: 187, B: 89},
+	{R: 128, G: 100, B: 162},
+	{R: 75, G: 172, B: 198},
+	{R: 247, G: 150, B: 70},
+}
+
+type pdfColumn struct {
+	title string
+	x     float64
+	width float64
+	right bool
+}
+
+// reportPDF lays out a report top to bottom, starting a new page when one is full.
+type reportPDF struct {
+	doc *pdf.Document
+	y   float64
+}
+
+// writeReportPDF writes the report as a PDF with the totals, a bar chart of the expense
+// categories, the time series and the transactions.
+func writeReportPDF(w io.Writer, export *entities.ReportExport) error {
+	report := export.Report
+	p := &reportPDF{doc: pdf.New()}
+
+	p.reserve(60)
+	p.y += 20
+	p.doc.Text(pdfMargin, p.y, pdf.Bold, 20, pdf.Black, "Finance report")
+	p.y += 18
+	period := report.Start.Format("Jan 2, 2006") + " - " + report.End.Add(-time.Nanosecond).Format("Jan 2, 2006") + ", amounts in " + report.Currency
+	p.doc.Text(pdfMargin, p.y, pdf.Regular, 10, pdf.Gray, period)
+	p.y += 10
+
+	p.heading("Summary")
+	p.table([]pdfColumn{
+		{title: "", x: pdfMargin, width: 145},
+		{title: "This period", x: 195, width: 110, right: true},
+		{title: "Previous period", x: 305, width: 110, right: true},
+		{title: "Change", x: 415, width: 130, right: true},
+	}, [][]string{
+		{"Income", formatAmount(report.Revenue, report.Currency), formatAmount(report.Previous.Revenue, report.Currency), formatChange(report.Previous.RevenueChange)},
+		{"Expenses", formatAmount(report.Expenses, report.Currency), formatAmount(report.Previous.Expenses, report.Currency), formatChange(report.Previous.ExpensesChange)},
+		{"Net", formatAmount(report.NetProfit, report.Currency), formatAmount(report.Previous.NetProfit, report.Currency), formatChange(report.Previous.NetProfitChange)},
+	})
+
+	p.heading("Expenses by category")
+	p.categoryChart(report)
+
+	p.heading("Over time")
+	series := make([][]string, len(report.Series))
+	for i, bucket := range report.Series {
+		series[i] = []string{
+			bucket.Start.Format("Jan 2, 2006"),
+			formatAmount(bucket.Income, report.Currency),
+			formatAmount(bucket.Expense, report.Currency),
+			formatAmount(bucket.Net, report.Currency),
+		}
+	}
+	p.table([]pdfColumn{
+		{title: "Period starting", x: pdfMargin, width: 150},
+		{title: "Income", x: 200, width: 110, right: true},
+		{title: "Expense", x: 310, width: 110, right: true},
+		{title: "Net", x: 420, width: 125, right: true},
+	}, series)
+
+	p.heading("Transactions")
+	if len(export.Transactions) == 0 {
+		p.note("No transactions in this period.")
+	} else {
+		transactions := make([][]string, len(export.Transactions))
+		for i, transaction := range export.Transactions {
+			transactions[i] = []string{
+				transaction.Date.Format("Jan 2, 2006"),
+				transaction.Type,
+				transaction.Category,
+				transaction.Description,
+				transaction.Amount.String(),
+			}
+		}
+		p.table([]pdfColumn{
+			{title: "Date", x: pdfMargin, width: 70},
+			{title: "Type", x: 120, width: 55},
+			{title: "Category", x: 175, width: 85},
+			{title: "Description", x: 260, width: 205},
+			{title: "Amount", x: 465, width: 80, right: true},
+		}, transactions)
+	}
+
+	_, err := p.doc.WriteTo(w)
+	return err
+}
+
+// reserve starts a new page unless height points are left on the current one.
+func (p *reportPDF) reserve(height float64) bool {
+	if p.doc.PageCount() > 0 && p.y+height <= pdf.PageHeight-pdfMargin {
+		return false
+	}
+	p.doc.AddPage()
+	p.y = pdfMargin
+	return true
+}
+
+func (p *reportPDF) heading(text string) {
+	// Keep the heading on the same page as at least two rows below it.
+	p.reserve(28 + 3*pdfRowHeight)
+	p.y += 28
+	p.doc.Text(pdfMargin, p.y, pdf.Bold, 13, pdf.Black, text)
+	p.y += 6
+}
+
+func (p *reportPDF) note(text string) {
+	p.reserve(pdfRowHeight)
+	p.y += pdfRowHeight
+	p.doc.Text(pdfMargin, p.y, pdf.Regular, 10, pdf.Gray, text)
+}
+
+// table draws rows under a header row, repeating the header on every new page.
+func (p *reportPDF) table(columns []pdfColumn, rows [][]string) {
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.title
+	}
+	p.reserve(2 * pdfRowHeight)
+	p.row(columns, header, pdf.Bold)
+
+	for _, row := range rows {
+		if p.reserve(pdfRowHeight) {
+			p.row(columns, header, pdf.Bold)
+		}
+		p.row(columns, row, pdf.Regular)
+	}
+}
+
+func (p *reportPDF) row(columns []pdfColumn, cells []string, font pdf.Font) {
+	const size = 9
+	p.y += pdfRowHeight
+	for i, column := range columns {
+		text := pdf.Truncate(cells[i], font, size, column.width-6)
+		x := column.x
+		if column.right {
+			x += column.width - pdf.TextWidth(text, font, size)
+		}
+		p.doc.Text(x, p.y, font, size, pdf.Black, text)
+	}
+	p.doc.Line(pdfMargin, p.y+5, pdf.PageWidth-pdfMargin, p.y+5, 0.5, pdf.LightGray)
+}
+
+// categoryChart draws one bar per expense category, scaled to the largest category.
+func (p *reportPDF) categoryChart(report *entities.Report) {
+	if len(report.Categories) == 0 {
+		p.note("No expenses in this period.")
+		return
+	}
+
+	const size = 9
+	largest := int64(0)
+	for _, amount := range report.Amounts {
+		largest = max(largest, amount)
+	}
+
+	for i, category := range report.Categories {
+		p.reserve(pdfRowHeight + 4)
+		p.y += pdfRowHeight + 4
+
+		label := pdf.Truncate(category, pdf.Regular, size, 110)
+		p.doc.Text(pdfMargin, p.y, pdf.Regular, size, pdf.Black, label)
+
+		width := 1.0
+		if largest > 0 {
+			width = max(pdfChartWidth*float64(report.Amounts[i])/float64(largest), 1)
+		}
+		p.doc.Rect(pdfMargin+120, p.y-9, width, 11, pdfChartColors[i%len(pdfChartColors)])
+
+		value := formatAmount(report.Amounts[i], report.Currency) + " (" + strconv.FormatFloat(report.Percentages[i]*100, 'f', 1, 64) + "%)"
+		p.doc.Text(pdfMargin+120+width+6, p.y, pdf.Regular, size, pdf.Black, value)
+	}
+}
+
+// formatChange formats a percent change with its sign, or a dash when there is none.
+func formatChange(change *float64) string {
+	if change == nil {
+		return "-"
+	}
+	text := strconv.FormatFloat(*change, 'f', -1, 64) + "%"
+	if *change > 0 {
+		text = "+" + text
+	}
+	return text
+}
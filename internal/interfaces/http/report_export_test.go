@@ -0,0 +1,342 @@
+package handler_test
+
+import (
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+)
+
+func newReportExport(transactionCount int) *entities.ReportExport {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	revenueChange := 25.0
+	export := &entities.ReportExport{
+		Report: &entities.Report{
+			Start:       start,
+			End:         time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+			Currency:    "USD",
+			Revenue:     5000,
+			Expenses:    2100,
+			NetProfit:   2900,
+			Categories:  []string{"Rent", "=Food"},
+			Amounts:     []int64{1500, 600},
+			Percentages: []float64{0.7143, 0.2857},
+			Interval:    report_domain.ReportTypeMonthly,
+			Series: []entities.ReportBucket{
+				{Start: start, Income: 5000, Expense: 2100, Net: 2900},
+			},
+			Previous: entities.ReportComparison{Revenue: 4000, Expenses: 2100, NetProfit: 1900, RevenueChange: &revenueChange},
+		},
+	}
+	for i := range transactionCount {
+		export.Transactions = append(export.Transactions, entities.Transaction{
+			ID:          primitive.NewObjectID(),
+			Amount:      entities.Money{Amount: int64(100 + i), Currency: "EUR"},
+			BaseAmount:  entities.Money{Amount: int64(110 + i), Currency: "USD"},
+			Description: fmt.Sprintf("Groceries, week %d", i+1),
+			Date:        start.AddDate(0, 0, i%31),
+			Category:    "Food",
+			Type:        entities.TransactionTypeExpense,
+		})
+	}
+	return export
+}
+
+var (
+	pdfCmapEntry = regexp.MustCompile(`<([0-9A-F]{4})> <([0-9A-F]+)>\n`)
+	pdfDrawnText = regexp.MustCompile(`<([0-9A-F]*)> Tj`)
+)
+
+// pdfTexts returns the strings drawn in a PDF, mapped back to Unicode.
+func pdfTexts(t *testing.T, body string) []string {
+	t.Helper()
+	chars := make(map[string]string)
+	for _, entry := range pdfCmapEntry.FindAllStringSubmatch(body, -1) {
+		units, err := hex.DecodeString(entry[2])
+		require.NoError(t, err)
+		utf := make([]uint16, len(units)/2)
+		for i := range utf {
+			utf[i] = binary.BigEndian.Uint16(units[2*i:])
+		}
+		chars[entry[1]] = string(utf16.Decode(utf))
+	}
+
+	var texts []string
+	for _, text := range pdfDrawnText.FindAllStringSubmatch(body, -1) {
+		var b strings.Builder
+		for i := 0; i < len(text[1]); i += 4 {
+			b.WriteString(chars[text[1][i:i+4]])
+		}
+		texts = append(texts, b.String())
+	}
+	return texts
+}
+
+func TestExportReport(t *testing.T) {
+	userID := "testUserID"
+
+	t.Run("Invalid format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=xlsx", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&errorResp))
+		assert.Equal(t, httperror.ErrInvalidExportFormat, errorResp.Message)
+	})
+
+	t.Run("Not acceptable", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export", nil)
+		r.Header.Set("Accept", "application/json")
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusNotAcceptable, w.Code)
+
+		var errorResp dto.ErrorResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&errorResp))
+		assert.Equal(t, httperror.ErrExportNotAcceptable, errorResp.Message)
+	})
+
+	t.Run("Invalid period", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=csv&end=tomorrow", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=csv", nil)
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		mockService.ReportService.EXPECT().
+			GetReportExport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=csv", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&errorResp))
+		assert.Equal(t, httperror.ErrFailedToExportReport, errorResp.Message)
+	})
+
+	t.Run("Invalid report type", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		mockService.ReportService.EXPECT().
+			GetReportExport(gomock.Any(), userID, gomock.Any(), gomock.Any(), "hourly", "").
+			Return(nil, report_domain.ErrInvalidReportType)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=pdf&type=hourly", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		export := newReportExport(2)
+		export.Transactions[1].Description = "-refund"
+		export.Transactions[1].Type = "@SUM(A1)"
+		start := time.Unix(1740787200, 0).UTC()
+		end := time.Unix(1743465600, 0).UTC()
+		mockService.ReportService.EXPECT().
+			GetReportExport(gomock.Any(), userID, start, end, "monthly", "weekly").
+			Return(export, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=CSV&type=monthly&interval=weekly&start=1740787200&end=1743465600", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename=report-2025-03-01-2025-03-31.csv`, w.Header().Get("Content-Disposition"))
+
+		reader := csv.NewReader(w.Body)
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"Start", "2025-03-01T00:00:00Z"},
+			{"End", "2025-04-01T00:00:00Z"},
+			{"Currency", "USD"},
+			{"Income", "50.00"},
+			{"Expenses", "21.00"},
+			{"Net", "29.00"},
+			{"Previous income", "40.00"},
+			{"Previous expenses", "21.00"},
+			{"Previous net", "19.00"},
+			{"Category", "Amount", "Percentage"},
+			{"Rent", "15.00", "71.43"},
+			{"'=Food", "6.00", "28.57"},
+			{"Interval start", "Income", "Expense", "Net"},
+			{"2025-03-01T00:00:00Z", "50.00", "21.00", "29.00"},
+			{"Date", "Type", "Category", "Description", "Amount", "Currency", "Base Amount", "Base Currency"},
+			{"2025-03-01T00:00:00Z", "expense", "Food", "Groceries, week 1", "1.00", "EUR", "1.10", "USD"},
+			{"2025-03-02T00:00:00Z", "'@SUM(A1)", "Food", "'-refund", "1.01", "EUR", "1.11", "USD"},
+		}, records)
+	})
+
+	t.Run("PDF negotiated from the Accept header", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		mockService.ReportService.EXPECT().
+			GetReportExport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(newReportExport(3), nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export", nil)
+		r.Header.Set("Accept", "application/pdf, text/csv;q=0.5")
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename=report-2025-03-01-2025-03-31.pdf`, w.Header().Get("Content-Disposition"))
+
+		body := w.Body.String()
+		assert.True(t, strings.HasPrefix(body, "%PDF-"))
+		assert.Contains(t, body, "/Count 1")
+		texts := pdfTexts(t, body)
+		assert.Contains(t, texts, "Finance report")
+		assert.Contains(t, texts, "Mar 1, 2025 - Mar 31, 2025, amounts in USD")
+		assert.Contains(t, texts, "Expenses by category")
+		assert.Contains(t, texts, "15.00 (71.4%)")
+		assert.Contains(t, texts, "1.02 EUR")
+		assert.Contains(t, texts, "+25%")
+		assert.Contains(t, texts, "Groceries, week 3")
+	})
+
+	t.Run("PDF of non-Latin text", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		export := newReportExport(1)
+		export.Report.Categories = []string{"Аренда", "餐飲"}
+		export.Transactions[0].Category = "Ψώνια"
+		mockService.ReportService.EXPECT().
+			GetReportExport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(export, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=pdf", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		texts := pdfTexts(t, w.Body.String())
+		assert.Contains(t, texts, "Аренда")
+		assert.Contains(t, texts, "餐飲")
+		assert.Contains(t, texts, "Ψώνια")
+	})
+
+	t.Run("PDF spanning several pages", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		mockService.ReportService.EXPECT().
+			GetReportExport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(newReportExport(120), nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=pdf", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		assert.Contains(t, body, "/Count 4")
+		// The table header is repeated on every page of transactions.
+		texts := pdfTexts(t, body)
+		descriptions := 0
+		for _, text := range texts {
+			if text == "Description" {
+				descriptions++
+			}
+		}
+		assert.Equal(t, 4, descriptions)
+		assert.Contains(t, texts, "Groceries, week 120")
+	})
+
+	t.Run("PDF of an empty period", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		export := &entities.ReportExport{Report: &entities.Report{
+			Start: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC),
+		}}
+		mockService.ReportService.EXPECT().
+			GetReportExport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(export, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/finance/export?format=pdf", nil)
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.ExportReport(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `attachment; filename=report-2025-03-01-2025-03-01.pdf`, w.Header().Get("Content-Disposition"))
+		texts := pdfTexts(t, w.Body.String())
+		assert.Contains(t, texts, "No expenses in this period.")
+		assert.Contains(t, texts, "No transactions in this period.")
+		assert.Contains(t, texts, "-")
+	})
+}
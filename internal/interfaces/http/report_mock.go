@@ -43,31 +43,61 @@ func (m *MockReportService) EXPECT() *MockReportServiceMockRecorder {
 }
 
 // GetReport mocks base method.
-func (m *MockReportService) GetReport(ctx context.Context, userID string, startTime, endTime time.Time, reportType string) (*entities.Report, error) {
+func (m *MockReportService) GetReport(ctx context.Context, userID string, startTime, endTime time.Time, reportType, interval string) (*entities.Report, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReport", ctx, userID, startTime, endTime, reportType)
+	ret := m.ctrl.Call(m, "GetReport", ctx, userID, startTime, endTime, reportType, interval)
 	ret0, _ := ret[0].(*entities.Report)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReport indicates an expected call of GetReport.
-func (mr *MockReportServiceMockRecorder) GetReport(ctx, userID, startTime, endTime, reportType any) *gomock.Call {
+func (mr *MockReportServiceMockRecorder) GetReport(ctx, userID, startTime, endTime, reportType, interval any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReportService)(nil).GetReport), ctx, userID, startTime, endTime, reportType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReportService)(nil).GetReport), ctx, userID, startTime, endTime, reportType, interval)
+}
+
+// GetReportExport mocks base method.
+func (m *MockReportService) GetReportExport(ctx context.Context, userID string, startTime, endTime time.Time, reportType, interval string) (*entities.ReportExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportExport", ctx, userID, startTime, endTime, reportType, interval)
+	ret0, _ := ret[0].(*entities.ReportExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportExport indicates an expected call of GetReportExport.
+func (mr *MockReportServiceMockRecorder) GetReportExport(ctx, userID, startTime, endTime, reportType, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportExport", reflect.TypeOf((*MockReportService)(nil).GetReportExport), ctx, userID, startTime, endTime, reportType, interval)
+}
+
+// GetReportHistory mocks base method.
+func (m *MockReportService) GetReportHistory(ctx context.Context, userID string) ([]entities.ReportSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportHistory", ctx, userID)
+	ret0, _ := ret[0].([]entities.ReportSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportHistory indicates an expected call of GetReportHistory.
+func (mr *MockReportServiceMockRecorder) GetReportHistory(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportHistory", reflect.TypeOf((*MockReportService)(nil).GetReportHistory), ctx, userID)
 }
 
 // GetReportSummary mocks base method.
-func (m *MockReportService) GetReportSummary(ctx context.Context, userID string) (*entities.ReportSummary, error) {
+func (m *MockReportService) GetReportSummary(ctx context.Context, userID string, startTime, endTime time.Time, reportType string) (*entities.ReportSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReportSummary", ctx, userID)
+	ret := m.ctrl.Call(m, "GetReportSummary", ctx, userID, startTime, endTime, reportType)
 	ret0, _ := ret[0].(*entities.ReportSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReportSummary indicates an expected call of GetReportSummary.
-func (mr *MockReportServiceMockRecorder) GetReportSummary(ctx, userID any) *gomock.Call {
+func (mr *MockReportServiceMockRecorder) GetReportSummary(ctx, userID, startTime, endTime, reportType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportSummary", reflect.TypeOf((*MockReportService)(nil).GetReportSummary), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportSummary", reflect.TypeOf((*MockReportService)(nil).GetReportSummary), ctx, userID, startTime, endTime, reportType)
 }
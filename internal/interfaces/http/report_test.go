@@ -6,11 +6,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/mock/gomock"
 )
 
@@ -40,7 +43,7 @@ func TestGetReport(t *testing.T) {
 		h, _ := newTestHandler(t)
 
 		w := httptest.NewRecorder()
-		r := httptest.NewRequest("GET", "/reports/finance?type=summary&start=invalid-date&end=1748908800", nil)
+		r := httptest.NewRequest("GET", "/reports/finance?type=monthly&start=invalid-date&end=1748908800", nil)
 
 		h.GetReport(w, r)
 
@@ -64,11 +67,11 @@ func TestGetReport(t *testing.T) {
 		userEmail := "test@example.com"
 
 		mockService.ReportService.EXPECT().
-			GetReport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any()).
+			GetReport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("service error"))
 
 		w := httptest.NewRecorder()
-		r := httptest.NewRequest("GET", "/reports/finance?type=summary&start=1735689600&end=1748908800", nil)
+		r := httptest.NewRequest("GET", "/reports/finance?type=monthly&start=1735689600&end=1748908800", nil)
 		ctx := newContext(userID, userEmail)
 		r = r.WithContext(ctx)
 
@@ -84,27 +87,69 @@ func TestGetReport(t *testing.T) {
 		assert.Equal(t, httperror.ErrFailedToGetReport, errorResp.Message)
 	})
 
+	domainErrors := []struct {
+		name    string
+		err     error
+		wantMsg string
+	}{
+		{"Invalid report type", report_domain.ErrInvalidReportType, httperror.ErrInvalidReportType},
+		{"Invalid period", report_domain.ErrInvalidPeriod, httperror.ErrInvalidReportPeriod},
+		{"Invalid interval", report_domain.ErrInvalidInterval, httperror.ErrInvalidReportInterval},
+	}
+	for _, tc := range domainErrors {
+		t.Run(tc.name, func(t *testing.T) {
+			h, mockService := newTestHandler(t)
+
+			mockService.ReportService.EXPECT().
+				GetReport(gomock.Any(), "testUserID", gomock.Any(), gomock.Any(), "monthly", "hourly").
+				Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "/reports/finance?type=monthly&interval=hourly", nil)
+			r = r.WithContext(newContext("testUserID", "test@example.com"))
+
+			h.GetReport(w, r)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMsg, errorResp.Message)
+		})
+	}
+
 	t.Run("Success", func(t *testing.T) {
 		h, mockService := newTestHandler(t)
 
 		userID := "testUserID"
 		userEmail := "test@example.com"
 
+		revenueChange := 25.0
 		report := &entities.Report{
+			Start:       time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			End:         time.Date(2025, time.January, 3, 0, 0, 0, 0, time.UTC),
+			Currency:    "EUR",
 			Revenue:     1000,
 			Expenses:    500,
 			NetProfit:   500,
 			Categories:  []string{"Food", "Transport"},
 			Amounts:     []int64{200, 300},
 			Percentages: []float64{0.4, 0.6},
+			Interval:    "daily",
+			Series: []entities.ReportBucket{
+				{Start: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Income: 1000, Net: 1000},
+				{Start: time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC), Expense: 500, Net: -500},
+			},
+			Previous: entities.ReportComparison{Revenue: 800, Expenses: 500, NetProfit: 300, RevenueChange: &revenueChange},
 		}
 
 		mockService.ReportService.EXPECT().
-			GetReport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any()).
+			GetReport(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(report, nil)
 
 		w := httptest.NewRecorder()
-		r := httptest.NewRequest("GET", "/reports/finance?type=summary&start=1735689600&end=1748908800", nil)
+		r := httptest.NewRequest("GET", "/reports/finance?type=monthly&start=1735689600&end=1748908800", nil)
 		ctx := newContext(userID, userEmail)
 		r = r.WithContext(ctx)
 
@@ -116,12 +161,23 @@ func TestGetReport(t *testing.T) {
 		var response dto.ReportResponse
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
+		assert.Equal(t, "EUR", response.Currency)
 		assert.Equal(t, report.Revenue, response.Revenue)
 		assert.Equal(t, report.Expenses, response.Expenses)
 		assert.Equal(t, report.NetProfit, response.NetProfit)
 		assert.Equal(t, report.Categories, response.Categories)
 		assert.Equal(t, report.Amounts, response.Amounts)
 		assert.Equal(t, report.Percentages, response.Percentages)
+		assert.Equal(t, "2025-01-01T00:00:00Z", response.Start)
+		assert.Equal(t, "2025-01-03T00:00:00Z", response.End)
+		assert.Equal(t, "daily", response.Interval)
+		assert.Equal(t, []dto.ReportBucketResponse{
+			{Start: "2025-01-01T00:00:00Z", Income: 1000, Net: 1000},
+			{Start: "2025-01-02T00:00:00Z", Expense: 500, Net: -500},
+		}, response.Series)
+		assert.Equal(t, int64(800), response.Previous.Revenue)
+		assert.Equal(t, &revenueChange, response.Previous.RevenueChange)
+		assert.Nil(t, response.Previous.ExpensesChange)
 	})
 }
 
@@ -154,7 +210,7 @@ func TestGetReportSummary(t *testing.T) {
 		userEmail := "test@example.com"
 
 		mockService.ReportService.EXPECT().
-			GetReportSummary(gomock.Any(), userID).
+			GetReportSummary(gomock.Any(), userID, gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("service error"))
 
 		w := httptest.NewRecorder()
@@ -180,16 +236,20 @@ func TestGetReportSummary(t *testing.T) {
 		userID := "testUserID"
 		userEmail := "test@example.com"
 
+		start := time.Unix(1735689600, 0).UTC()
+		end := time.Unix(1738368000, 0).UTC()
 		reportSummary := &entities.ReportSummary{
 			Summary: "This is a summary",
+			Start:   start,
+			End:     end,
 		}
 
 		mockService.ReportService.EXPECT().
-			GetReportSummary(gomock.Any(), userID).
+			GetReportSummary(gomock.Any(), userID, start, end, "monthly").
 			Return(reportSummary, nil)
 
 		w := httptest.NewRecorder()
-		r := httptest.NewRequest("GET", "/reports/analysis", nil)
+		r := httptest.NewRequest("GET", "/reports/analysis?type=monthly&start=1735689600&end=1738368000", nil)
 		ctx := newContext(userID, userEmail)
 		r = r.WithContext(ctx)
 
@@ -202,5 +262,140 @@ func TestGetReportSummary(t *testing.T) {
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
 		assert.Equal(t, reportSummary.Summary, response.Summary)
+		assert.Equal(t, "2025-01-01T00:00:00Z", response.Start)
+		assert.Equal(t, "2025-02-01T00:00:00Z", response.End)
+	})
+
+	t.Run("Invalid start", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/analysis?start=yesterday", nil)
+		r = r.WithContext(newContext("testUserID", "test@example.com"))
+
+		h.GetReportSummary(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidParameter, errorResp.Message)
+	})
+
+	t.Run("Invalid report type", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		mockService.ReportService.EXPECT().
+			GetReportSummary(gomock.Any(), "testUserID", gomock.Any(), gomock.Any(), "hourly").
+			Return(nil, report_domain.ErrInvalidReportType)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/analysis?type=hourly", nil)
+		r = r.WithContext(newContext("testUserID", "test@example.com"))
+
+		h.GetReportSummary(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetReportHistory(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/history", nil)
+
+		h.GetReportHistory(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUnauthorized, errorResp.Message)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		mockService.ReportService.EXPECT().
+			GetReportHistory(gomock.Any(), "testUserID").
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/history", nil)
+		r = r.WithContext(newContext("testUserID", "test@example.com"))
+
+		h.GetReportHistory(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetReportHistory, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		snapshotID := primitive.NewObjectID()
+		snapshots := []entities.ReportSnapshot{
+			{
+				ID:       snapshotID,
+				Timezone: "Asia/Taipei",
+				Report: entities.Report{
+					Start:      time.Date(2025, time.February, 28, 16, 0, 0, 0, time.UTC),
+					End:        time.Date(2025, time.March, 31, 16, 0, 0, 0, time.UTC),
+					Revenue:    5000,
+					Expenses:   1500,
+					NetProfit:  3500,
+					Categories: []string{"Rent"},
+					Amounts:    []int64{1500},
+				},
+				CreatedAt: time.Date(2025, time.March, 31, 16, 5, 0, 0, time.UTC),
+			},
+		}
+
+		mockService.ReportService.EXPECT().
+			GetReportHistory(gomock.Any(), "testUserID").
+			Return(snapshots, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/history", nil)
+		r = r.WithContext(newContext("testUserID", "test@example.com"))
+
+		h.GetReportHistory(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.ReportHistoryResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Snapshots, 1)
+		assert.Equal(t, snapshotID.Hex(), response.Snapshots[0].ID)
+		assert.Equal(t, "Asia/Taipei", response.Snapshots[0].Timezone)
+		assert.Equal(t, "2025-03-31T16:05:00Z", response.Snapshots[0].CreatedAt)
+		assert.Equal(t, int64(3500), response.Snapshots[0].Report.NetProfit)
+		assert.Equal(t, []string{"Rent"}, response.Snapshots[0].Report.Categories)
+	})
+
+	t.Run("No snapshots", func(t *testing.T) {
+		h, mockService := newTestHandler(t)
+
+		mockService.ReportService.EXPECT().
+			GetReportHistory(gomock.Any(), "testUserID").
+			Return(nil, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/reports/history", nil)
+		r = r.WithContext(newContext("testUserID", "test@example.com"))
+
+		h.GetReportHistory(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"snapshots":[]}`, w.Body.String())
 	})
 }
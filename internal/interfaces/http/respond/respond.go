@@ -2,7 +2,11 @@ package respond
 
 import (
 	"encoding/json"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/Financial-Partner/server/internal/infrastructure/logger"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
@@ -33,3 +37,82 @@ func WithJSON(w http.ResponseWriter, r *http.Request, data interface{}, statusCo
 	w.WriteHeader(statusCode)
 	_ = json.NewEncoder(w).Encode(data)
 }
+
+// WithAttachment sends what write produces as a file download. The headers are sent
+// before write runs, so an error from write can only be logged.
+func WithAttachment(w http.ResponseWriter, r *http.Request, log logger.Logger, contentType string, filename string, write func(io.Writer) error) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.WriteHeader(http.StatusOK)
+	if err := write(w); err != nil {
+		log.WithError(err).Errorf("Failed to write %s", filename)
+	}
+}
+
+// Negotiate returns the offered media type the request's Accept header prefers. Ties go
+// to the earlier offer, and the first offer is returned when there is no Accept header.
+// It returns "" when none of the offers is acceptable.
+func Negotiate(r *http.Request, offers ...string) string {
+	header := r.Header.Get("Accept")
+	if header == "" {
+		if len(offers) == 0 {
+			return ""
+		}
+		return offers[0]
+	}
+
+	ranges := parseAccept(header)
+	best, bestQuality := "", 0.0
+	for _, offer := range offers {
+		if quality := acceptQuality(ranges, offer); quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+	return best
+}
+
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+func parseAccept(header string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+	}
+	return ranges
+}
+
+// acceptQuality returns the quality of the most specific range matching mediaType.
+func acceptQuality(ranges []mediaRange, mediaType string) float64 {
+	mainType, _, _ := strings.Cut(mediaType, "/")
+	quality, specificity := 0.0, -1
+	for _, rng := range ranges {
+		var s int
+		switch rng.mediaType {
+		case mediaType:
+			s = 2
+		case mainType + "/*":
+			s = 1
+		case "*/*":
+			s = 0
+		default:
+			continue
+		}
+		if s > specificity {
+			quality, specificity = rng.quality, s
+		}
+	}
+	return quality
+}
@@ -3,6 +3,7 @@ package respond_test
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -96,3 +97,67 @@ func TestWithJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestWithAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/test", nil)
+
+		respond.WithAttachment(w, r, logger.NewNopLogger(), "text/csv", "report 2025-03.csv", func(out io.Writer) error {
+			_, err := io.WriteString(out, "a,b\n")
+			return err
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="report 2025-03.csv"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "a,b\n", w.Body.String())
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/test", nil)
+
+		respond.WithAttachment(w, r, logger.NewNopLogger(), "text/csv", "report.csv", func(out io.Writer) error {
+			return errors.New("write error")
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
+
+func TestNegotiate(t *testing.T) {
+	offers := []string{"text/csv", "application/pdf"}
+
+	tests := []struct {
+		name   string
+		accept string
+		want   string
+	}{
+		{name: "no accept header", accept: "", want: "text/csv"},
+		{name: "exact match", accept: "application/pdf", want: "application/pdf"},
+		{name: "wildcard", accept: "*/*", want: "text/csv"},
+		{name: "type wildcard", accept: "application/*", want: "application/pdf"},
+		{name: "quality", accept: "text/csv;q=0.5, application/pdf", want: "application/pdf"},
+		{name: "specific range overrides wildcard", accept: "*/*, text/csv;q=0", want: "application/pdf"},
+		{name: "malformed ranges are ignored", accept: "text/csv;q=high, ;, application/pdf;q=0.1", want: "application/pdf"},
+		{name: "not acceptable", accept: "application/json", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+
+			assert.Equal(t, tt.want, respond.Negotiate(r, offers...))
+		})
+	}
+
+	t.Run("no offers", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/test", nil)
+		assert.Equal(t, "", respond.Negotiate(r))
+	})
+}
@@ -3,30 +3,54 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/gorilla/mux"
+
 	"github.com/Financial-Partner/server/internal/contextutil"
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
 	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
 )
 
 //go:generate mockgen -source=transaction.go -destination=transaction_mock.go -package=handler
 
 type TransactionService interface {
 	CreateTransaction(ctx context.Context, UserID string, transaction *dto.CreateTransactionRequest) (*entities.Transaction, error)
-	GetTransactions(ctx context.Context, UserID string) ([]entities.Transaction, error)
+	GetTransactions(ctx context.Context, userID string, query *dto.GetTransactionsQuery) (*entities.TransactionPage, error)
+	GetTransaction(ctx context.Context, userID, transactionID string) (*entities.Transaction, error)
+	UpdateTransaction(ctx context.Context, userID, transactionID string, req *dto.UpdateTransactionRequest) (*entities.Transaction, error)
+	DeleteTransaction(ctx context.Context, userID, transactionID string) error
+	ImportTransactions(ctx context.Context, userID string, statement io.Reader, req *dto.ImportTransactionsRequest) (*entities.TransactionImport, error)
 }
 
 // @Summary Get transactions
-// @Description Get transactions for a user
+// @Description Get a page of the user's transactions, newest first by default. Pass next_cursor from a response as cursor to get the following page
 // @Tags transactions
 // @Accept json
 // @Produce json
+// @Param from query string false "Earliest transaction date (YYYY-MM-DD)"
+// @Param to query string false "Latest transaction date (YYYY-MM-DD)"
+// @Param category query string false "Category"
+// @Param type query string false "Transaction type" Enums(income, expense)
+// @Param min_amount query int false "Minimum amount"
+// @Param max_amount query int false "Maximum amount"
+// @Param q query string false "Text to search for in descriptions"
+// @Param sort query string false "Date order" Enums(desc, asc) default(desc)
+// @Param limit query int false "Page size, at most 100" default(20)
+// @Param cursor query string false "Cursor of the page to get"
 // @Param Authorization header string true "Bearer {token}" default "Bearer "
 // @Success 200 {object} dto.GetTransactionsResponse
+// @Failure 400 {object} dto.ErrorResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /transactions [get]
@@ -38,28 +62,32 @@ func (h *Handler) GetTransactions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transactions, err := h.transactionService.GetTransactions(r.Context(), userID)
-	if err != nil {
-		h.log.Errorf("failed to get transactions")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToGetTransactions, http.StatusInternalServerError)
-		return
+	params := r.URL.Query()
+	query := dto.GetTransactionsQuery{
+		From:      params.Get("from"),
+		To:        params.Get("to"),
+		Category:  params.Get("category"),
+		Type:      params.Get("type"),
+		MinAmount: params.Get("min_amount"),
+		MaxAmount: params.Get("max_amount"),
+		Search:    params.Get("q"),
+		Sort:      params.Get("sort"),
+		Limit:     params.Get("limit"),
+		Cursor:    params.Get("cursor"),
 	}
 
-	var transactionResponses []dto.TransactionResponse
-	for _, transaction := range transactions {
-		transactionResponses = append(transactionResponses, dto.TransactionResponse{
-			Amount:      transaction.Amount,
-			Category:    transaction.Category,
-			Type:        transaction.Type,
-			Date:        transaction.Date.Format(time.DateOnly),
-			Description: transaction.Description,
-			CreatedAt:   transaction.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:   transaction.UpdatedAt.Format(time.RFC3339),
-		})
+	page, err := h.transactionService.GetTransactions(r.Context(), userID, &query)
+	if err != nil {
+		h.respondWithTransactionError(w, r, err, httperror.ErrFailedToGetTransactions)
+		return
 	}
 
 	resp := dto.GetTransactionsResponse{
-		Transactions: transactionResponses,
+		Transactions: make([]dto.TransactionResponse, 0, len(page.Transactions)),
+		NextCursor:   page.NextCursor,
+	}
+	for i := range page.Transactions {
+		resp.Transactions = append(resp.Transactions, buildTransactionResponse(&page.Transactions[i]))
 	}
 
 	respond.WithJSON(w, r, resp, http.StatusOK)
@@ -94,13 +122,208 @@ func (h *Handler) CreateTransaction(w http.ResponseWriter, r *http.Request) {
 
 	transaction, err := h.transactionService.CreateTransaction(r.Context(), userID, &req)
 	if err != nil {
-		h.log.Errorf("failed to create transaction: %v", err)
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToCreateTransaction, http.StatusInternalServerError)
+		h.respondWithTransactionError(w, r, err, httperror.ErrFailedToCreateTransaction)
+		return
+	}
+
+	respond.WithJSON(w, r, buildTransactionResponse(transaction), http.StatusOK)
+}
+
+// @Summary Get a transaction
+// @Description Get one of the user's transactions
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Param id path string true "Transaction ID"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.TransactionResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /transactions/{id} [get]
+func (h *Handler) GetTransaction(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	transaction, err := h.transactionService.GetTransaction(r.Context(), userID, mux.Vars(r)["id"])
+	if err != nil {
+		h.respondWithTransactionError(w, r, err, httperror.ErrFailedToGetTransaction)
+		return
+	}
+
+	respond.WithJSON(w, r, buildTransactionResponse(transaction), http.StatusOK)
+}
+
+// @Summary Update a transaction
+// @Description Correct the amount, category, type, date or description of one of the user's transactions
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Param id path string true "Transaction ID"
+// @Param request body dto.UpdateTransactionRequest true "Update transaction request"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.TransactionResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /transactions/{id} [put]
+func (h *Handler) UpdateTransaction(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	var req dto.UpdateTransactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.WithError(err).Warnf("failed to decode request body")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	transaction, err := h.transactionService.UpdateTransaction(r.Context(), userID, mux.Vars(r)["id"], &req)
+	if err != nil {
+		h.respondWithTransactionError(w, r, err, httperror.ErrFailedToUpdateTransaction)
+		return
+	}
+
+	respond.WithJSON(w, r, buildTransactionResponse(transaction), http.StatusOK)
+}
+
+// @Summary Delete a transaction
+// @Description Delete one of the user's transactions
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Param id path string true "Transaction ID"
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /transactions/{id} [delete]
+func (h *Handler) DeleteTransaction(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.transactionService.DeleteTransaction(r.Context(), userID, mux.Vars(r)["id"]); err != nil {
+		h.respondWithTransactionError(w, r, err, httperror.ErrFailedToDeleteTransaction)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// maxStatementSize bounds the size of an imported bank statement upload.
+const maxStatementSize = 5 << 20
+
+// @Summary Import a bank statement
+// @Description Preview the transactions of a CSV, OFX or QFX bank statement row by row. Rows matching a recorded transaction by date, amount and description are duplicates. A preview saves nothing; send the same file with commit set to save the new rows
+// @Tags transactions
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Bank statement"
+// @Param format formData string false "Statement format, by default the file name's extension" Enums(csv, ofx, qfx)
+// @Param mapping formData string false "Column mapping of a CSV statement, a JSON dto.CSVMapping"
+// @Param currency formData string false "Currency of amounts the statement gives none for, by default the user's base currency"
+// @Param commit formData bool false "Save the new rows" default(false)
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.ImportTransactionsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 413 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /transactions/import [post]
+func (h *Handler) ImportTransactions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Warnf("failed to get user ID from context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxStatementSize)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respond.WithError(w, r, h.log, err, httperror.ErrStatementTooLarge, http.StatusRequestEntityTooLarge)
+			return
+		}
+		h.log.WithError(err).Warnf("failed to read statement file")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	req := dto.ImportTransactionsRequest{
+		Format:   r.FormValue("format"),
+		Currency: r.FormValue("currency"),
+	}
+	if req.Format == "" {
+		req.Format = strings.TrimPrefix(filepath.Ext(header.Filename), ".")
+	}
+	if mapping := r.FormValue("mapping"); mapping != "" {
+		req.Mapping = &dto.CSVMapping{}
+		if err := json.Unmarshal([]byte(mapping), req.Mapping); err != nil {
+			h.log.WithError(err).Warnf("failed to decode column mapping")
+			respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+			return
+		}
+	}
+	if commit := r.FormValue("commit"); commit != "" {
+		if req.Commit, err = strconv.ParseBool(commit); err != nil {
+			respond.WithError(w, r, h.log, err, httperror.ErrInvalidParameter, http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := h.transactionService.ImportTransactions(r.Context(), userID, file, &req)
+	if err != nil {
+		h.respondWithTransactionError(w, r, err, httperror.ErrFailedToImportTransactions)
 		return
 	}
 
-	resp := dto.TransactionResponse{
-		Amount:      transaction.Amount,
+	respond.WithJSON(w, r, buildImportTransactionsResponse(result), http.StatusOK)
+}
+
+func (h *Handler) respondWithTransactionError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case errors.Is(err, transaction_domain.ErrInvalidTransactionDate):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidTransactionDate, http.StatusBadRequest)
+	case errors.Is(err, transaction_domain.ErrInvalidAmount):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidTransactionAmount, http.StatusBadRequest)
+	case errors.Is(err, exchange_domain.ErrRateNotFound):
+		respond.WithError(w, r, h.log, err, httperror.ErrExchangeRateNotFound, http.StatusBadRequest)
+	case errors.Is(err, transaction_domain.ErrInvalidQuery):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidParameter, http.StatusBadRequest)
+	case errors.Is(err, transaction_domain.ErrInvalidStatement):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidStatement, http.StatusBadRequest)
+	case errors.Is(err, transaction_domain.ErrStatementTooLarge):
+		respond.WithError(w, r, h.log, err, httperror.ErrStatementTooLarge, http.StatusRequestEntityTooLarge)
+	case errors.Is(err, transaction_domain.ErrTransactionNotFound):
+		respond.WithError(w, r, h.log, err, httperror.ErrTransactionNotFound, http.StatusNotFound)
+	default:
+		h.log.WithError(err).Warnf("transaction request failed")
+		respond.WithError(w, r, h.log, err, message, http.StatusInternalServerError)
+	}
+}
+
+func buildTransactionResponse(transaction *entities.Transaction) dto.TransactionResponse {
+	return dto.TransactionResponse{
+		ID:          transaction.ID.Hex(),
+		Amount:      buildMoneyResponse(transaction.Amount),
+		BaseAmount:  buildMoneyResponse(transaction.BaseAmount),
 		Category:    transaction.Category,
 		Type:        transaction.Type,
 		Date:        transaction.Date.Format(time.DateOnly),
@@ -108,6 +331,37 @@ func (h *Handler) CreateTransaction(w http.ResponseWriter, r *http.Request) {
 		CreatedAt:   transaction.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   transaction.UpdatedAt.Format(time.RFC3339),
 	}
+}
 
-	respond.WithJSON(w, r, resp, http.StatusOK)
+func buildImportTransactionsResponse(result *entities.TransactionImport) dto.ImportTransactionsResponse {
+	resp := dto.ImportTransactionsResponse{
+		Committed: result.Committed,
+		Rows:      make([]dto.ImportRowResponse, 0, len(result.Rows)),
+	}
+	for _, row := range result.Rows {
+		switch row.Status {
+		case entities.ImportRowNew:
+			resp.New++
+		case entities.ImportRowDuplicate:
+			resp.Duplicates++
+		case entities.ImportRowInvalid:
+			resp.Invalid++
+		}
+
+		rowResp := dto.ImportRowResponse{
+			Line:   row.Line,
+			Status: row.Status,
+			Error:  row.Error,
+		}
+		if row.Transaction != nil {
+			transaction := buildTransactionResponse(row.Transaction)
+			// Transactions that weren't saved have no ID yet.
+			if row.Transaction.ID.IsZero() {
+				transaction.ID = ""
+			}
+			rowResp.Transaction = &transaction
+		}
+		resp.Rows = append(resp.Rows, rowResp)
+	}
+	return resp
 }
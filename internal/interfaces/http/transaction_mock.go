@@ -11,6 +11,7 @@ package handler
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	entities "github.com/Financial-Partner/server/internal/entities"
@@ -57,17 +58,76 @@ func (mr *MockTransactionServiceMockRecorder) CreateTransaction(ctx, UserID, tra
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockTransactionService)(nil).CreateTransaction), ctx, UserID, transaction)
 }
 
+// DeleteTransaction mocks base method.
+func (m *MockTransactionService) DeleteTransaction(ctx context.Context, userID, transactionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, userID, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockTransactionServiceMockRecorder) DeleteTransaction(ctx, userID, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTransactionService)(nil).DeleteTransaction), ctx, userID, transactionID)
+}
+
+// GetTransaction mocks base method.
+func (m *MockTransactionService) GetTransaction(ctx context.Context, userID, transactionID string) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, userID, transactionID)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockTransactionServiceMockRecorder) GetTransaction(ctx, userID, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockTransactionService)(nil).GetTransaction), ctx, userID, transactionID)
+}
+
 // GetTransactions mocks base method.
-func (m *MockTransactionService) GetTransactions(ctx context.Context, UserId string) ([]entities.Transaction, error) {
+func (m *MockTransactionService) GetTransactions(ctx context.Context, userID string, query *dto.GetTransactionsQuery) (*entities.TransactionPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactions", ctx, UserId)
-	ret0, _ := ret[0].([]entities.Transaction)
+	ret := m.ctrl.Call(m, "GetTransactions", ctx, userID, query)
+	ret0, _ := ret[0].(*entities.TransactionPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransactions indicates an expected call of GetTransactions.
-func (mr *MockTransactionServiceMockRecorder) GetTransactions(ctx, UserId any) *gomock.Call {
+func (mr *MockTransactionServiceMockRecorder) GetTransactions(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockTransactionService)(nil).GetTransactions), ctx, userID, query)
+}
+
+// ImportTransactions mocks base method.
+func (m *MockTransactionService) ImportTransactions(ctx context.Context, userID string, statement io.Reader, req *dto.ImportTransactionsRequest) (*entities.TransactionImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTransactions", ctx, userID, statement, req)
+	ret0, _ := ret[0].(*entities.TransactionImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTransactions indicates an expected call of ImportTransactions.
+func (mr *MockTransactionServiceMockRecorder) ImportTransactions(ctx, userID, statement, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTransactions", reflect.TypeOf((*MockTransactionService)(nil).ImportTransactions), ctx, userID, statement, req)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockTransactionService) UpdateTransaction(ctx context.Context, userID, transactionID string, req *dto.UpdateTransactionRequest) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, userID, transactionID, req)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockTransactionServiceMockRecorder) UpdateTransaction(ctx, userID, transactionID, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockTransactionService)(nil).GetTransactions), ctx, UserId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactionService)(nil).UpdateTransaction), ctx, userID, transactionID, req)
 }
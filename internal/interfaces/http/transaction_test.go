@@ -5,8 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,7 +18,11 @@ import (
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/mock/gomock"
 )
@@ -50,7 +58,7 @@ func TestCreateTransaction(t *testing.T) {
 		h, _ := newTestHandler(t)
 
 		req := dto.CreateTransactionRequest{
-			Amount:      1000,
+			Amount:      dto.Money{Amount: 1000, Currency: "USD"},
 			Category:    "Food",
 			Type:        "expense",
 			Date:        "2023-01-01",
@@ -83,7 +91,7 @@ func TestCreateTransaction(t *testing.T) {
 			Return(nil, errors.New("service error"))
 
 		req := dto.CreateTransactionRequest{
-			Amount:      1000,
+			Amount:      dto.Money{Amount: 1000, Currency: "USD"},
 			Category:    "Food",
 			Type:        "expense",
 			Date:        "2023-01-01",
@@ -107,6 +115,64 @@ func TestCreateTransaction(t *testing.T) {
 		assert.Equal(t, httperror.ErrFailedToCreateTransaction, errorResp.Message)
 	})
 
+	t.Run("Invalid amount", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			CreateTransaction(gomock.Any(), userID, gomock.Any()).
+			Return(nil, transaction_domain.ErrInvalidAmount)
+
+		body, _ := json.Marshal(dto.CreateTransactionRequest{
+			Amount:   dto.Money{Amount: 1000, Currency: "dollars"},
+			Category: "Food",
+			Type:     "expense",
+			Date:     "2023-01-01",
+		})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/transactions", bytes.NewBuffer(body))
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.CreateTransaction(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidTransactionAmount, errorResp.Message)
+	})
+
+	t.Run("Exchange rate not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			CreateTransaction(gomock.Any(), userID, gomock.Any()).
+			Return(nil, fmt.Errorf("failed to get EUR/USD rate: %w", exchange_domain.ErrRateNotFound))
+
+		body, _ := json.Marshal(dto.CreateTransactionRequest{
+			Amount:   dto.Money{Amount: 1000, Currency: "EUR"},
+			Category: "Food",
+			Type:     "expense",
+			Date:     "2023-01-01",
+		})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/transactions", bytes.NewBuffer(body))
+		r = r.WithContext(newContext(userID, "test@example.com"))
+
+		h.CreateTransaction(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrExchangeRateNotFound, errorResp.Message)
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 
@@ -117,7 +183,8 @@ func TestCreateTransaction(t *testing.T) {
 		objectID := primitive.NewObjectID()
 		transaction := &entities.Transaction{
 			ID:          objectID,
-			Amount:      1000,
+			Amount:      entities.Money{Amount: 1000, Currency: "EUR"},
+			BaseAmount:  entities.Money{Amount: 1080, Currency: "USD"},
 			Description: "Lunch",
 			Date:        time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
 			Category:    "Food",
@@ -132,7 +199,7 @@ func TestCreateTransaction(t *testing.T) {
 			Return(transaction, nil)
 
 		req := dto.CreateTransactionRequest{
-			Amount:      1000,
+			Amount:      dto.Money{Amount: 1000, Currency: "USD"},
 			Category:    "Food",
 			Type:        "expense",
 			Date:        "2023-01-01",
@@ -152,7 +219,9 @@ func TestCreateTransaction(t *testing.T) {
 		var response dto.TransactionResponse
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
-		assert.Equal(t, transaction.Amount, response.Amount)
+		assert.Equal(t, objectID.Hex(), response.ID)
+		assert.Equal(t, dto.Money(transaction.Amount), response.Amount)
+		assert.Equal(t, dto.Money(transaction.BaseAmount), response.BaseAmount)
 		assert.Equal(t, transaction.Description, response.Description)
 		assert.Equal(t, transaction.Date.Format(time.DateOnly), response.Date)
 		assert.Equal(t, transaction.Category, response.Category)
@@ -191,7 +260,7 @@ func TestGetTransactions(t *testing.T) {
 		userEmail := "test@example.com"
 
 		mockServices.TransactionService.EXPECT().
-			GetTransactions(gomock.Any(), userID.Hex()).
+			GetTransactions(gomock.Any(), userID.Hex(), &dto.GetTransactionsQuery{}).
 			Return(nil, errors.New("service error"))
 
 		w := httptest.NewRecorder()
@@ -222,7 +291,7 @@ func TestGetTransactions(t *testing.T) {
 		transactions := []entities.Transaction{
 			{
 				ID:          objectID,
-				Amount:      1000,
+				Amount:      entities.Money{Amount: 1000, Currency: "USD"},
 				Description: "Lunch",
 				Date:        time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
 				Category:    "Food",
@@ -234,11 +303,22 @@ func TestGetTransactions(t *testing.T) {
 		}
 
 		mockServices.TransactionService.EXPECT().
-			GetTransactions(gomock.Any(), userID.Hex()).
-			Return(transactions, nil)
+			GetTransactions(gomock.Any(), userID.Hex(), &dto.GetTransactionsQuery{
+				From:      "2023-01-01",
+				To:        "2023-01-31",
+				Category:  "Food",
+				Type:      "expense",
+				MinAmount: "100",
+				MaxAmount: "5000",
+				Search:    "lunch",
+				Sort:      "asc",
+				Limit:     "10",
+				Cursor:    "abc",
+			}).
+			Return(&entities.TransactionPage{Transactions: transactions, NextCursor: "next"}, nil)
 
 		w := httptest.NewRecorder()
-		r := httptest.NewRequest("GET", "/transactions", nil)
+		r := httptest.NewRequest("GET", "/transactions?from=2023-01-01&to=2023-01-31&category=Food&type=expense&min_amount=100&max_amount=5000&q=lunch&sort=asc&limit=10&cursor=abc", nil)
 		ctx := newContext(userID.Hex(), userEmail)
 		r = r.WithContext(ctx)
 
@@ -251,7 +331,9 @@ func TestGetTransactions(t *testing.T) {
 		err := json.NewDecoder(w.Body).Decode(&response)
 		assert.NoError(t, err)
 		assert.Equal(t, len(transactions), len(response.Transactions))
-		assert.Equal(t, transactions[0].Amount, response.Transactions[0].Amount)
+		assert.Equal(t, "next", response.NextCursor)
+		assert.Equal(t, objectID.Hex(), response.Transactions[0].ID)
+		assert.Equal(t, dto.Money(transactions[0].Amount), response.Transactions[0].Amount)
 		assert.Equal(t, transactions[0].Description, response.Transactions[0].Description)
 		assert.Equal(t, transactions[0].Date.Format(time.DateOnly), response.Transactions[0].Date)
 		assert.Equal(t, transactions[0].Category, response.Transactions[0].Category)
@@ -259,4 +341,537 @@ func TestGetTransactions(t *testing.T) {
 		assert.Equal(t, transactions[0].CreatedAt.Format(time.RFC3339), response.Transactions[0].CreatedAt)
 		assert.Equal(t, transactions[0].UpdatedAt.Format(time.RFC3339), response.Transactions[0].UpdatedAt)
 	})
+
+	t.Run("Last page", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID()
+
+		mockServices.TransactionService.EXPECT().
+			GetTransactions(gomock.Any(), userID.Hex(), &dto.GetTransactionsQuery{}).
+			Return(&entities.TransactionPage{}, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/transactions", nil)
+		r = r.WithContext(newContext(userID.Hex(), "test@example.com"))
+
+		h.GetTransactions(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"transactions":[]}`, w.Body.String())
+	})
+
+	t.Run("Invalid query", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID()
+
+		mockServices.TransactionService.EXPECT().
+			GetTransactions(gomock.Any(), userID.Hex(), &dto.GetTransactionsQuery{Limit: "1000"}).
+			Return(nil, fmt.Errorf("%w: limit must be between 1 and 100", transaction_domain.ErrInvalidQuery))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/transactions?limit=1000", nil)
+		r = r.WithContext(newContext(userID.Hex(), "test@example.com"))
+
+		h.GetTransactions(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidParameter, errorResp.Message)
+	})
+}
+
+func TestGetTransaction(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/transactions/id", nil)
+
+		h.GetTransaction(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Transaction not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			GetTransaction(gomock.Any(), userID, transactionID).
+			Return(nil, transaction_domain.ErrTransactionNotFound)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/transactions/"+transactionID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.GetTransaction(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrTransactionNotFound, errorResp.Message)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			GetTransaction(gomock.Any(), userID, transactionID).
+			Return(nil, errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/transactions/"+transactionID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.GetTransaction(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetTransaction, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID()
+		transaction := &entities.Transaction{
+			ID:          primitive.NewObjectID(),
+			UserID:      userID,
+			Amount:      entities.Money{Amount: 1000, Currency: "USD"},
+			Description: "Lunch",
+			Date:        time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Category:    "Food",
+			Type:        "expense",
+		}
+
+		mockServices.TransactionService.EXPECT().
+			GetTransaction(gomock.Any(), userID.Hex(), transaction.ID.Hex()).
+			Return(transaction, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/transactions/"+transaction.ID.Hex(), nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID.Hex(), "test@example.com")), map[string]string{"id": transaction.ID.Hex()})
+
+		h.GetTransaction(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.TransactionResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Equal(t, transaction.ID.Hex(), response.ID)
+		assert.Equal(t, dto.Money(transaction.Amount), response.Amount)
+		assert.Equal(t, "2023-01-01", response.Date)
+	})
+}
+
+func TestUpdateTransaction(t *testing.T) {
+	req := dto.UpdateTransactionRequest{
+		Amount:      dto.Money{Amount: 1200, Currency: "USD"},
+		Category:    "Food",
+		Type:        "expense",
+		Date:        "2023-01-02",
+		Description: "Dinner",
+	}
+
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/transactions/id", nil)
+
+		h.UpdateTransaction(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Invalid request format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/transactions/id", bytes.NewBufferString("invalid json"))
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": "id"})
+
+		h.UpdateTransaction(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			UpdateTransaction(gomock.Any(), userID, transactionID, gomock.Any()).
+			Return(nil, transaction_domain.ErrInvalidTransactionDate)
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/transactions/"+transactionID, bytes.NewBuffer(body))
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.UpdateTransaction(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidTransactionDate, errorResp.Message)
+	})
+
+	t.Run("Transaction not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			UpdateTransaction(gomock.Any(), userID, transactionID, gomock.Any()).
+			Return(nil, transaction_domain.ErrTransactionNotFound)
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/transactions/"+transactionID, bytes.NewBuffer(body))
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.UpdateTransaction(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			UpdateTransaction(gomock.Any(), userID, transactionID, gomock.Any()).
+			Return(nil, errors.New("service error"))
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/transactions/"+transactionID, bytes.NewBuffer(body))
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.UpdateTransaction(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToUpdateTransaction, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID()
+		transaction := &entities.Transaction{
+			ID:          primitive.NewObjectID(),
+			UserID:      userID,
+			Amount:      entities.Money{Amount: req.Amount.Amount, Currency: req.Amount.Currency},
+			Description: req.Description,
+			Date:        time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+			Category:    req.Category,
+			Type:        req.Type,
+		}
+
+		mockServices.TransactionService.EXPECT().
+			UpdateTransaction(gomock.Any(), userID.Hex(), transaction.ID.Hex(), &req).
+			Return(transaction, nil)
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/transactions/"+transaction.ID.Hex(), bytes.NewBuffer(body))
+		r = mux.SetURLVars(r.WithContext(newContext(userID.Hex(), "test@example.com")), map[string]string{"id": transaction.ID.Hex()})
+
+		h.UpdateTransaction(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.TransactionResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Equal(t, transaction.ID.Hex(), response.ID)
+		assert.Equal(t, req.Amount, response.Amount)
+		assert.Equal(t, req.Date, response.Date)
+		assert.Equal(t, req.Description, response.Description)
+	})
+}
+
+func TestDeleteTransaction(t *testing.T) {
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/transactions/id", nil)
+
+		h.DeleteTransaction(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Transaction not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			DeleteTransaction(gomock.Any(), userID, transactionID).
+			Return(transaction_domain.ErrTransactionNotFound)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/transactions/"+transactionID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.DeleteTransaction(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Service error", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			DeleteTransaction(gomock.Any(), userID, transactionID).
+			Return(errors.New("service error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/transactions/"+transactionID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.DeleteTransaction(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToDeleteTransaction, errorResp.Message)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		userID := primitive.NewObjectID().Hex()
+		transactionID := primitive.NewObjectID().Hex()
+
+		mockServices.TransactionService.EXPECT().
+			DeleteTransaction(gomock.Any(), userID, transactionID).
+			Return(nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "/transactions/"+transactionID, nil)
+		r = mux.SetURLVars(r.WithContext(newContext(userID, "test@example.com")), map[string]string{"id": transactionID})
+
+		h.DeleteTransaction(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
+
+// newStatementRequest builds a statement import upload with the file and form fields.
+func newStatementRequest(t *testing.T, userID, filename, statement string, fields map[string]string) *http.Request {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range fields {
+		require.NoError(t, form.WriteField(name, value))
+	}
+	if filename != "" {
+		file, err := form.CreateFormFile("file", filename)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(statement))
+		require.NoError(t, err)
+	}
+	require.NoError(t, form.Close())
+
+	r := httptest.NewRequest("POST", "/transactions/import", &body)
+	r.Header.Set("Content-Type", form.FormDataContentType())
+	return r.WithContext(newContext(userID, "test@example.com"))
+}
+
+func TestImportTransactions(t *testing.T) {
+	userID := primitive.NewObjectID().Hex()
+
+	t.Run("Unauthorized request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/transactions/import", nil)
+
+		h.ImportTransactions(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+		saved := &entities.Transaction{
+			ID:          primitive.NewObjectID(),
+			Amount:      entities.Money{Amount: 350, Currency: "EUR"},
+			BaseAmount:  entities.Money{Amount: 385, Currency: "USD"},
+			Category:    "Uncategorized",
+			Type:        entities.TransactionTypeExpense,
+			Date:        date,
+			Description: "Coffee",
+		}
+		duplicate := *saved
+		duplicate.ID = primitive.NilObjectID
+
+		mockServices.TransactionService.EXPECT().
+			ImportTransactions(gomock.Any(), userID, gomock.Any(), &dto.ImportTransactionsRequest{
+				Format:   "CSV",
+				Mapping:  &dto.CSVMapping{Date: "Date", Amount: "Amount", DateFormat: "DD/MM/YYYY"},
+				Currency: "EUR",
+				Commit:   true,
+			}).
+			DoAndReturn(func(_ context.Context, _ string, statement io.Reader, _ *dto.ImportTransactionsRequest) (*entities.TransactionImport, error) {
+				data, err := io.ReadAll(statement)
+				require.NoError(t, err)
+				assert.Equal(t, "Date,Amount\n", string(data))
+				return &entities.TransactionImport{
+					Committed: true,
+					Rows: []entities.ImportRow{
+						{Line: 2, Status: entities.ImportRowNew, Transaction: saved},
+						{Line: 3, Status: entities.ImportRowDuplicate, Transaction: &duplicate},
+						{Line: 4, Status: entities.ImportRowInvalid, Error: `amount "abc" is not a EUR amount`},
+					},
+				}, nil
+			})
+
+		w := httptest.NewRecorder()
+		r := newStatementRequest(t, userID, "march.CSV", "Date,Amount\n", map[string]string{
+			"mapping":  `{"date": "Date", "amount": "Amount", "date_format": "DD/MM/YYYY"}`,
+			"currency": "EUR",
+			"commit":   "true",
+		})
+
+		h.ImportTransactions(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp dto.ImportTransactionsResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		assert.True(t, resp.Committed)
+		assert.Equal(t, 1, resp.New)
+		assert.Equal(t, 1, resp.Duplicates)
+		assert.Equal(t, 1, resp.Invalid)
+		require.Len(t, resp.Rows, 3)
+		assert.Equal(t, saved.ID.Hex(), resp.Rows[0].Transaction.ID)
+		assert.Equal(t, dto.Money{Amount: 385, Currency: "USD"}, resp.Rows[0].Transaction.BaseAmount)
+		assert.Equal(t, "", resp.Rows[1].Transaction.ID)
+		assert.Equal(t, "duplicate", resp.Rows[1].Status)
+		assert.Equal(t, 4, resp.Rows[2].Line)
+		assert.Equal(t, `amount "abc" is not a EUR amount`, resp.Rows[2].Error)
+		assert.Nil(t, resp.Rows[2].Transaction)
+	})
+
+	t.Run("Format overrides the file name", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		mockServices.TransactionService.EXPECT().
+			ImportTransactions(gomock.Any(), userID, gomock.Any(), &dto.ImportTransactionsRequest{Format: "qfx"}).
+			Return(&entities.TransactionImport{}, nil)
+
+		w := httptest.NewRecorder()
+		r := newStatementRequest(t, userID, "statement.txt", "<OFX>", map[string]string{"format": "qfx"})
+
+		h.ImportTransactions(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			filename string
+			fields   map[string]string
+			message  string
+		}{
+			"missing file":    {"", nil, httperror.ErrInvalidRequest},
+			"invalid mapping": {"statement.csv", map[string]string{"mapping": "{"}, httperror.ErrInvalidRequest},
+			"invalid commit":  {"statement.csv", map[string]string{"commit": "maybe"}, httperror.ErrInvalidParameter},
+		} {
+			t.Run(name, func(t *testing.T) {
+				h, _ := newTestHandler(t)
+
+				w := httptest.NewRecorder()
+				h.ImportTransactions(w, newStatementRequest(t, userID, tc.filename, "", tc.fields))
+
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				var errorResp dto.ErrorResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errorResp))
+				assert.Equal(t, tc.message, errorResp.Message)
+			})
+		}
+	})
+
+	t.Run("File too large", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		h.ImportTransactions(w, newStatementRequest(t, userID, "statement.csv", strings.Repeat("x", 5<<20), nil))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("Service errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			err     error
+			status  int
+			message string
+		}{
+			{fmt.Errorf("%w: no column", transaction_domain.ErrInvalidStatement), http.StatusBadRequest, httperror.ErrInvalidStatement},
+			{transaction_domain.ErrStatementTooLarge, http.StatusRequestEntityTooLarge, httperror.ErrStatementTooLarge},
+			{transaction_domain.ErrInvalidAmount, http.StatusBadRequest, httperror.ErrInvalidTransactionAmount},
+			{errors.New("service error"), http.StatusInternalServerError, httperror.ErrFailedToImportTransactions},
+		} {
+			h, mockServices := newTestHandler(t)
+
+			mockServices.TransactionService.EXPECT().
+				ImportTransactions(gomock.Any(), userID, gomock.Any(), gomock.Any()).
+				Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			h.ImportTransactions(w, newStatementRequest(t, userID, "statement.ofx", "<OFX>", nil))
+
+			assert.Equal(t, tc.status, w.Code)
+			var errorResp dto.ErrorResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&errorResp))
+			assert.Equal(t, tc.message, errorResp.Message)
+		}
+	})
 }
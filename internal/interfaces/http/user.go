@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
 	respond "github.com/Financial-Partner/server/internal/interfaces/http/respond"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
 )
 
 //go:generate mockgen -source=user.go -destination=user_mock.go -package=handler
@@ -18,12 +20,16 @@ import (
 type UserService interface {
 	GetUser(ctx context.Context, email string) (*entities.User, error)
 	GetOrCreateUser(ctx context.Context, email, name string) (*entities.User, error)
-	UpdateUserName(ctx context.Context, id, name string) (*entities.User, error)
+	UpdateUser(ctx context.Context, userID string, req *dto.UpdateUserRequest) (*entities.User, error)
+	GetCharacters(ctx context.Context, userID string) ([]entities.Character, error)
+	EquipCharacter(ctx context.Context, userID, characterID string) (*entities.User, error)
+	UpdateTimezone(ctx context.Context, userID, timezone string) (*entities.User, error)
+	CreateCharacter(ctx context.Context, req *dto.CreateCharacterRequest) (*entities.Character, error)
 }
 
 // UpdateUser UpdateUser
 // @Summary UpdateUser
-// @Description Update the current user's nickname
+// @Description Update the current user's nickname and base currency. The currency can only change while savings are empty
 // @Tags users
 // @Accept json
 // @Produce json
@@ -33,11 +39,13 @@ type UserService interface {
 // @Success 200 {object} dto.UpdateUserResponse "Update user successfully"
 // @Failure 400 {object} dto.ErrorResponse "Invalid request format"
 // @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 404 {object} dto.ErrorResponse "User not found"
+// @Failure 409 {object} dto.ErrorResponse "Savings are held in the current currency"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /users/me [put]
 func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	var req dto.UpdateUserRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Name == "" && req.Currency == "" {
 		h.log.WithError(err).Warnf("Invalid request format")
 		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
 		return
@@ -50,10 +58,9 @@ func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedUser, err := h.userService.UpdateUserName(r.Context(), id, req.Name)
+	updatedUser, err := h.userService.UpdateUser(r.Context(), id, &req)
 	if err != nil {
-		h.log.WithError(err).Errorf("Failed to update user")
-		respond.WithError(w, r, h.log, err, httperror.ErrFailedToUpdateUser, http.StatusInternalServerError)
+		h.respondWithUserError(w, r, err, httperror.ErrFailedToUpdateUser)
 		return
 	}
 
@@ -61,8 +68,10 @@ func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		ID:        updatedUser.ID.Hex(),
 		Email:     updatedUser.Email,
 		Name:      updatedUser.Name,
+		Currency:  updatedUser.BaseCurrency(),
 		Diamonds:  updatedUser.Wallet.Diamonds,
-		Savings:   updatedUser.Wallet.Savings,
+		Savings:   buildMoneyResponse(updatedUser.Wallet.Savings),
+		Character: buildCharacterResponse(updatedUser),
 		UpdatedAt: updatedUser.UpdatedAt.Format(time.RFC3339),
 	}
 
@@ -108,6 +117,189 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 	respond.WithJSON(w, r, response, http.StatusOK)
 }
 
+// GetCharacters GetCharacters
+// @Summary GetCharacters
+// @Description List the characters the current user has unlocked
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Success 200 {object} dto.GetCharactersResponse "Successfully retrieved characters"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 404 {object} dto.ErrorResponse "User not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me/characters [get]
+func (h *Handler) GetCharacters(w http.ResponseWriter, r *http.Request) {
+	id, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Errorf("User ID not found in context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUserIDNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	characters, err := h.userService.GetCharacters(r.Context(), id)
+	if err != nil {
+		h.respondWithUserError(w, r, err, httperror.ErrFailedToGetCharacters)
+		return
+	}
+
+	response := dto.GetCharactersResponse{
+		Characters: make([]dto.CharacterResponse, 0, len(characters)),
+	}
+	for _, character := range characters {
+		response.Characters = append(response.Characters, dto.CharacterResponse{
+			ID:       character.ID,
+			Name:     character.Name,
+			ImageURL: character.ImageURL,
+		})
+	}
+
+	respond.WithJSON(w, r, response, http.StatusOK)
+}
+
+// EquipCharacter EquipCharacter
+// @Summary EquipCharacter
+// @Description Equip one of the current user's unlocked characters
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Param request body dto.EquipCharacterRequest true "Equip character request"
+// @Success 200 {object} dto.GetUserResponse "Character equipped successfully"
+// @Failure 400 {object} dto.ErrorResponse "Invalid request format"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Character has not been unlocked"
+// @Failure 404 {object} dto.ErrorResponse "Character not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me/character [put]
+func (h *Handler) EquipCharacter(w http.ResponseWriter, r *http.Request) {
+	var req dto.EquipCharacterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.CharacterID == "" {
+		h.log.WithError(err).Warnf("Invalid request format")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	id, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Errorf("User ID not found in context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUserIDNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	user, err := h.userService.EquipCharacter(r.Context(), id, req.CharacterID)
+	if err != nil {
+		h.respondWithUserError(w, r, err, httperror.ErrFailedToEquipCharacter)
+		return
+	}
+
+	respond.WithJSON(w, r, buildUserResponse(user, nil), http.StatusOK)
+}
+
+// UpdateTimezone UpdateTimezone
+// @Summary UpdateTimezone
+// @Description Set the timezone the current user's monthly report snapshots follow
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Param request body dto.UpdateTimezoneRequest true "Update timezone request"
+// @Success 200 {object} dto.GetUserResponse "Timezone updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Invalid timezone"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 404 {object} dto.ErrorResponse "User not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/me/timezone [put]
+func (h *Handler) UpdateTimezone(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateTimezoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Timezone == "" {
+		h.log.WithError(err).Warnf("Invalid request format")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	id, ok := contextutil.GetUserID(r.Context())
+	if !ok {
+		h.log.Errorf("User ID not found in context")
+		respond.WithError(w, r, h.log, nil, httperror.ErrUserIDNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	user, err := h.userService.UpdateTimezone(r.Context(), id, req.Timezone)
+	if err != nil {
+		h.respondWithUserError(w, r, err, httperror.ErrFailedToUpdateTimezone)
+		return
+	}
+
+	respond.WithJSON(w, r, buildUserResponse(user, nil), http.StatusOK)
+}
+
+// CreateCharacter CreateCharacter
+// @Summary CreateCharacter
+// @Description Add a character to the catalog. Admin only.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Authorization header string true "Bearer {token}" default "Bearer "
+// @Param request body dto.CreateCharacterRequest true "Create character request"
+// @Success 200 {object} dto.CharacterResponse "Character created successfully"
+// @Failure 400 {object} dto.ErrorResponse "Invalid request format"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure 403 {object} dto.ErrorResponse "Admin role required"
+// @Failure 409 {object} dto.ErrorResponse "Character already exists"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /characters [post]
+func (h *Handler) CreateCharacter(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateCharacterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.ID == "" || req.Name == "" {
+		h.log.WithError(err).Warnf("Invalid request format")
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	character, err := h.userService.CreateCharacter(r.Context(), &req)
+	if err != nil {
+		h.respondWithUserError(w, r, err, httperror.ErrFailedToCreateCharacter)
+		return
+	}
+
+	response := dto.CharacterResponse{
+		ID:       character.ID,
+		Name:     character.Name,
+		ImageURL: character.ImageURL,
+	}
+
+	respond.WithJSON(w, r, response, http.StatusOK)
+}
+
+// respondWithUserError maps user domain errors to their HTTP status and anything else
+// to an internal error with the given message.
+func (h *Handler) respondWithUserError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case errors.Is(err, user_domain.ErrCharacterNotFound):
+		respond.WithError(w, r, h.log, err, httperror.ErrCharacterNotFound, http.StatusNotFound)
+	case errors.Is(err, user_domain.ErrCharacterNotOwned):
+		respond.WithError(w, r, h.log, err, httperror.ErrCharacterNotOwned, http.StatusForbidden)
+	case errors.Is(err, user_domain.ErrCharacterExists):
+		respond.WithError(w, r, h.log, err, httperror.ErrCharacterExists, http.StatusConflict)
+	case errors.Is(err, user_domain.ErrInvalidCurrency):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidCurrency, http.StatusBadRequest)
+	case errors.Is(err, user_domain.ErrCurrencyLocked):
+		respond.WithError(w, r, h.log, err, httperror.ErrCurrencyLocked, http.StatusConflict)
+	case errors.Is(err, user_domain.ErrInvalidTimezone):
+		respond.WithError(w, r, h.log, err, httperror.ErrInvalidTimezone, http.StatusBadRequest)
+	case errors.Is(err, user_domain.ErrUserNotFound):
+		respond.WithError(w, r, h.log, err, httperror.ErrUserNotFound, http.StatusNotFound)
+	default:
+		h.log.WithError(err).Warnf("user request failed")
+		respond.WithError(w, r, h.log, err, message, http.StatusInternalServerError)
+	}
+}
+
 func buildUserResponse(user *entities.User, scopes []string) dto.GetUserResponse {
 	response := dto.GetUserResponse{
 		ID:        user.ID.Hex(),
@@ -124,19 +316,35 @@ func buildUserResponse(user *entities.User, scopes []string) dto.GetUserResponse
 		case "profile":
 			response.Email = &user.Email
 			response.Name = &user.Name
+			response.Role = &user.Role
+			timezone := user.Timezone
+			if timezone == "" {
+				timezone = entities.DefaultTimezone
+			}
+			response.Timezone = &timezone
+			currency := user.BaseCurrency()
+			response.Currency = &currency
 		case "wallet":
 			response.Wallet = &dto.WalletResponse{
 				Diamonds: user.Wallet.Diamonds,
-				Savings:  user.Wallet.Savings,
+				Savings:  buildMoneyResponse(user.Wallet.Savings),
 			}
 		case "character":
-			response.Character = &dto.CharacterResponse{
-				ID:       user.Character.ID,
-				Name:     user.Character.Name,
-				ImageURL: user.Character.ImageURL,
-			}
+			response.Character = buildCharacterResponse(user)
 		}
 	}
 
 	return response
 }
+
+// buildCharacterResponse returns the user's equipped character, or nil if they haven't equipped one.
+func buildCharacterResponse(user *entities.User) *dto.CharacterResponse {
+	if user.Character.ID == "" {
+		return nil
+	}
+	return &dto.CharacterResponse{
+		ID:       user.Character.ID,
+		Name:     user.Character.Name,
+		ImageURL: user.Character.ImageURL,
+	}
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entities "github.com/Financial-Partner/server/internal/entities"
+	dto "github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +42,51 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateCharacter mocks base method.
+func (m *MockUserService) CreateCharacter(ctx context.Context, req *dto.CreateCharacterRequest) (*entities.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCharacter", ctx, req)
+	ret0, _ := ret[0].(*entities.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCharacter indicates an expected call of CreateCharacter.
+func (mr *MockUserServiceMockRecorder) CreateCharacter(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCharacter", reflect.TypeOf((*MockUserService)(nil).CreateCharacter), ctx, req)
+}
+
+// EquipCharacter mocks base method.
+func (m *MockUserService) EquipCharacter(ctx context.Context, userID, characterID string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EquipCharacter", ctx, userID, characterID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EquipCharacter indicates an expected call of EquipCharacter.
+func (mr *MockUserServiceMockRecorder) EquipCharacter(ctx, userID, characterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EquipCharacter", reflect.TypeOf((*MockUserService)(nil).EquipCharacter), ctx, userID, characterID)
+}
+
+// GetCharacters mocks base method.
+func (m *MockUserService) GetCharacters(ctx context.Context, userID string) ([]entities.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharacters", ctx, userID)
+	ret0, _ := ret[0].([]entities.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharacters indicates an expected call of GetCharacters.
+func (mr *MockUserServiceMockRecorder) GetCharacters(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacters", reflect.TypeOf((*MockUserService)(nil).GetCharacters), ctx, userID)
+}
+
 // GetOrCreateUser mocks base method.
 func (m *MockUserService) GetOrCreateUser(ctx context.Context, email, name string) (*entities.User, error) {
 	m.ctrl.T.Helper()
@@ -71,17 +117,32 @@ func (mr *MockUserServiceMockRecorder) GetUser(ctx, email any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserService)(nil).GetUser), ctx, email)
 }
 
-// UpdateUserName mocks base method.
-func (m *MockUserService) UpdateUserName(ctx context.Context, id, name string) (*entities.User, error) {
+// UpdateTimezone mocks base method.
+func (m *MockUserService) UpdateTimezone(ctx context.Context, userID, timezone string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", ctx, userID, timezone)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockUserServiceMockRecorder) UpdateTimezone(ctx, userID, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockUserService)(nil).UpdateTimezone), ctx, userID, timezone)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUserService) UpdateUser(ctx context.Context, userID string, req *dto.UpdateUserRequest) (*entities.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserName", ctx, id, name)
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, userID, req)
 	ret0, _ := ret[0].(*entities.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUserName indicates an expected call of UpdateUserName.
-func (mr *MockUserServiceMockRecorder) UpdateUserName(ctx, id, name any) *gomock.Call {
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserServiceMockRecorder) UpdateUser(ctx, userID, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserName", reflect.TypeOf((*MockUserService)(nil).UpdateUserName), ctx, id, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserService)(nil).UpdateUser), ctx, userID, req)
 }
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/mock/gomock"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
 	httperror "github.com/Financial-Partner/server/internal/interfaces/http/error"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
 )
 
 func TestUpdateUser(t *testing.T) {
@@ -72,7 +74,7 @@ func TestUpdateUser(t *testing.T) {
 		objectID := primitive.NewObjectID()
 
 		mockServices.UserService.EXPECT().
-			UpdateUserName(gomock.Any(), objectID.Hex(), "New Name").
+			UpdateUser(gomock.Any(), objectID.Hex(), &dto.UpdateUserRequest{Name: "New Name"}).
 			Return(nil, errors.New("update failed"))
 
 		updateReq := dto.UpdateUserRequest{
@@ -98,6 +100,42 @@ func TestUpdateUser(t *testing.T) {
 		assert.Equal(t, httperror.ErrFailedToUpdateUser, errorResp.Message)
 	})
 
+	t.Run("Empty request", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me", bytes.NewBufferString(`{}`))
+
+		h.UpdateUser(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Currency locked", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+
+		objectID := primitive.NewObjectID()
+
+		mockServices.UserService.EXPECT().
+			UpdateUser(gomock.Any(), objectID.Hex(), &dto.UpdateUserRequest{Currency: "EUR"}).
+			Return(nil, user_domain.ErrCurrencyLocked)
+
+		body, _ := json.Marshal(dto.UpdateUserRequest{Currency: "EUR"})
+		ctx := context.WithValue(context.Background(), contextutil.UserIDKey, objectID.Hex())
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me", bytes.NewBuffer(body)).WithContext(ctx)
+
+		h.UpdateUser(w, r)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrCurrencyLocked, errorResp.Message)
+	})
+
 	t.Run("Update user successful", func(t *testing.T) {
 		h, mockServices := newTestHandler(t)
 
@@ -108,14 +146,14 @@ func TestUpdateUser(t *testing.T) {
 			Name:  "New Name",
 			Wallet: entities.Wallet{
 				Diamonds: 100,
-				Savings:  5000,
+				Savings:  entities.Money{Amount: 5000, Currency: "USD"},
 			},
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		}
 
 		mockServices.UserService.EXPECT().
-			UpdateUserName(gomock.Any(), objectID.Hex(), "New Name").
+			UpdateUser(gomock.Any(), objectID.Hex(), &dto.UpdateUserRequest{Name: "New Name"}).
 			Return(testUser, nil)
 
 		updateReq := dto.UpdateUserRequest{
@@ -141,7 +179,8 @@ func TestUpdateUser(t *testing.T) {
 		assert.Equal(t, testUser.Email, response.Email)
 		assert.Equal(t, testUser.Name, response.Name)
 		assert.Equal(t, testUser.Wallet.Diamonds, response.Diamonds)
-		assert.Equal(t, testUser.Wallet.Savings, response.Savings)
+		assert.Equal(t, dto.Money(testUser.Wallet.Savings), response.Savings)
+		assert.Equal(t, "USD", response.Currency)
 	})
 }
 
@@ -154,9 +193,10 @@ func TestGetUserWithScope(t *testing.T) {
 		ID:    objectID,
 		Email: "user@example.com",
 		Name:  "Test User",
+		Role:  entities.UserRoleUser,
 		Wallet: entities.Wallet{
 			Diamonds: 100,
-			Savings:  5000,
+			Savings:  entities.Money{Amount: 5000, Currency: "USD"},
 		},
 		Character: entities.Character{
 			ID:       "char_001",
@@ -238,7 +278,7 @@ func TestGetUserWithScope(t *testing.T) {
 		assert.Equal(t, testUser.Name, *response.Name)
 		assert.NotNil(t, response.Wallet)
 		assert.Equal(t, testUser.Wallet.Diamonds, response.Wallet.Diamonds)
-		assert.Equal(t, testUser.Wallet.Savings, response.Wallet.Savings)
+		assert.Equal(t, dto.Money(testUser.Wallet.Savings), response.Wallet.Savings)
 		assert.NotNil(t, response.Character)
 		assert.Equal(t, testUser.Character.ID, response.Character.ID)
 		assert.Equal(t, testUser.Character.Name, response.Character.Name)
@@ -271,6 +311,10 @@ func TestGetUserWithScope(t *testing.T) {
 		assert.Equal(t, testUser.Email, *response.Email)
 		assert.NotNil(t, response.Name)
 		assert.Equal(t, testUser.Name, *response.Name)
+		require.NotNil(t, response.Role)
+		assert.Equal(t, entities.UserRoleUser, *response.Role)
+		require.NotNil(t, response.Timezone)
+		assert.Equal(t, entities.DefaultTimezone, *response.Timezone)
 		assert.Nil(t, response.Wallet)
 		assert.Nil(t, response.Character)
 	})
@@ -299,9 +343,10 @@ func TestGetUserWithScope(t *testing.T) {
 		assert.Equal(t, testUser.ID.Hex(), response.ID)
 		assert.Nil(t, response.Email)
 		assert.Nil(t, response.Name)
+		assert.Nil(t, response.Role)
 		assert.NotNil(t, response.Wallet)
 		assert.Equal(t, testUser.Wallet.Diamonds, response.Wallet.Diamonds)
-		assert.Equal(t, testUser.Wallet.Savings, response.Wallet.Savings)
+		assert.Equal(t, dto.Money(testUser.Wallet.Savings), response.Wallet.Savings)
 		assert.Nil(t, response.Character)
 	})
 
@@ -364,7 +409,7 @@ func TestGetUserWithScope(t *testing.T) {
 		assert.Equal(t, testUser.Name, *response.Name)
 		assert.NotNil(t, response.Wallet)
 		assert.Equal(t, testUser.Wallet.Diamonds, response.Wallet.Diamonds)
-		assert.Equal(t, testUser.Wallet.Savings, response.Wallet.Savings)
+		assert.Equal(t, dto.Money(testUser.Wallet.Savings), response.Wallet.Savings)
 		assert.Nil(t, response.Character)
 	})
 
@@ -396,7 +441,7 @@ func TestGetUserWithScope(t *testing.T) {
 		assert.Equal(t, testUser.Name, *response.Name)
 		assert.NotNil(t, response.Wallet)
 		assert.Equal(t, testUser.Wallet.Diamonds, response.Wallet.Diamonds)
-		assert.Equal(t, testUser.Wallet.Savings, response.Wallet.Savings)
+		assert.Equal(t, dto.Money(testUser.Wallet.Savings), response.Wallet.Savings)
 		assert.NotNil(t, response.Character)
 		assert.Equal(t, testUser.Character.ID, response.Character.ID)
 		assert.Equal(t, testUser.Character.Name, response.Character.Name)
@@ -433,3 +478,366 @@ func TestGetUserWithScope(t *testing.T) {
 		assert.NotEmpty(t, response.UpdatedAt)
 	})
 }
+
+func TestGetCharacters(t *testing.T) {
+	t.Run("UserID not in context", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/users/me/characters", nil)
+
+		h.GetCharacters(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUserIDNotFound, errorResp.Message)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.UserService.EXPECT().
+			GetCharacters(gomock.Any(), userID).
+			Return(nil, user_domain.ErrUserNotFound)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/users/me/characters", nil).WithContext(newContext(userID, "user@example.com"))
+
+		h.GetCharacters(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUserNotFound, errorResp.Message)
+	})
+
+	t.Run("Get characters failed", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		userID := primitive.NewObjectID().Hex()
+
+		mockServices.UserService.EXPECT().
+			GetCharacters(gomock.Any(), userID).
+			Return(nil, errors.New("db error"))
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/users/me/characters", nil).WithContext(newContext(userID, "user@example.com"))
+
+		h.GetCharacters(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrFailedToGetCharacters, errorResp.Message)
+	})
+
+	t.Run("Get characters successful", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		userID := primitive.NewObjectID().Hex()
+
+		characters := []entities.Character{
+			{ID: "char_001", Name: "Financial Assistant", ImageURL: "https://example.com/char_001.png"},
+			{ID: "char_002", Name: "Savings Coach", ImageURL: "https://example.com/char_002.png"},
+		}
+		mockServices.UserService.EXPECT().
+			GetCharacters(gomock.Any(), userID).
+			Return(characters, nil)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/users/me/characters", nil).WithContext(newContext(userID, "user@example.com"))
+
+		h.GetCharacters(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetCharactersResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Characters, 2)
+		assert.Equal(t, "char_001", response.Characters[0].ID)
+		assert.Equal(t, "Savings Coach", response.Characters[1].Name)
+	})
+}
+
+func TestEquipCharacter(t *testing.T) {
+	t.Run("Invalid request format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me/character", bytes.NewBufferString(`{}`))
+
+		h.EquipCharacter(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
+	t.Run("UserID not in context", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		body, _ := json.Marshal(dto.EquipCharacterRequest{CharacterID: "char_001"})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me/character", bytes.NewBuffer(body))
+
+		h.EquipCharacter(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrUserIDNotFound, errorResp.Message)
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantMsg    string
+	}{
+		{"Character not found", user_domain.ErrCharacterNotFound, http.StatusNotFound, httperror.ErrCharacterNotFound},
+		{"Character not owned", user_domain.ErrCharacterNotOwned, http.StatusForbidden, httperror.ErrCharacterNotOwned},
+		{"Equip character failed", errors.New("db error"), http.StatusInternalServerError, httperror.ErrFailedToEquipCharacter},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, mockServices := newTestHandler(t)
+			userID := primitive.NewObjectID().Hex()
+
+			mockServices.UserService.EXPECT().
+				EquipCharacter(gomock.Any(), userID, "char_001").
+				Return(nil, tc.err)
+
+			body, _ := json.Marshal(dto.EquipCharacterRequest{CharacterID: "char_001"})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "/users/me/character", bytes.NewBuffer(body)).WithContext(newContext(userID, "user@example.com"))
+
+			h.EquipCharacter(w, r)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMsg, errorResp.Message)
+		})
+	}
+
+	t.Run("Equip character successful", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		objectID := primitive.NewObjectID()
+
+		user := &entities.User{
+			ID:              objectID,
+			Email:           "user@example.com",
+			Name:            "Test User",
+			OwnedCharacters: []string{"char_001"},
+			Character: entities.Character{
+				ID:       "char_001",
+				Name:     "Financial Assistant",
+				ImageURL: "https://example.com/char_001.png",
+			},
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		mockServices.UserService.EXPECT().
+			EquipCharacter(gomock.Any(), objectID.Hex(), "char_001").
+			Return(user, nil)
+
+		body, _ := json.Marshal(dto.EquipCharacterRequest{CharacterID: "char_001"})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me/character", bytes.NewBuffer(body)).WithContext(newContext(objectID.Hex(), user.Email))
+
+		h.EquipCharacter(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetUserResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		assert.Equal(t, objectID.Hex(), response.ID)
+		assert.NotNil(t, response.Character)
+		assert.Equal(t, "char_001", response.Character.ID)
+		assert.Equal(t, "Financial Assistant", response.Character.Name)
+	})
+}
+
+func TestUpdateTimezone(t *testing.T) {
+	t.Run("Invalid request format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me/timezone", bytes.NewBufferString(`{}`))
+
+		h.UpdateTimezone(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
+	t.Run("UserID not in context", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		body, _ := json.Marshal(dto.UpdateTimezoneRequest{Timezone: "Asia/Taipei"})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me/timezone", bytes.NewBuffer(body))
+
+		h.UpdateTimezone(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantMsg    string
+	}{
+		{"Invalid timezone", user_domain.ErrInvalidTimezone, http.StatusBadRequest, httperror.ErrInvalidTimezone},
+		{"User not found", user_domain.ErrUserNotFound, http.StatusNotFound, httperror.ErrUserNotFound},
+		{"Update timezone failed", errors.New("db error"), http.StatusInternalServerError, httperror.ErrFailedToUpdateTimezone},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, mockServices := newTestHandler(t)
+			userID := primitive.NewObjectID().Hex()
+
+			mockServices.UserService.EXPECT().
+				UpdateTimezone(gomock.Any(), userID, "Asia/Taipei").
+				Return(nil, tc.err)
+
+			body, _ := json.Marshal(dto.UpdateTimezoneRequest{Timezone: "Asia/Taipei"})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "/users/me/timezone", bytes.NewBuffer(body)).WithContext(newContext(userID, "user@example.com"))
+
+			h.UpdateTimezone(w, r)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMsg, errorResp.Message)
+		})
+	}
+
+	t.Run("Update timezone successful", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		objectID := primitive.NewObjectID()
+
+		user := &entities.User{
+			ID:        objectID,
+			Email:     "user@example.com",
+			Timezone:  "Asia/Taipei",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		mockServices.UserService.EXPECT().
+			UpdateTimezone(gomock.Any(), objectID.Hex(), "Asia/Taipei").
+			Return(user, nil)
+
+		body, _ := json.Marshal(dto.UpdateTimezoneRequest{Timezone: "Asia/Taipei"})
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("PUT", "/users/me/timezone", bytes.NewBuffer(body)).WithContext(newContext(objectID.Hex(), user.Email))
+
+		h.UpdateTimezone(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.GetUserResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		assert.NoError(t, err)
+		require.NotNil(t, response.Timezone)
+		assert.Equal(t, "Asia/Taipei", *response.Timezone)
+	})
+}
+
+func TestCreateCharacter(t *testing.T) {
+	t.Run("Invalid request format", func(t *testing.T) {
+		h, _ := newTestHandler(t)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/characters", bytes.NewBufferString(`{"id":"char_010"}`))
+
+		h.CreateCharacter(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var errorResp dto.ErrorResponse
+		err := json.NewDecoder(w.Body).Decode(&errorResp)
+		assert.NoError(t, err)
+		assert.Equal(t, httperror.ErrInvalidRequest, errorResp.Message)
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantMsg    string
+	}{
+		{"Character exists", user_domain.ErrCharacterExists, http.StatusConflict, httperror.ErrCharacterExists},
+		{"Create character failed", errors.New("db error"), http.StatusInternalServerError, httperror.ErrFailedToCreateCharacter},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, mockServices := newTestHandler(t)
+			req := dto.CreateCharacterRequest{ID: "char_010", Name: "Advisor"}
+
+			mockServices.UserService.EXPECT().
+				CreateCharacter(gomock.Any(), &req).
+				Return(nil, tc.err)
+
+			body, _ := json.Marshal(req)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/characters", bytes.NewBuffer(body))
+
+			h.CreateCharacter(w, r)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+
+			var errorResp dto.ErrorResponse
+			err := json.NewDecoder(w.Body).Decode(&errorResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMsg, errorResp.Message)
+		})
+	}
+
+	t.Run("Create character successful", func(t *testing.T) {
+		h, mockServices := newTestHandler(t)
+		req := dto.CreateCharacterRequest{ID: "char_010", Name: "Advisor", ImageURL: "https://example.com/advisor.png"}
+
+		mockServices.UserService.EXPECT().
+			CreateCharacter(gomock.Any(), &req).
+			Return(&entities.Character{ID: req.ID, Name: req.Name, ImageURL: req.ImageURL}, nil)
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/characters", bytes.NewBuffer(body))
+
+		h.CreateCharacter(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.CharacterResponse
+		err := json.NewDecoder(w.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.Equal(t, "char_010", response.ID)
+		assert.Equal(t, "Advisor", response.Name)
+		assert.Equal(t, req.ImageURL, response.ImageURL)
+	})
+}
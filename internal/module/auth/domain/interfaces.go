@@ -20,7 +20,7 @@ type FirebaseAuth interface {
 }
 
 type JWTManager interface {
-	GenerateAccessToken(id, email string) (string, time.Time, error)
+	GenerateAccessToken(id, email, role string) (string, time.Time, error)
 	GenerateRefreshToken(id, email string) (string, time.Time, error)
 	ValidateToken(tokenString string) (*auth.Claims, error)
 }
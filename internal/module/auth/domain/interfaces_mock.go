@@ -149,9 +149,9 @@ func (m *MockJWTManager) EXPECT() *MockJWTManagerMockRecorder {
 }
 
 // GenerateAccessToken mocks base method.
-func (m *MockJWTManager) GenerateAccessToken(id, email string) (string, time.Time, error) {
+func (m *MockJWTManager) GenerateAccessToken(id, email, role string) (string, time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessToken", id, email)
+	ret := m.ctrl.Call(m, "GenerateAccessToken", id, email, role)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(time.Time)
 	ret2, _ := ret[2].(error)
@@ -159,9 +159,9 @@ func (m *MockJWTManager) GenerateAccessToken(id, email string) (string, time.Tim
 }
 
 // GenerateAccessToken indicates an expected call of GenerateAccessToken.
-func (mr *MockJWTManagerMockRecorder) GenerateAccessToken(id, email any) *gomock.Call {
+func (mr *MockJWTManagerMockRecorder) GenerateAccessToken(id, email, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockJWTManager)(nil).GenerateAccessToken), id, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockJWTManager)(nil).GenerateAccessToken), id, email, role)
 }
 
 // GenerateRefreshToken mocks base method.
@@ -58,7 +58,7 @@ func (s *Service) LoginWithFirebase(ctx context.Context, firebaseToken string) (
 		return "", "", 0, nil, fmt.Errorf("failed to get or create user: %w", err)
 	}
 
-	accessToken, expiryTime, err := s.jwtManager.GenerateAccessToken(user.ID.Hex(), email)
+	accessToken, expiryTime, err := s.jwtManager.GenerateAccessToken(user.ID.Hex(), email, user.Role)
 	if err != nil {
 		return "", "", 0, nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -97,7 +97,13 @@ func (s *Service) RefreshToken(ctx context.Context, refreshToken string) (newAcc
 		return "", "", 0, fmt.Errorf("token id mismatch")
 	}
 
-	accessToken, expiryTime, err := s.jwtManager.GenerateAccessToken(claims.ID, claims.Email)
+	// The role is read again so that granting or revoking it applies from the next refresh.
+	user, err := s.userService.GetUser(ctx, claims.Email)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	accessToken, expiryTime, err := s.jwtManager.GenerateAccessToken(claims.ID, claims.Email, user.Role)
 	if err != nil {
 		return "", "", 0, fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -61,8 +61,12 @@ func TestRefreshToken(t *testing.T) {
 			GetRefreshToken(gomock.Any(), "valid_refresh_token").
 			Return(id, nil)
 
+		mocks.mockUserService.EXPECT().
+			GetUser(gomock.Any(), email).
+			Return(&entities.User{Email: email, Role: entities.UserRoleAdmin}, nil)
+
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id, email).
+			GenerateAccessToken(id, email, entities.UserRoleAdmin).
 			Return("new_access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -161,6 +165,39 @@ func TestRefreshToken(t *testing.T) {
 		assert.Contains(t, err.Error(), "token id mismatch")
 	})
 
+	t.Run("Failed to get user", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := auth_usecase.NewService(cfg, mocks.mockFirebaseAuth, mocks.mockJWTManager, mocks.mockTokenStore, mocks.mockUserService)
+
+		id := primitive.NewObjectID().Hex()
+		email := "test@example.com"
+		claims := &infraAuth.Claims{
+			ID:    id,
+			Email: email,
+		}
+
+		mocks.mockJWTManager.EXPECT().
+			ValidateToken("valid_token").
+			Return(claims, nil)
+
+		mocks.mockTokenStore.EXPECT().
+			GetRefreshToken(gomock.Any(), "valid_token").
+			Return(id, nil)
+
+		mocks.mockUserService.EXPECT().
+			GetUser(gomock.Any(), email).
+			Return(nil, errors.New("user not found"))
+
+		accessToken, refreshToken, expiresIn, err := service.RefreshToken(context.Background(), "valid_token")
+
+		assert.Error(t, err)
+		assert.Equal(t, "", accessToken)
+		assert.Equal(t, "", refreshToken)
+		assert.Equal(t, 0, expiresIn)
+		assert.Contains(t, err.Error(), "failed to get user")
+	})
+
 	t.Run("Failed to generate access token", func(t *testing.T) {
 		mocks := NewMocks(t)
 		defer mocks.ctrl.Finish()
@@ -181,8 +218,12 @@ func TestRefreshToken(t *testing.T) {
 			GetRefreshToken(gomock.Any(), "valid_token").
 			Return(id, nil)
 
+		mocks.mockUserService.EXPECT().
+			GetUser(gomock.Any(), email).
+			Return(&entities.User{Email: email, Role: entities.UserRoleAdmin}, nil)
+
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id, email).
+			GenerateAccessToken(id, email, entities.UserRoleAdmin).
 			Return("", time.Time{}, errors.New("failed to generate token"))
 
 		accessToken, refreshToken, expiresIn, err := service.RefreshToken(context.Background(), "valid_token")
@@ -214,8 +255,12 @@ func TestRefreshToken(t *testing.T) {
 			GetRefreshToken(gomock.Any(), "valid_token").
 			Return(id, nil)
 
+		mocks.mockUserService.EXPECT().
+			GetUser(gomock.Any(), email).
+			Return(&entities.User{Email: email, Role: entities.UserRoleAdmin}, nil)
+
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id, email).
+			GenerateAccessToken(id, email, entities.UserRoleAdmin).
 			Return("new_access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -251,8 +296,12 @@ func TestRefreshToken(t *testing.T) {
 			GetRefreshToken(gomock.Any(), "valid_token").
 			Return(id, nil)
 
+		mocks.mockUserService.EXPECT().
+			GetUser(gomock.Any(), email).
+			Return(&entities.User{Email: email, Role: entities.UserRoleAdmin}, nil)
+
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id, email).
+			GenerateAccessToken(id, email, entities.UserRoleAdmin).
 			Return("new_access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -292,8 +341,12 @@ func TestRefreshToken(t *testing.T) {
 			GetRefreshToken(gomock.Any(), "valid_token").
 			Return(id, nil)
 
+		mocks.mockUserService.EXPECT().
+			GetUser(gomock.Any(), email).
+			Return(&entities.User{Email: email, Role: entities.UserRoleAdmin}, nil)
+
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id, email).
+			GenerateAccessToken(id, email, entities.UserRoleAdmin).
 			Return("new_access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -340,6 +393,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			ID:    id,
 			Email: email,
 			Name:  name,
+			Role:  entities.UserRoleUser,
 		}
 
 		mocks.mockFirebaseAuth.EXPECT().
@@ -351,7 +405,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			Return(mockUser, nil)
 
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id.Hex(), email).
+			GenerateAccessToken(id.Hex(), email, mockUser.Role).
 			Return("access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -466,6 +520,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			ID:    id,
 			Email: email,
 			Name:  name,
+			Role:  entities.UserRoleUser,
 		}
 
 		mocks.mockFirebaseAuth.EXPECT().
@@ -477,7 +532,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			Return(mockUser, nil)
 
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id.Hex(), email).
+			GenerateAccessToken(id.Hex(), email, mockUser.Role).
 			Return("", time.Time{}, errors.New("failed to generate token"))
 
 		accessToken, refreshToken, expiresIn, user, err := service.LoginWithFirebase(context.Background(), "valid_token")
@@ -509,6 +564,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			ID:    id,
 			Email: email,
 			Name:  name,
+			Role:  entities.UserRoleUser,
 		}
 
 		mocks.mockFirebaseAuth.EXPECT().
@@ -520,7 +576,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			Return(mockUser, nil)
 
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id.Hex(), email).
+			GenerateAccessToken(id.Hex(), email, mockUser.Role).
 			Return("access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -556,6 +612,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			ID:    id,
 			Email: email,
 			Name:  name,
+			Role:  entities.UserRoleUser,
 		}
 
 		mocks.mockFirebaseAuth.EXPECT().
@@ -567,7 +624,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			Return(mockUser, nil)
 
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id.Hex(), email).
+			GenerateAccessToken(id.Hex(), email, mockUser.Role).
 			Return("access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -616,7 +673,7 @@ func TestLoginWithFirebase(t *testing.T) {
 			Return(mockUser, nil)
 
 		mocks.mockJWTManager.EXPECT().
-			GenerateAccessToken(id.Hex(), email).
+			GenerateAccessToken(id.Hex(), email, mockUser.Role).
 			Return("access_token", time.Now().Add(time.Hour), nil)
 
 		mocks.mockJWTManager.EXPECT().
@@ -0,0 +1,5 @@
+package exchange_domain
+
+import "errors"
+
+var ErrRateNotFound = errors.New("exchange rate not found")
@@ -0,0 +1,13 @@
+package exchange_domain
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=interfaces_mock.go -package=exchange_domain
+
+// RateProvider prices one major unit of a currency in another on a day.
+type RateProvider interface {
+	Rate(ctx context.Context, from, to string, on time.Time) (float64, error)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=interfaces_mock.go -package=exchange_domain
+//
+
+// Package exchange_domain is a generated GoMock package.
+package exchange_domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRateProvider is a mock of RateProvider interface.
+type MockRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockRateProviderMockRecorder is the mock recorder for MockRateProvider.
+type MockRateProviderMockRecorder struct {
+	mock *MockRateProvider
+}
+
+// NewMockRateProvider creates a new mock instance.
+func NewMockRateProvider(ctrl *gomock.Controller) *MockRateProvider {
+	mock := &MockRateProvider{ctrl: ctrl}
+	mock.recorder = &MockRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateProvider) EXPECT() *MockRateProviderMockRecorder {
+	return m.recorder
+}
+
+// Rate mocks base method.
+func (m *MockRateProvider) Rate(ctx context.Context, from, to string, on time.Time) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rate", ctx, from, to, on)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rate indicates an expected call of Rate.
+func (mr *MockRateProviderMockRecorder) Rate(ctx, from, to, on any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rate", reflect.TypeOf((*MockRateProvider)(nil).Rate), ctx, from, to, on)
+}
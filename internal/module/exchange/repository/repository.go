@@ -0,0 +1,16 @@
+package exchange_repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+//go:generate mockgen -source=repository.go -destination=repository_mock.go -package=exchange_repository
+
+type Repository interface {
+	// FindRate returns the latest rate of the currency pair dated on or before on, or
+	// exchange_domain.ErrRateNotFound if there is none.
+	FindRate(ctx context.Context, base, quote string, on time.Time) (*entities.ExchangeRate, error)
+}
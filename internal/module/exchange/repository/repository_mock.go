@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=repository_mock.go -package=exchange_repository
+//
+
+// Package exchange_repository is a generated GoMock package.
+package exchange_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindRate mocks base method.
+func (m *MockRepository) FindRate(ctx context.Context, base, quote string, on time.Time) (*entities.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRate", ctx, base, quote, on)
+	ret0, _ := ret[0].(*entities.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRate indicates an expected call of FindRate.
+func (mr *MockRepositoryMockRecorder) FindRate(ctx, base, quote, on any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRate", reflect.TypeOf((*MockRepository)(nil).FindRate), ctx, base, quote, on)
+}
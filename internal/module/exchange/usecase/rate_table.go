@@ -0,0 +1,42 @@
+package exchange_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	exchange_repository "github.com/Financial-Partner/server/internal/module/exchange/repository"
+)
+
+// RateTable provides rates from a table of recorded rates, so conversions work without
+// reaching an external service. A pair missing from the table is priced from its
+// inverse pair.
+type RateTable struct {
+	repo exchange_repository.Repository
+}
+
+func NewRateTable(repo exchange_repository.Repository) *RateTable {
+	return &RateTable{repo: repo}
+}
+
+func (t *RateTable) Rate(ctx context.Context, from, to string, on time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+
+	rate, err := t.repo.FindRate(ctx, from, to, on)
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !errors.Is(err, exchange_domain.ErrRateNotFound) {
+		return 0, fmt.Errorf("failed to get %s/%s rate: %w", from, to, err)
+	}
+
+	inverse, err := t.repo.FindRate(ctx, to, from, on)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s/%s rate: %w", from, to, err)
+	}
+	return 1 / inverse.Rate, nil
+}
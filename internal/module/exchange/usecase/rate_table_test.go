@@ -0,0 +1,78 @@
+package exchange_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	exchange_repository "github.com/Financial-Partner/server/internal/module/exchange/repository"
+	exchange_usecase "github.com/Financial-Partner/server/internal/module/exchange/usecase"
+)
+
+func TestRateTable(t *testing.T) {
+	on := time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Same currency", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		table := exchange_usecase.NewRateTable(exchange_repository.NewMockRepository(ctrl))
+
+		rate, err := table.Rate(context.Background(), "USD", "USD", on)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, rate)
+	})
+
+	t.Run("Recorded pair", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := exchange_repository.NewMockRepository(ctrl)
+		table := exchange_usecase.NewRateTable(mockRepo)
+
+		mockRepo.EXPECT().FindRate(gomock.Any(), "EUR", "USD", on).Return(&entities.ExchangeRate{Base: "EUR", Quote: "USD", Rate: 1.08}, nil)
+
+		rate, err := table.Rate(context.Background(), "EUR", "USD", on)
+		require.NoError(t, err)
+		assert.Equal(t, 1.08, rate)
+	})
+
+	t.Run("Inverse pair", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := exchange_repository.NewMockRepository(ctrl)
+		table := exchange_usecase.NewRateTable(mockRepo)
+
+		mockRepo.EXPECT().FindRate(gomock.Any(), "USD", "EUR", on).Return(nil, exchange_domain.ErrRateNotFound)
+		mockRepo.EXPECT().FindRate(gomock.Any(), "EUR", "USD", on).Return(&entities.ExchangeRate{Base: "EUR", Quote: "USD", Rate: 1.25}, nil)
+
+		rate, err := table.Rate(context.Background(), "USD", "EUR", on)
+		require.NoError(t, err)
+		assert.Equal(t, 0.8, rate)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := exchange_repository.NewMockRepository(ctrl)
+		table := exchange_usecase.NewRateTable(mockRepo)
+
+		mockRepo.EXPECT().FindRate(gomock.Any(), gomock.Any(), gomock.Any(), on).Return(nil, exchange_domain.ErrRateNotFound).Times(2)
+
+		_, err := table.Rate(context.Background(), "USD", "EUR", on)
+		assert.ErrorIs(t, err, exchange_domain.ErrRateNotFound)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := exchange_repository.NewMockRepository(ctrl)
+		table := exchange_usecase.NewRateTable(mockRepo)
+
+		mockRepo.EXPECT().FindRate(gomock.Any(), "USD", "EUR", on).Return(nil, errors.New("db error"))
+
+		_, err := table.Rate(context.Background(), "USD", "EUR", on)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, exchange_domain.ErrRateNotFound)
+	})
+}
@@ -0,0 +1,28 @@
+package gacha_domain
+
+// Config holds the draw rules that are set per deployment rather than per pool.
+type Config struct {
+	Pity PityRules
+	// BatchDiscountPercent is taken off the total cost of a batch draw.
+	BatchDiscountPercent int
+}
+
+// PityRule guarantees an item of at least Rarity once a user has gone Threshold
+// draws from a pool without one. A zero Threshold disables pity.
+type PityRule struct {
+	Rarity    string
+	Threshold int
+}
+
+// PityRules holds the default pity rule and the overrides of individual pools.
+type PityRules struct {
+	Default PityRule
+	Pools   map[string]PityRule
+}
+
+func (r PityRules) ForPool(poolID string) PityRule {
+	if rule, ok := r.Pools[poolID]; ok {
+		return rule
+	}
+	return r.Default
+}
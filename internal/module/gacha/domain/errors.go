@@ -0,0 +1,12 @@
+package gacha_domain
+
+import "errors"
+
+var (
+	ErrPoolNotFound         = errors.New("gacha pool not found")
+	ErrEmptyPool            = errors.New("gacha pool has no items")
+	ErrInvalidDrawAmount    = errors.New("draw amount must equal the pool's cost")
+	ErrInvalidDrawCount     = errors.New("invalid number of draws")
+	ErrInsufficientDiamonds = errors.New("not enough diamonds to draw")
+	ErrInvalidPool          = errors.New("gacha pool needs a positive cost and drawable items of known rarities")
+)
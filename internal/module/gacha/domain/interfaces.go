@@ -0,0 +1,28 @@
+package gacha_domain
+
+import (
+	"context"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=interfaces_mock.go -package=gacha_domain
+
+type GachaService interface {
+	DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.GachaDraw, error)
+	DrawGachaBatch(ctx context.Context, userID string, req *dto.BatchDrawGachaRequest) ([]entities.GachaDraw, error)
+	PreviewGachas(ctx context.Context, userID, poolID string) (*entities.GachaPreview, error)
+	GetInventory(ctx context.Context, userID string) (*entities.GachaInventory, error)
+	CreatePool(ctx context.Context, req *dto.CreateGachaPoolRequest) (*entities.GachaPool, error)
+}
+
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// RandomSource picks the random numbers behind each draw. IntN returns a
+// number in [0, n).
+type RandomSource interface {
+	IntN(n int) int
+}
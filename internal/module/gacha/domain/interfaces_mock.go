@@ -0,0 +1,194 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=interfaces_mock.go -package=gacha_domain
+//
+
+// Package gacha_domain is a generated GoMock package.
+package gacha_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	dto "github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGachaService is a mock of GachaService interface.
+type MockGachaService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGachaServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGachaServiceMockRecorder is the mock recorder for MockGachaService.
+type MockGachaServiceMockRecorder struct {
+	mock *MockGachaService
+}
+
+// NewMockGachaService creates a new mock instance.
+func NewMockGachaService(ctrl *gomock.Controller) *MockGachaService {
+	mock := &MockGachaService{ctrl: ctrl}
+	mock.recorder = &MockGachaServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGachaService) EXPECT() *MockGachaServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePool mocks base method.
+func (m *MockGachaService) CreatePool(ctx context.Context, req *dto.CreateGachaPoolRequest) (*entities.GachaPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePool", ctx, req)
+	ret0, _ := ret[0].(*entities.GachaPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePool indicates an expected call of CreatePool.
+func (mr *MockGachaServiceMockRecorder) CreatePool(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePool", reflect.TypeOf((*MockGachaService)(nil).CreatePool), ctx, req)
+}
+
+// DrawGacha mocks base method.
+func (m *MockGachaService) DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.GachaDraw, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrawGacha", ctx, userID, req)
+	ret0, _ := ret[0].(*entities.GachaDraw)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrawGacha indicates an expected call of DrawGacha.
+func (mr *MockGachaServiceMockRecorder) DrawGacha(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrawGacha", reflect.TypeOf((*MockGachaService)(nil).DrawGacha), ctx, userID, req)
+}
+
+// DrawGachaBatch mocks base method.
+func (m *MockGachaService) DrawGachaBatch(ctx context.Context, userID string, req *dto.BatchDrawGachaRequest) ([]entities.GachaDraw, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrawGachaBatch", ctx, userID, req)
+	ret0, _ := ret[0].([]entities.GachaDraw)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrawGachaBatch indicates an expected call of DrawGachaBatch.
+func (mr *MockGachaServiceMockRecorder) DrawGachaBatch(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrawGachaBatch", reflect.TypeOf((*MockGachaService)(nil).DrawGachaBatch), ctx, userID, req)
+}
+
+// GetInventory mocks base method.
+func (m *MockGachaService) GetInventory(ctx context.Context, userID string) (*entities.GachaInventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventory", ctx, userID)
+	ret0, _ := ret[0].(*entities.GachaInventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventory indicates an expected call of GetInventory.
+func (mr *MockGachaServiceMockRecorder) GetInventory(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventory", reflect.TypeOf((*MockGachaService)(nil).GetInventory), ctx, userID)
+}
+
+// PreviewGachas mocks base method.
+func (m *MockGachaService) PreviewGachas(ctx context.Context, userID, poolID string) (*entities.GachaPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewGachas", ctx, userID, poolID)
+	ret0, _ := ret[0].(*entities.GachaPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewGachas indicates an expected call of PreviewGachas.
+func (mr *MockGachaServiceMockRecorder) PreviewGachas(ctx, userID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewGachas", reflect.TypeOf((*MockGachaService)(nil).PreviewGachas), ctx, userID, poolID)
+}
+
+// MockTransactor is a mock of Transactor interface.
+type MockTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactorMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactorMockRecorder is the mock recorder for MockTransactor.
+type MockTransactorMockRecorder struct {
+	mock *MockTransactor
+}
+
+// NewMockTransactor creates a new mock instance.
+func NewMockTransactor(ctrl *gomock.Controller) *MockTransactor {
+	mock := &MockTransactor{ctrl: ctrl}
+	mock.recorder = &MockTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactor) EXPECT() *MockTransactorMockRecorder {
+	return m.recorder
+}
+
+// WithTransaction mocks base method.
+func (m *MockTransactor) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockTransactorMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactor)(nil).WithTransaction), ctx, fn)
+}
+
+// MockRandomSource is a mock of RandomSource interface.
+type MockRandomSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockRandomSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockRandomSourceMockRecorder is the mock recorder for MockRandomSource.
+type MockRandomSourceMockRecorder struct {
+	mock *MockRandomSource
+}
+
+// NewMockRandomSource creates a new mock instance.
+func NewMockRandomSource(ctrl *gomock.Controller) *MockRandomSource {
+	mock := &MockRandomSource{ctrl: ctrl}
+	mock.recorder = &MockRandomSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRandomSource) EXPECT() *MockRandomSourceMockRecorder {
+	return m.recorder
+}
+
+// IntN mocks base method.
+func (m *MockRandomSource) IntN(n int) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IntN", n)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// IntN indicates an expected call of IntN.
+func (mr *MockRandomSourceMockRecorder) IntN(n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IntN", reflect.TypeOf((*MockRandomSource)(nil).IntN), n)
+}
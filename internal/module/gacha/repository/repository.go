@@ -0,0 +1,29 @@
+package gacha_repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+//go:generate mockgen -source=repository.go -destination=repository_mock.go -package=gacha_repository
+
+type Repository interface {
+	FindPoolById(ctx context.Context, poolID primitive.ObjectID) (*entities.GachaPool, error)
+	FindLatestPool(ctx context.Context) (*entities.GachaPool, error)
+	CreatePool(ctx context.Context, pool *entities.GachaPool) (*entities.GachaPool, error)
+	CreateInventoryItem(ctx context.Context, item *entities.GachaInventoryItem) (*entities.GachaInventoryItem, error)
+	FindInventoryByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.GachaInventoryItem, error)
+	FindOwnedGachaIds(ctx context.Context, userID primitive.ObjectID, gachaIDs []primitive.ObjectID) ([]primitive.ObjectID, error)
+	FindShards(ctx context.Context, userID primitive.ObjectID) (*entities.GachaShards, error)
+	AddShards(ctx context.Context, userID primitive.ObjectID, amount int64) error
+	FindPity(ctx context.Context, userID, poolID primitive.ObjectID) (*entities.GachaPity, error)
+	SavePity(ctx context.Context, pity *entities.GachaPity) error
+}
+
+type GachaStore interface {
+	GetPity(ctx context.Context, userID, poolID string) (*entities.GachaPity, error)
+	SetPity(ctx context.Context, pity *entities.GachaPity) error
+}
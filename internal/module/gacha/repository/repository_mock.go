@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=repository_mock.go -package=gacha_repository
+//
+
+// Package gacha_repository is a generated GoMock package.
+package gacha_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddShards mocks base method.
+func (m *MockRepository) AddShards(ctx context.Context, userID primitive.ObjectID, amount int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddShards", ctx, userID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddShards indicates an expected call of AddShards.
+func (mr *MockRepositoryMockRecorder) AddShards(ctx, userID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddShards", reflect.TypeOf((*MockRepository)(nil).AddShards), ctx, userID, amount)
+}
+
+// CreateInventoryItem mocks base method.
+func (m *MockRepository) CreateInventoryItem(ctx context.Context, item *entities.GachaInventoryItem) (*entities.GachaInventoryItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInventoryItem", ctx, item)
+	ret0, _ := ret[0].(*entities.GachaInventoryItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInventoryItem indicates an expected call of CreateInventoryItem.
+func (mr *MockRepositoryMockRecorder) CreateInventoryItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInventoryItem", reflect.TypeOf((*MockRepository)(nil).CreateInventoryItem), ctx, item)
+}
+
+// CreatePool mocks base method.
+func (m *MockRepository) CreatePool(ctx context.Context, pool *entities.GachaPool) (*entities.GachaPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePool", ctx, pool)
+	ret0, _ := ret[0].(*entities.GachaPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePool indicates an expected call of CreatePool.
+func (mr *MockRepositoryMockRecorder) CreatePool(ctx, pool any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePool", reflect.TypeOf((*MockRepository)(nil).CreatePool), ctx, pool)
+}
+
+// FindInventoryByUserId mocks base method.
+func (m *MockRepository) FindInventoryByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.GachaInventoryItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInventoryByUserId", ctx, userID)
+	ret0, _ := ret[0].([]entities.GachaInventoryItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInventoryByUserId indicates an expected call of FindInventoryByUserId.
+func (mr *MockRepositoryMockRecorder) FindInventoryByUserId(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInventoryByUserId", reflect.TypeOf((*MockRepository)(nil).FindInventoryByUserId), ctx, userID)
+}
+
+// FindLatestPool mocks base method.
+func (m *MockRepository) FindLatestPool(ctx context.Context) (*entities.GachaPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestPool", ctx)
+	ret0, _ := ret[0].(*entities.GachaPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestPool indicates an expected call of FindLatestPool.
+func (mr *MockRepositoryMockRecorder) FindLatestPool(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestPool", reflect.TypeOf((*MockRepository)(nil).FindLatestPool), ctx)
+}
+
+// FindOwnedGachaIds mocks base method.
+func (m *MockRepository) FindOwnedGachaIds(ctx context.Context, userID primitive.ObjectID, gachaIDs []primitive.ObjectID) ([]primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOwnedGachaIds", ctx, userID, gachaIDs)
+	ret0, _ := ret[0].([]primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOwnedGachaIds indicates an expected call of FindOwnedGachaIds.
+func (mr *MockRepositoryMockRecorder) FindOwnedGachaIds(ctx, userID, gachaIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOwnedGachaIds", reflect.TypeOf((*MockRepository)(nil).FindOwnedGachaIds), ctx, userID, gachaIDs)
+}
+
+// FindPity mocks base method.
+func (m *MockRepository) FindPity(ctx context.Context, userID, poolID primitive.ObjectID) (*entities.GachaPity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPity", ctx, userID, poolID)
+	ret0, _ := ret[0].(*entities.GachaPity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPity indicates an expected call of FindPity.
+func (mr *MockRepositoryMockRecorder) FindPity(ctx, userID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPity", reflect.TypeOf((*MockRepository)(nil).FindPity), ctx, userID, poolID)
+}
+
+// FindPoolById mocks base method.
+func (m *MockRepository) FindPoolById(ctx context.Context, poolID primitive.ObjectID) (*entities.GachaPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPoolById", ctx, poolID)
+	ret0, _ := ret[0].(*entities.GachaPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPoolById indicates an expected call of FindPoolById.
+func (mr *MockRepositoryMockRecorder) FindPoolById(ctx, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPoolById", reflect.TypeOf((*MockRepository)(nil).FindPoolById), ctx, poolID)
+}
+
+// FindShards mocks base method.
+func (m *MockRepository) FindShards(ctx context.Context, userID primitive.ObjectID) (*entities.GachaShards, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindShards", ctx, userID)
+	ret0, _ := ret[0].(*entities.GachaShards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindShards indicates an expected call of FindShards.
+func (mr *MockRepositoryMockRecorder) FindShards(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindShards", reflect.TypeOf((*MockRepository)(nil).FindShards), ctx, userID)
+}
+
+// SavePity mocks base method.
+func (m *MockRepository) SavePity(ctx context.Context, pity *entities.GachaPity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePity", ctx, pity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePity indicates an expected call of SavePity.
+func (mr *MockRepositoryMockRecorder) SavePity(ctx, pity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePity", reflect.TypeOf((*MockRepository)(nil).SavePity), ctx, pity)
+}
+
+// MockGachaStore is a mock of GachaStore interface.
+type MockGachaStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockGachaStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockGachaStoreMockRecorder is the mock recorder for MockGachaStore.
+type MockGachaStoreMockRecorder struct {
+	mock *MockGachaStore
+}
+
+// NewMockGachaStore creates a new mock instance.
+func NewMockGachaStore(ctrl *gomock.Controller) *MockGachaStore {
+	mock := &MockGachaStore{ctrl: ctrl}
+	mock.recorder = &MockGachaStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGachaStore) EXPECT() *MockGachaStoreMockRecorder {
+	return m.recorder
+}
+
+// GetPity mocks base method.
+func (m *MockGachaStore) GetPity(ctx context.Context, userID, poolID string) (*entities.GachaPity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPity", ctx, userID, poolID)
+	ret0, _ := ret[0].(*entities.GachaPity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPity indicates an expected call of GetPity.
+func (mr *MockGachaStoreMockRecorder) GetPity(ctx, userID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPity", reflect.TypeOf((*MockGachaStore)(nil).GetPity), ctx, userID, poolID)
+}
+
+// SetPity mocks base method.
+func (m *MockGachaStore) SetPity(ctx context.Context, pity *entities.GachaPity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPity", ctx, pity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPity indicates an expected call of SetPity.
+func (mr *MockGachaStoreMockRecorder) SetPity(ctx, pity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPity", reflect.TypeOf((*MockGachaStore)(nil).SetPity), ctx, pity)
+}
@@ -0,0 +1,55 @@
+package gacha_usecase
+
+import (
+	"math"
+	"slices"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	gacha_domain "github.com/Financial-Partner/server/internal/module/gacha/domain"
+)
+
+// drawWithPity draws from the whole pool until the user has gone the rule's threshold
+// of draws without an item of its rarity; the next draw then only considers items of
+// that rarity or rarer. A pool without such items is drawn from as a whole.
+func drawWithPity(items []entities.Gacha, rule gacha_domain.PityRule, count int, random gacha_domain.RandomSource) (*entities.Gacha, error) {
+	if rule.Threshold > 0 && count >= rule.Threshold {
+		guaranteed := make([]entities.Gacha, 0, len(items))
+		for _, item := range items {
+			if entities.GachaRarityAtLeast(item.Rarity, rule.Rarity) {
+				guaranteed = append(guaranteed, item)
+			}
+		}
+		if gacha, err := pickWeighted(guaranteed, random); err == nil {
+			return gacha, nil
+		}
+	}
+	return pickWeighted(items, random)
+}
+
+// dropRates returns each rarity's base chance of being drawn, in percent rounded to
+// two decimals, from most to least common.
+func dropRates(items []entities.Gacha) []entities.GachaDropRate {
+	weights := make(map[string]int)
+	rarities := slices.Clone(entities.GachaRarities)
+	total := 0
+	for _, item := range items {
+		if item.Weight <= 0 {
+			continue
+		}
+		if !slices.Contains(rarities, item.Rarity) {
+			rarities = append(rarities, item.Rarity)
+		}
+		weights[item.Rarity] += item.Weight
+		total += item.Weight
+	}
+
+	rates := make([]entities.GachaDropRate, 0, len(weights))
+	for _, rarity := range rarities {
+		if weights[rarity] == 0 {
+			continue
+		}
+		rate := math.Round(float64(weights[rarity])*10000/float64(total)) / 100
+		rates = append(rates, entities.GachaDropRate{Rarity: rarity, Rate: rate})
+	}
+	return rates
+}
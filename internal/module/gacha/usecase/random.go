@@ -0,0 +1,41 @@
+package gacha_usecase
+
+import (
+	"math/rand/v2"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	gacha_domain "github.com/Financial-Partner/server/internal/module/gacha/domain"
+)
+
+type globalRandomSource struct{}
+
+// NewRandomSource returns a random source backed by the runtime's global generator,
+// which is safe for concurrent draws.
+func NewRandomSource() gacha_domain.RandomSource {
+	return globalRandomSource{}
+}
+
+func (globalRandomSource) IntN(n int) int {
+	return rand.IntN(n)
+}
+
+// pickWeighted draws one item with a chance proportional to its weight.
+// Items without a positive weight are never drawn.
+func pickWeighted(items []entities.Gacha, random gacha_domain.RandomSource) (*entities.Gacha, error) {
+	total := 0
+	for _, item := range items {
+		total += max(item.Weight, 0)
+	}
+	if total == 0 {
+		return nil, gacha_domain.ErrEmptyPool
+	}
+
+	n := random.IntN(total)
+	for i := range items {
+		n -= max(items[i].Weight, 0)
+		if n < 0 {
+			return &items[i], nil
+		}
+	}
+	return nil, gacha_domain.ErrEmptyPool
+}
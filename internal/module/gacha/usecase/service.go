@@ -2,22 +2,362 @@ package gacha_usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	gacha_domain "github.com/Financial-Partner/server/internal/module/gacha/domain"
+	gacha_repository "github.com/Financial-Partner/server/internal/module/gacha/repository"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
+)
+
+const (
+	// previewSize is the number of items shown in a pool preview.
+	previewSize = 9
+	// maxBatchDraws is the most draws a single batch may make.
+	maxBatchDraws = 10
 )
 
+// shardValues is the number of shards a duplicate item of each rarity converts into.
+var shardValues = map[string]int64{
+	entities.GachaRarityCommon:    1,
+	entities.GachaRarityRare:      5,
+	entities.GachaRarityEpic:      20,
+	entities.GachaRarityLegendary: 50,
+}
+
 type Service struct {
+	repo        gacha_repository.Repository
+	store       gacha_repository.GachaStore
+	userService user_domain.UserService
+	transactor  gacha_domain.Transactor
+	random      gacha_domain.RandomSource
+	cfg         gacha_domain.Config
+	log         logger.Logger
+}
+
+func NewService(
+	repo gacha_repository.Repository,
+	store gacha_repository.GachaStore,
+	userService user_domain.UserService,
+	transactor gacha_domain.Transactor,
+	random gacha_domain.RandomSource,
+	cfg gacha_domain.Config,
+	log logger.Logger,
+) *Service {
+	return &Service{
+		repo:        repo,
+		store:       store,
+		userService: userService,
+		transactor:  transactor,
+		random:      random,
+		cfg:         cfg,
+		log:         log,
+	}
+}
+
+// PreviewGachas shows the first items of a pool with the pool's drop rates and the
+// user's pity progress. An empty pool ID previews the latest pool.
+func (s *Service) PreviewGachas(ctx context.Context, userID, poolID string) (*entities.GachaPreview, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	pool, err := s.findPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	pity, err := s.getPity(ctx, objectID, pool.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := s.cfg.Pity.ForPool(pool.ID.Hex())
+	return &entities.GachaPreview{
+		PoolID:        pool.ID,
+		Cost:          pool.Cost,
+		Items:         pool.Items[:min(len(pool.Items), previewSize)],
+		DropRates:     dropRates(pool.Items),
+		PityRarity:    rule.Rarity,
+		PityThreshold: rule.Threshold,
+		PityCount:     pity.Count,
+	}, nil
+}
+
+// DrawGacha charges the pool's cost in diamonds and draws one item from it.
+func (s *Service) DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.GachaDraw, error) {
+	draws, err := s.draw(ctx, userID, req.PoolID, 1, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &draws[0], nil
+}
+
+// DrawGachaBatch makes several draws from a pool at a discount. Either every draw
+// succeeds or none is charged.
+func (s *Service) DrawGachaBatch(ctx context.Context, userID string, req *dto.BatchDrawGachaRequest) ([]entities.GachaDraw, error) {
+	if req.Count < 1 || req.Count > maxBatchDraws {
+		return nil, gacha_domain.ErrInvalidDrawCount
+	}
+	return s.draw(ctx, userID, req.PoolID, req.Count, req.Amount)
+}
+
+func (s *Service) GetInventory(ctx context.Context, userID string) (*entities.GachaInventory, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	items, err := s.repo.FindInventoryByUserId(ctx, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	inventory := &entities.GachaInventory{Items: items}
+	shards, err := s.repo.FindShards(ctx, objectID)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to get shards: %w", err)
+	}
+	if shards != nil {
+		inventory.Shards = shards.Balance
+	}
+
+	return inventory, nil
+}
+
+// CreatePool adds a gacha pool. Every item needs a known rarity and a non-negative
+// weight, and at least one item must be drawable.
+func (s *Service) CreatePool(ctx context.Context, req *dto.CreateGachaPoolRequest) (*entities.GachaPool, error) {
+	pool := &entities.GachaPool{
+		Name:      req.Name,
+		Cost:      req.Cost,
+		Items:     make([]entities.Gacha, 0, len(req.Items)),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	totalWeight := 0
+	for _, item := range req.Items {
+		if item.Weight < 0 || !slices.Contains(entities.GachaRarities, item.Rarity) {
+			return nil, gacha_domain.ErrInvalidPool
+		}
+		totalWeight += item.Weight
+		pool.Items = append(pool.Items, entities.Gacha{
+			Name:        item.Name,
+			ImgSrc:      item.ImgSrc,
+			Rarity:      item.Rarity,
+			Weight:      item.Weight,
+			CharacterID: item.CharacterID,
+		})
+	}
+	if pool.Cost <= 0 || totalWeight == 0 {
+		return nil, gacha_domain.ErrInvalidPool
+	}
+
+	createdPool, err := s.repo.CreatePool(ctx, pool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gacha pool: %w", err)
+	}
+
+	return createdPool, nil
+}
+
+// draw charges amount in diamonds and makes count draws from the pool. The charge,
+// the collected items and the user's pity count are saved in one transaction, so a
+// failed draw costs nothing.
+func (s *Service) draw(ctx context.Context, userID, poolID string, count int, amount int64) ([]entities.GachaDraw, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	pool, err := s.findPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	if amount != drawCost(pool.Cost, count, s.cfg.BatchDiscountPercent) {
+		return nil, gacha_domain.ErrInvalidDrawAmount
+	}
+
+	rule := s.cfg.Pity.ForPool(pool.ID.Hex())
+
+	var draws []entities.GachaDraw
+	var pity *entities.GachaPity
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if pity, err = s.findPity(ctx, objectID, pool.ID); err != nil {
+			return err
+		}
+
+		draws = make([]entities.GachaDraw, 0, count)
+		for range count {
+			gacha, err := drawWithPity(pool.Items, rule, pity.Count, s.random)
+			if err != nil {
+				return err
+			}
+			if entities.GachaRarityAtLeast(gacha.Rarity, rule.Rarity) {
+				pity.Count = 0
+			} else {
+				pity.Count++
+			}
+			draws = append(draws, entities.GachaDraw{Gacha: *gacha})
+		}
+
+		if _, err := s.userService.UpdateWallet(ctx, userID, -amount, entities.Money{}); err != nil {
+			return err
+		}
+		if err := s.collect(ctx, objectID, pool.ID, draws); err != nil {
+			return err
+		}
+		return s.repo.SavePity(ctx, pity)
+	})
+	if errors.Is(err, user_domain.ErrInsufficientBalance) {
+		return nil, gacha_domain.ErrInsufficientDiamonds
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to draw gacha: %w", err)
+	}
+
+	s.setPityToStore(ctx, pity)
+
+	return draws, nil
+}
+
+// collect adds drawn items to the user's inventory. Items the user already owns,
+// including repeats within the same batch, are converted into shards instead.
+func (s *Service) collect(ctx context.Context, userID, poolID primitive.ObjectID, draws []entities.GachaDraw) error {
+	gachaIDs := make([]primitive.ObjectID, 0, len(draws))
+	for _, draw := range draws {
+		gachaIDs = append(gachaIDs, draw.Gacha.ID)
+	}
+	ownedIDs, err := s.repo.FindOwnedGachaIds(ctx, userID, gachaIDs)
+	if err != nil {
+		return err
+	}
+	owned := make(map[primitive.ObjectID]bool, len(ownedIDs))
+	for _, id := range ownedIDs {
+		owned[id] = true
+	}
+
+	drawnAt := time.Now().UTC()
+	var shards int64
+	for i := range draws {
+		draw := &draws[i]
+		if owned[draw.Gacha.ID] {
+			draw.Duplicate = true
+			draw.Shards = shardValue(draw.Gacha.Rarity)
+			shards += draw.Shards
+			continue
+		}
+		owned[draw.Gacha.ID] = true
+
+		_, err := s.repo.CreateInventoryItem(ctx, &entities.GachaInventoryItem{
+			UserID:  userID,
+			PoolID:  poolID,
+			GachaID: draw.Gacha.ID,
+			Name:    draw.Gacha.Name,
+			ImgSrc:  draw.Gacha.ImgSrc,
+			Rarity:  draw.Gacha.Rarity,
+			DrawnAt: drawnAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		if draw.Gacha.CharacterID != "" {
+			if err := s.userService.UnlockCharacter(ctx, userID.Hex(), draw.Gacha.CharacterID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if shards > 0 {
+		return s.repo.AddShards(ctx, userID, shards)
+	}
+	return nil
+}
+
+// findPool loads the pool with the given ID, or the latest pool when the ID is empty.
+func (s *Service) findPool(ctx context.Context, poolID string) (*entities.GachaPool, error) {
+	var pool *entities.GachaPool
+	var err error
+	if poolID == "" {
+		pool, err = s.repo.FindLatestPool(ctx)
+	} else {
+		objectID, parseErr := primitive.ObjectIDFromHex(poolID)
+		if parseErr != nil {
+			return nil, gacha_domain.ErrPoolNotFound
+		}
+		pool, err = s.repo.FindPoolById(ctx, objectID)
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, gacha_domain.ErrPoolNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gacha pool: %w", err)
+	}
+
+	return pool, nil
+}
+
+// getPity returns the user's pity count for the pool, preferring the cached copy.
+func (s *Service) getPity(ctx context.Context, userID, poolID primitive.ObjectID) (*entities.GachaPity, error) {
+	pity, err := s.store.GetPity(ctx, userID.Hex(), poolID.Hex())
+	if err == nil {
+		return pity, nil
+	}
+
+	pity, err = s.findPity(ctx, userID, poolID)
+	if err != nil {
+		return nil, err
+	}
+	s.setPityToStore(ctx, pity)
+
+	return pity, nil
+}
+
+// findPity loads the user's pity count for the pool from the database. A user who
+// never drew from the pool starts at zero.
+func (s *Service) findPity(ctx context.Context, userID, poolID primitive.ObjectID) (*entities.GachaPity, error) {
+	pity, err := s.repo.FindPity(ctx, userID, poolID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &entities.GachaPity{UserID: userID, PoolID: poolID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pity: %w", err)
+	}
+	return pity, nil
 }
 
-func NewService() *Service {
-	return &Service{}
+func (s *Service) setPityToStore(ctx context.Context, pity *entities.GachaPity) {
+	if err := s.store.SetPity(ctx, pity); err != nil {
+		s.log.Warnf("Failed to cache pity for userID %s: %v", pity.UserID.Hex(), err)
+	}
 }
 
-func (s *Service) PreviewGachas(ctx context.Context, userID string) ([]entities.Gacha, error) {
-	return nil, nil
+// drawCost is the diamond price of count draws from a pool. Batches of more than one
+// draw get the configured discount.
+func drawCost(cost int64, count, discountPercent int) int64 {
+	total := cost * int64(count)
+	if count > 1 {
+		total -= total * int64(discountPercent) / 100
+	}
+	return total
 }
 
-func (s *Service) DrawGacha(ctx context.Context, userID string, req *dto.DrawGachaRequest) (*entities.Gacha, error) {
-	return nil, nil
+// shardValue is the number of shards a duplicate item converts into. Items of an
+// unknown rarity are worth as much as common ones.
+func shardValue(rarity string) int64 {
+	if value, ok := shardValues[rarity]; ok {
+		return value
+	}
+	return shardValues[entities.GachaRarityCommon]
 }
@@ -0,0 +1,704 @@
+package gacha_usecase_test
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	gacha_domain "github.com/Financial-Partner/server/internal/module/gacha/domain"
+	gacha_repository "github.com/Financial-Partner/server/internal/module/gacha/repository"
+	gacha_usecase "github.com/Financial-Partner/server/internal/module/gacha/usecase"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
+)
+
+type Mocks struct {
+	ctrl            *gomock.Controller
+	mockRepo        *gacha_repository.MockRepository
+	mockStore       *gacha_repository.MockGachaStore
+	mockUserService *user_domain.MockUserService
+	mockTransactor  *gacha_domain.MockTransactor
+	mockRandom      *gacha_domain.MockRandomSource
+}
+
+func NewMocks(t *testing.T) *Mocks {
+	ctrl := gomock.NewController(t)
+
+	return &Mocks{
+		ctrl:            ctrl,
+		mockRepo:        gacha_repository.NewMockRepository(ctrl),
+		mockStore:       gacha_repository.NewMockGachaStore(ctrl),
+		mockUserService: user_domain.NewMockUserService(ctrl),
+		mockTransactor:  gacha_domain.NewMockTransactor(ctrl),
+		mockRandom:      gacha_domain.NewMockRandomSource(ctrl),
+	}
+}
+
+// testConfig guarantees a rare item after ten draws without one and takes 10% off batches.
+var testConfig = gacha_domain.Config{
+	Pity: gacha_domain.PityRules{
+		Default: gacha_domain.PityRule{Rarity: entities.GachaRarityRare, Threshold: 10},
+	},
+	BatchDiscountPercent: 10,
+}
+
+func (m *Mocks) newService() *gacha_usecase.Service {
+	return gacha_usecase.NewService(m.mockRepo, m.mockStore, m.mockUserService, m.mockTransactor, m.mockRandom, testConfig, logger.NewNopLogger())
+}
+
+// expectTransaction runs the transaction body directly, as a committed transaction would.
+func (m *Mocks) expectTransaction() {
+	m.mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+}
+
+// expectDraw expects a committed draw from the pool by a user whose pity count goes
+// from count to expectedCount.
+func (m *Mocks) expectDraw(userID primitive.ObjectID, pool *entities.GachaPool, count, expectedCount int) {
+	m.expectTransaction()
+	if count == 0 {
+		m.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(nil, mongo.ErrNoDocuments)
+	} else {
+		m.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(&entities.GachaPity{UserID: userID, PoolID: pool.ID, Count: count}, nil)
+	}
+	m.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), -pool.Cost, entities.Money{}).Return(&entities.User{}, nil)
+	m.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, gomock.Len(1)).Return(nil, nil)
+	m.mockRepo.EXPECT().CreateInventoryItem(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, item *entities.GachaInventoryItem) (*entities.GachaInventoryItem, error) {
+			return item, nil
+		},
+	)
+	expected := &entities.GachaPity{UserID: userID, PoolID: pool.ID, Count: expectedCount}
+	m.mockRepo.EXPECT().SavePity(gomock.Any(), expected).Return(nil)
+	m.mockStore.EXPECT().SetPity(gomock.Any(), expected).Return(nil)
+}
+
+func testPool() *entities.GachaPool {
+	return &entities.GachaPool{
+		ID:   primitive.NewObjectID(),
+		Name: "Starter pool",
+		Cost: 100,
+		Items: []entities.Gacha{
+			{ID: primitive.NewObjectID(), Name: "Piggy bank", Rarity: entities.GachaRarityCommon, Weight: 70},
+			{ID: primitive.NewObjectID(), Name: "Silver coin", Rarity: entities.GachaRarityRare, Weight: 25},
+			{ID: primitive.NewObjectID(), Name: "Broken vase", Rarity: entities.GachaRarityRare, Weight: 0},
+			{ID: primitive.NewObjectID(), Name: "Golden coin", Rarity: entities.GachaRarityLegendary, Weight: 5},
+		},
+	}
+}
+
+func TestDrawGacha(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Weighted draw from the latest pool", func(t *testing.T) {
+		testCases := []struct {
+			roll     int
+			expected string
+			pity     int
+		}{
+			{0, "Piggy bank", 5},
+			{69, "Piggy bank", 5},
+			{70, "Silver coin", 0},
+			{94, "Silver coin", 0},
+			{95, "Golden coin", 0},
+			{99, "Golden coin", 0},
+		}
+
+		for _, tc := range testCases {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+			pool := testPool()
+
+			mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+			mocks.mockRandom.EXPECT().IntN(100).Return(tc.roll)
+			mocks.expectDraw(userID, pool, 4, tc.pity)
+
+			draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, draw.Gacha.Name)
+		}
+	})
+
+	t.Run("Seeded source is reproducible", func(t *testing.T) {
+		draw := func() []string {
+			mocks := NewMocks(t)
+			pool := testPool()
+
+			service := gacha_usecase.NewService(mocks.mockRepo, mocks.mockStore, mocks.mockUserService, mocks.mockTransactor, rand.New(rand.NewPCG(1, 2)), gacha_domain.Config{}, logger.NewNopLogger())
+			mocks.mockRepo.EXPECT().FindPoolById(gomock.Any(), pool.ID).Return(pool, nil).Times(20)
+			mocks.mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).Times(20).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				},
+			)
+			mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(nil, mongo.ErrNoDocuments).Times(20)
+			mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-100), entities.Money{}).Return(&entities.User{}, nil).Times(20)
+			mocks.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, gomock.Any()).Return(nil, nil).Times(20)
+			mocks.mockRepo.EXPECT().CreateInventoryItem(gomock.Any(), gomock.Any()).Return(&entities.GachaInventoryItem{}, nil).Times(20)
+			mocks.mockRepo.EXPECT().SavePity(gomock.Any(), gomock.Any()).Return(nil).Times(20)
+			mocks.mockStore.EXPECT().SetPity(gomock.Any(), gomock.Any()).Return(nil).Times(20)
+
+			names := make([]string, 0, 20)
+			for range 20 {
+				draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{PoolID: pool.ID.Hex(), Amount: 100})
+				require.NoError(t, err)
+				assert.NotEqual(t, "Broken vase", draw.Gacha.Name)
+				names = append(names, draw.Gacha.Name)
+			}
+			return names
+		}
+
+		assert.Equal(t, draw(), draw())
+	})
+
+	t.Run("Pity guarantees a rare item", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+
+		// Only the silver and golden coins (25 + 5) are drawable; the vase has no weight.
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockRandom.EXPECT().IntN(30).Return(27)
+		mocks.expectDraw(userID, pool, 10, 0)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		require.NoError(t, err)
+		assert.Equal(t, "Golden coin", draw.Gacha.Name)
+	})
+
+	t.Run("Pity rule of the pool", func(t *testing.T) {
+		mocks := NewMocks(t)
+		pool := testPool()
+		cfg := testConfig
+		cfg.Pity.Pools = map[string]gacha_domain.PityRule{
+			pool.ID.Hex(): {Rarity: entities.GachaRarityLegendary, Threshold: 90},
+		}
+		service := gacha_usecase.NewService(mocks.mockRepo, mocks.mockStore, mocks.mockUserService, mocks.mockTransactor, mocks.mockRandom, cfg, logger.NewNopLogger())
+
+		// A rare item doesn't reset the pity of a pool that guarantees legendaries.
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockRandom.EXPECT().IntN(100).Return(80)
+		mocks.expectDraw(userID, pool, 10, 11)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		require.NoError(t, err)
+		assert.Equal(t, "Silver coin", draw.Gacha.Name)
+	})
+
+	t.Run("Pity in a pool without rare items", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+		pool.Items = pool.Items[:1]
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockRandom.EXPECT().IntN(70).Return(0)
+		mocks.expectDraw(userID, pool, 12, 13)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		require.NoError(t, err)
+		assert.Equal(t, "Piggy bank", draw.Gacha.Name)
+	})
+
+	t.Run("Pity error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(testPool(), nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, gomock.Any()).Return(nil, errors.New("db error"))
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		assert.Error(t, err)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("Amount does not match cost", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(testPool(), nil)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 50})
+		assert.ErrorIs(t, err, gacha_domain.ErrInvalidDrawAmount)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("Pool not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		poolID := primitive.NewObjectID()
+
+		mocks.mockRepo.EXPECT().FindPoolById(gomock.Any(), poolID).Return(nil, mongo.ErrNoDocuments)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{PoolID: poolID.Hex(), Amount: 100})
+		assert.ErrorIs(t, err, gacha_domain.ErrPoolNotFound)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("Malformed pool ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{PoolID: "invalid", Amount: 100})
+		assert.ErrorIs(t, err, gacha_domain.ErrPoolNotFound)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("Pool without drawable items", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+		for i := range pool.Items {
+			pool.Items[i].Weight = 0
+		}
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(nil, mongo.ErrNoDocuments)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		assert.ErrorIs(t, err, gacha_domain.ErrEmptyPool)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("Insufficient diamonds", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(testPool(), nil)
+		mocks.mockRandom.EXPECT().IntN(100).Return(0)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, gomock.Any()).Return(nil, mongo.ErrNoDocuments)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-100), entities.Money{}).Return(nil, user_domain.ErrInsufficientBalance)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		assert.ErrorIs(t, err, gacha_domain.ErrInsufficientDiamonds)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("Inventory error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(testPool(), nil)
+		mocks.mockRandom.EXPECT().IntN(100).Return(0)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, gomock.Any()).Return(nil, mongo.ErrNoDocuments)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-100), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, gomock.Any()).Return(nil, nil)
+		mocks.mockRepo.EXPECT().CreateInventoryItem(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		assert.Error(t, err)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("First draw unlocks the item's character", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+		pool.Items[3].CharacterID = "char_001"
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockRandom.EXPECT().IntN(100).Return(99)
+		mocks.expectDraw(userID, pool, 0, 0)
+		mocks.mockUserService.EXPECT().UnlockCharacter(gomock.Any(), userID.Hex(), "char_001").Return(nil)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		require.NoError(t, err)
+		assert.Equal(t, "char_001", draw.Gacha.CharacterID)
+	})
+
+	t.Run("Unlock error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+		pool.Items[3].CharacterID = "char_001"
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockRandom.EXPECT().IntN(100).Return(99)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(nil, mongo.ErrNoDocuments)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-100), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, gomock.Any()).Return(nil, nil)
+		mocks.mockRepo.EXPECT().CreateInventoryItem(gomock.Any(), gomock.Any()).Return(&entities.GachaInventoryItem{}, nil)
+		mocks.mockUserService.EXPECT().UnlockCharacter(gomock.Any(), userID.Hex(), "char_001").Return(user_domain.ErrUserNotFound)
+
+		draw, err := service.DrawGacha(context.Background(), userID.Hex(), &dto.DrawGachaRequest{Amount: 100})
+		assert.ErrorIs(t, err, user_domain.ErrUserNotFound)
+		assert.Nil(t, draw)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		draw, err := service.DrawGacha(context.Background(), "invalid", &dto.DrawGachaRequest{Amount: 100})
+		assert.Error(t, err)
+		assert.Nil(t, draw)
+	})
+}
+
+func TestDrawGachaBatch(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Discounted batch with duplicates", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+		piggy, silver, golden := pool.Items[0], pool.Items[1], pool.Items[3]
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		// Piggy bank, silver coin, piggy bank again, golden coin.
+		gomock.InOrder(
+			mocks.mockRandom.EXPECT().IntN(100).Return(10),
+			mocks.mockRandom.EXPECT().IntN(100).Return(80),
+			mocks.mockRandom.EXPECT().IntN(100).Return(20),
+			mocks.mockRandom.EXPECT().IntN(100).Return(97),
+		)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(&entities.GachaPity{UserID: userID, PoolID: pool.ID, Count: 2}, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-360), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, []primitive.ObjectID{piggy.ID, silver.ID, piggy.ID, golden.ID}).
+			Return([]primitive.ObjectID{silver.ID}, nil)
+		var collected []primitive.ObjectID
+		mocks.mockRepo.EXPECT().CreateInventoryItem(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+			func(ctx context.Context, item *entities.GachaInventoryItem) (*entities.GachaInventoryItem, error) {
+				collected = append(collected, item.GachaID)
+				return item, nil
+			},
+		)
+		mocks.mockRepo.EXPECT().AddShards(gomock.Any(), userID, int64(6)).Return(nil)
+		expected := &entities.GachaPity{UserID: userID, PoolID: pool.ID, Count: 0}
+		mocks.mockRepo.EXPECT().SavePity(gomock.Any(), expected).Return(nil)
+		mocks.mockStore.EXPECT().SetPity(gomock.Any(), expected).Return(nil)
+
+		draws, err := service.DrawGachaBatch(context.Background(), userID.Hex(), &dto.BatchDrawGachaRequest{Count: 4, Amount: 360})
+		require.NoError(t, err)
+		assert.Equal(t, []entities.GachaDraw{
+			{Gacha: piggy},
+			{Gacha: silver, Duplicate: true, Shards: 5},
+			{Gacha: piggy, Duplicate: true, Shards: 1},
+			{Gacha: golden},
+		}, draws)
+		assert.Equal(t, []primitive.ObjectID{piggy.ID, golden.ID}, collected)
+	})
+
+	t.Run("Pity is applied within the batch", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		gomock.InOrder(
+			mocks.mockRandom.EXPECT().IntN(100).Return(0),
+			mocks.mockRandom.EXPECT().IntN(30).Return(0),
+		)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(&entities.GachaPity{UserID: userID, PoolID: pool.ID, Count: 9}, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-180), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, gomock.Len(2)).Return(nil, nil)
+		mocks.mockRepo.EXPECT().CreateInventoryItem(gomock.Any(), gomock.Any()).Times(2).Return(&entities.GachaInventoryItem{}, nil)
+		expected := &entities.GachaPity{UserID: userID, PoolID: pool.ID, Count: 0}
+		mocks.mockRepo.EXPECT().SavePity(gomock.Any(), expected).Return(nil)
+		mocks.mockStore.EXPECT().SetPity(gomock.Any(), expected).Return(nil)
+
+		draws, err := service.DrawGachaBatch(context.Background(), userID.Hex(), &dto.BatchDrawGachaRequest{Count: 2, Amount: 180})
+		require.NoError(t, err)
+		require.Len(t, draws, 2)
+		assert.Equal(t, "Piggy bank", draws[0].Gacha.Name)
+		assert.Equal(t, "Silver coin", draws[1].Gacha.Name)
+	})
+
+	t.Run("Shards error rolls back", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockRandom.EXPECT().IntN(100).Times(2).Return(0)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(nil, mongo.ErrNoDocuments)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-180), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, gomock.Len(2)).Return(nil, nil)
+		mocks.mockRepo.EXPECT().CreateInventoryItem(gomock.Any(), gomock.Any()).Return(&entities.GachaInventoryItem{}, nil)
+		mocks.mockRepo.EXPECT().AddShards(gomock.Any(), userID, int64(1)).Return(errors.New("db error"))
+
+		draws, err := service.DrawGachaBatch(context.Background(), userID.Hex(), &dto.BatchDrawGachaRequest{Count: 2, Amount: 180})
+		assert.Error(t, err)
+		assert.Nil(t, draws)
+	})
+
+	t.Run("Owned items error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockRandom.EXPECT().IntN(100).Times(2).Return(0)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(nil, mongo.ErrNoDocuments)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-180), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().FindOwnedGachaIds(gomock.Any(), userID, gomock.Len(2)).Return(nil, errors.New("db error"))
+
+		draws, err := service.DrawGachaBatch(context.Background(), userID.Hex(), &dto.BatchDrawGachaRequest{Count: 2, Amount: 180})
+		assert.Error(t, err)
+		assert.Nil(t, draws)
+	})
+
+	t.Run("Undiscounted amount", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(testPool(), nil)
+
+		draws, err := service.DrawGachaBatch(context.Background(), userID.Hex(), &dto.BatchDrawGachaRequest{Count: 10, Amount: 1000})
+		assert.ErrorIs(t, err, gacha_domain.ErrInvalidDrawAmount)
+		assert.Nil(t, draws)
+	})
+
+	t.Run("Invalid count", func(t *testing.T) {
+		for _, count := range []int{0, 11} {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			draws, err := service.DrawGachaBatch(context.Background(), userID.Hex(), &dto.BatchDrawGachaRequest{Count: count, Amount: 100})
+			assert.ErrorIs(t, err, gacha_domain.ErrInvalidDrawCount)
+			assert.Nil(t, draws)
+		}
+	})
+}
+
+func TestPreviewGachas(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Preview with cached pity", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+		for len(pool.Items) < 12 {
+			pool.Items = append(pool.Items, entities.Gacha{ID: primitive.NewObjectID(), Rarity: entities.GachaRarityEpic, Weight: 0})
+		}
+		pool.Items[11].Weight = 100
+
+		mocks.mockRepo.EXPECT().FindPoolById(gomock.Any(), pool.ID).Return(pool, nil)
+		mocks.mockStore.EXPECT().GetPity(gomock.Any(), userID.Hex(), pool.ID.Hex()).Return(&entities.GachaPity{Count: 4}, nil)
+
+		preview, err := service.PreviewGachas(context.Background(), userID.Hex(), pool.ID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, pool.ID, preview.PoolID)
+		assert.Equal(t, int64(100), preview.Cost)
+		assert.Equal(t, pool.Items[:9], preview.Items)
+		assert.Equal(t, []entities.GachaDropRate{
+			{Rarity: entities.GachaRarityCommon, Rate: 35},
+			{Rarity: entities.GachaRarityRare, Rate: 12.5},
+			{Rarity: entities.GachaRarityEpic, Rate: 50},
+			{Rarity: entities.GachaRarityLegendary, Rate: 2.5},
+		}, preview.DropRates)
+		assert.Equal(t, entities.GachaRarityRare, preview.PityRarity)
+		assert.Equal(t, 10, preview.PityThreshold)
+		assert.Equal(t, 4, preview.PityCount)
+	})
+
+	t.Run("Pity loaded from database", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+		pity := &entities.GachaPity{UserID: userID, PoolID: pool.ID, Count: 7}
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockStore.EXPECT().GetPity(gomock.Any(), userID.Hex(), pool.ID.Hex()).Return(nil, errors.New("cache miss"))
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(pity, nil)
+		mocks.mockStore.EXPECT().SetPity(gomock.Any(), pity).Return(errors.New("cache error"))
+
+		preview, err := service.PreviewGachas(context.Background(), userID.Hex(), "")
+		require.NoError(t, err)
+		assert.Equal(t, pool.Items, preview.Items)
+		assert.Equal(t, 7, preview.PityCount)
+	})
+
+	t.Run("Pity error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		pool := testPool()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(pool, nil)
+		mocks.mockStore.EXPECT().GetPity(gomock.Any(), userID.Hex(), pool.ID.Hex()).Return(nil, errors.New("cache miss"))
+		mocks.mockRepo.EXPECT().FindPity(gomock.Any(), userID, pool.ID).Return(nil, errors.New("db error"))
+
+		preview, err := service.PreviewGachas(context.Background(), userID.Hex(), "")
+		assert.Error(t, err)
+		assert.Nil(t, preview)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindLatestPool(gomock.Any()).Return(nil, errors.New("db error"))
+
+		preview, err := service.PreviewGachas(context.Background(), userID.Hex(), "")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, gacha_domain.ErrPoolNotFound)
+		assert.Nil(t, preview)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		preview, err := service.PreviewGachas(context.Background(), "invalid", "")
+		assert.Error(t, err)
+		assert.Nil(t, preview)
+	})
+}
+
+func TestGetInventory(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		items := []entities.GachaInventoryItem{{ID: primitive.NewObjectID(), UserID: userID, Name: "Piggy bank"}}
+
+		mocks.mockRepo.EXPECT().FindInventoryByUserId(gomock.Any(), userID).Return(items, nil)
+		mocks.mockRepo.EXPECT().FindShards(gomock.Any(), userID).Return(&entities.GachaShards{UserID: userID, Balance: 25}, nil)
+
+		result, err := service.GetInventory(context.Background(), userID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, &entities.GachaInventory{Items: items, Shards: 25}, result)
+	})
+
+	t.Run("No shards yet", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindInventoryByUserId(gomock.Any(), userID).Return(nil, nil)
+		mocks.mockRepo.EXPECT().FindShards(gomock.Any(), userID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := service.GetInventory(context.Background(), userID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, &entities.GachaInventory{}, result)
+	})
+
+	t.Run("Shards error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindInventoryByUserId(gomock.Any(), userID).Return(nil, nil)
+		mocks.mockRepo.EXPECT().FindShards(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		result, err := service.GetInventory(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindInventoryByUserId(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		result, err := service.GetInventory(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		result, err := service.GetInventory(context.Background(), "invalid")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCreatePool(t *testing.T) {
+	newRequest := func() *dto.CreateGachaPoolRequest {
+		return &dto.CreateGachaPoolRequest{
+			Name: "Spring festival",
+			Cost: 100,
+			Items: []dto.CreateGachaItemRequest{
+				{Name: "Piggy bank", Rarity: entities.GachaRarityCommon, Weight: 90},
+				{Name: "Golden coin", Rarity: entities.GachaRarityLegendary, Weight: 10, CharacterID: "coin"},
+			},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().CreatePool(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, pool *entities.GachaPool) (*entities.GachaPool, error) {
+				assert.Equal(t, "Spring festival", pool.Name)
+				assert.Equal(t, int64(100), pool.Cost)
+				require.Len(t, pool.Items, 2)
+				assert.Equal(t, entities.GachaRarityLegendary, pool.Items[1].Rarity)
+				assert.Equal(t, "coin", pool.Items[1].CharacterID)
+				assert.False(t, pool.CreatedAt.IsZero())
+				pool.ID = primitive.NewObjectID()
+				return pool, nil
+			},
+		)
+
+		result, err := service.CreatePool(context.Background(), newRequest())
+		require.NoError(t, err)
+		assert.False(t, result.ID.IsZero())
+	})
+
+	invalid := map[string]func(req *dto.CreateGachaPoolRequest){
+		"Unknown rarity":    func(req *dto.CreateGachaPoolRequest) { req.Items[0].Rarity = "mythic" },
+		"Negative weight":   func(req *dto.CreateGachaPoolRequest) { req.Items[0].Weight = -1 },
+		"Zero cost":         func(req *dto.CreateGachaPoolRequest) { req.Cost = 0 },
+		"No drawable items": func(req *dto.CreateGachaPoolRequest) { req.Items[0].Weight, req.Items[1].Weight = 0, 0 },
+		"No items":          func(req *dto.CreateGachaPoolRequest) { req.Items = nil },
+	}
+	for name, modify := range invalid {
+		t.Run(name, func(t *testing.T) {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			req := newRequest()
+			modify(req)
+
+			result, err := service.CreatePool(context.Background(), req)
+			assert.ErrorIs(t, err, gacha_domain.ErrInvalidPool)
+			assert.Nil(t, result)
+		})
+	}
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().CreatePool(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.CreatePool(context.Background(), newRequest())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestNewRandomSource(t *testing.T) {
+	random := gacha_usecase.NewRandomSource()
+	for range 100 {
+		n := random.IntN(10)
+		assert.GreaterOrEqual(t, n, 0)
+		assert.Less(t, n, 10)
+	}
+}
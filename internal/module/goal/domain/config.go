@@ -0,0 +1,8 @@
+package goal_domain
+
+// Config holds the goal rules that are set per deployment rather than per goal.
+type Config struct {
+	// MilestoneCharacters maps the target percent of a milestone to the character
+	// completing it unlocks. Milestones without one only pay diamonds.
+	MilestoneCharacters map[int]string
+}
@@ -0,0 +1,12 @@
+package goal_domain
+
+import "errors"
+
+var (
+	ErrGoalNotFound       = errors.New("goal not found")
+	ErrInvalidGoal        = errors.New("target amount and period must be positive, priority must not be negative and allocation percent must be between 0 and 100")
+	ErrInvalidGoalStatus  = errors.New("invalid goal status")
+	ErrGoalNotActive      = errors.New("goal is no longer active")
+	ErrAllocationExceeded = errors.New("allocation percents of active goals exceed 100")
+	ErrInvalidCurrency    = errors.New("currency must be an ISO 4217 code")
+)
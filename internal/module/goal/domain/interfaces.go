@@ -0,0 +1,31 @@
+package goal_domain
+
+import (
+	"context"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=interfaces_mock.go -package=goal_domain
+
+type GoalService interface {
+	GetGoalSuggestion(ctx context.Context, userID, locale string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error)
+	GetAutoGoalSuggestion(ctx context.Context, userID, locale string) (*entities.GoalSuggestion, error)
+	CreateGoal(ctx context.Context, userID string, req *dto.CreateGoalRequest) (*entities.Goal, error)
+	GetGoals(ctx context.Context, userID, status string) ([]entities.Goal, error)
+	GetGoal(ctx context.Context, userID, goalID string) (*entities.Goal, error)
+	UpdateGoal(ctx context.Context, userID, goalID string, req *dto.UpdateGoalRequest) (*entities.Goal, error)
+	DeleteGoal(ctx context.Context, userID, goalID string) error
+	GetGoalMilestones(ctx context.Context, userID, goalID string) ([]entities.GoalMilestone, error)
+}
+
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// SuggestionStrategy turns a user's cash flow into a saving goal suggestion
+// whose message is written in the given locale.
+type SuggestionStrategy interface {
+	Suggest(cashFlow CashFlow, locale string) *entities.GoalSuggestion
+}
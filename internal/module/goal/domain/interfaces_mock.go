@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=interfaces_mock.go -package=goal_domain
+//
+
+// Package goal_domain is a generated GoMock package.
+package goal_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	dto "github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGoalService is a mock of GoalService interface.
+type MockGoalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoalServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGoalServiceMockRecorder is the mock recorder for MockGoalService.
+type MockGoalServiceMockRecorder struct {
+	mock *MockGoalService
+}
+
+// NewMockGoalService creates a new mock instance.
+func NewMockGoalService(ctrl *gomock.Controller) *MockGoalService {
+	mock := &MockGoalService{ctrl: ctrl}
+	mock.recorder = &MockGoalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoalService) EXPECT() *MockGoalServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateGoal mocks base method.
+func (m *MockGoalService) CreateGoal(ctx context.Context, userID string, req *dto.CreateGoalRequest) (*entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, userID, req)
+	ret0, _ := ret[0].(*entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockGoalServiceMockRecorder) CreateGoal(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockGoalService)(nil).CreateGoal), ctx, userID, req)
+}
+
+// DeleteGoal mocks base method.
+func (m *MockGoalService) DeleteGoal(ctx context.Context, userID, goalID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, userID, goalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockGoalServiceMockRecorder) DeleteGoal(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockGoalService)(nil).DeleteGoal), ctx, userID, goalID)
+}
+
+// GetAutoGoalSuggestion mocks base method.
+func (m *MockGoalService) GetAutoGoalSuggestion(ctx context.Context, userID, locale string) (*entities.GoalSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoGoalSuggestion", ctx, userID, locale)
+	ret0, _ := ret[0].(*entities.GoalSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoGoalSuggestion indicates an expected call of GetAutoGoalSuggestion.
+func (mr *MockGoalServiceMockRecorder) GetAutoGoalSuggestion(ctx, userID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoGoalSuggestion", reflect.TypeOf((*MockGoalService)(nil).GetAutoGoalSuggestion), ctx, userID, locale)
+}
+
+// GetGoal mocks base method.
+func (m *MockGoalService) GetGoal(ctx context.Context, userID, goalID string) (*entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoal", ctx, userID, goalID)
+	ret0, _ := ret[0].(*entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoal indicates an expected call of GetGoal.
+func (mr *MockGoalServiceMockRecorder) GetGoal(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockGoalService)(nil).GetGoal), ctx, userID, goalID)
+}
+
+// GetGoalMilestones mocks base method.
+func (m *MockGoalService) GetGoalMilestones(ctx context.Context, userID, goalID string) ([]entities.GoalMilestone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalMilestones", ctx, userID, goalID)
+	ret0, _ := ret[0].([]entities.GoalMilestone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalMilestones indicates an expected call of GetGoalMilestones.
+func (mr *MockGoalServiceMockRecorder) GetGoalMilestones(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalMilestones", reflect.TypeOf((*MockGoalService)(nil).GetGoalMilestones), ctx, userID, goalID)
+}
+
+// GetGoalSuggestion mocks base method.
+func (m *MockGoalService) GetGoalSuggestion(ctx context.Context, userID, locale string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalSuggestion", ctx, userID, locale, req)
+	ret0, _ := ret[0].(*entities.GoalSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalSuggestion indicates an expected call of GetGoalSuggestion.
+func (mr *MockGoalServiceMockRecorder) GetGoalSuggestion(ctx, userID, locale, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalSuggestion", reflect.TypeOf((*MockGoalService)(nil).GetGoalSuggestion), ctx, userID, locale, req)
+}
+
+// GetGoals mocks base method.
+func (m *MockGoalService) GetGoals(ctx context.Context, userID, status string) ([]entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoals", ctx, userID, status)
+	ret0, _ := ret[0].([]entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoals indicates an expected call of GetGoals.
+func (mr *MockGoalServiceMockRecorder) GetGoals(ctx, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoals", reflect.TypeOf((*MockGoalService)(nil).GetGoals), ctx, userID, status)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockGoalService) UpdateGoal(ctx context.Context, userID, goalID string, req *dto.UpdateGoalRequest) (*entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, userID, goalID, req)
+	ret0, _ := ret[0].(*entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockGoalServiceMockRecorder) UpdateGoal(ctx, userID, goalID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockGoalService)(nil).UpdateGoal), ctx, userID, goalID, req)
+}
+
+// MockTransactor is a mock of Transactor interface.
+type MockTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactorMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactorMockRecorder is the mock recorder for MockTransactor.
+type MockTransactorMockRecorder struct {
+	mock *MockTransactor
+}
+
+// NewMockTransactor creates a new mock instance.
+func NewMockTransactor(ctrl *gomock.Controller) *MockTransactor {
+	mock := &MockTransactor{ctrl: ctrl}
+	mock.recorder = &MockTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactor) EXPECT() *MockTransactorMockRecorder {
+	return m.recorder
+}
+
+// WithTransaction mocks base method.
+func (m *MockTransactor) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockTransactorMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactor)(nil).WithTransaction), ctx, fn)
+}
+
+// MockSuggestionStrategy is a mock of SuggestionStrategy interface.
+type MockSuggestionStrategy struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestionStrategyMockRecorder
+	isgomock struct{}
+}
+
+// MockSuggestionStrategyMockRecorder is the mock recorder for MockSuggestionStrategy.
+type MockSuggestionStrategyMockRecorder struct {
+	mock *MockSuggestionStrategy
+}
+
+// NewMockSuggestionStrategy creates a new mock instance.
+func NewMockSuggestionStrategy(ctrl *gomock.Controller) *MockSuggestionStrategy {
+	mock := &MockSuggestionStrategy{ctrl: ctrl}
+	mock.recorder = &MockSuggestionStrategyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggestionStrategy) EXPECT() *MockSuggestionStrategyMockRecorder {
+	return m.recorder
+}
+
+// Suggest mocks base method.
+func (m *MockSuggestionStrategy) Suggest(cashFlow CashFlow, locale string) *entities.GoalSuggestion {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", cashFlow, locale)
+	ret0, _ := ret[0].(*entities.GoalSuggestion)
+	return ret0
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockSuggestionStrategyMockRecorder) Suggest(cashFlow, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockSuggestionStrategy)(nil).Suggest), cashFlow, locale)
+}
@@ -0,0 +1,13 @@
+package goal_domain
+
+// CashFlow holds a user's average income and expenses per day, week and month, in
+// minor units of the currency.
+type CashFlow struct {
+	Currency        string
+	DailyIncome     int64
+	WeeklyIncome    int64
+	MonthlyIncome   int64
+	DailyExpenses   int64
+	WeeklyExpenses  int64
+	MonthlyExpenses int64
+}
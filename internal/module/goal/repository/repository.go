@@ -0,0 +1,32 @@
+package goal_repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+//go:generate mockgen -source=repository.go -destination=repository_mock.go -package=goal_repository
+
+type Repository interface {
+	Create(ctx context.Context, goal *entities.Goal) (*entities.Goal, error)
+	Update(ctx context.Context, goal *entities.Goal) error
+	Delete(ctx context.Context, userID, goalID primitive.ObjectID) error
+	FindById(ctx context.Context, userID, goalID primitive.ObjectID) (*entities.Goal, error)
+	FindByUserId(ctx context.Context, userID primitive.ObjectID, status string) ([]entities.Goal, error)
+	AddContribution(ctx context.Context, goalID, transactionID primitive.ObjectID, amount entities.Money) (*entities.Goal, error)
+	RemoveContributions(ctx context.Context, userID, transactionID primitive.ObjectID) ([]entities.Goal, error)
+	CompleteMilestone(ctx context.Context, goalID primitive.ObjectID, index int, completedAt time.Time, rewardWithheld bool) (bool, error)
+	ReopenMilestone(ctx context.Context, goalID primitive.ObjectID, index int) (bool, error)
+	ClaimMilestoneReward(ctx context.Context, userID primitive.ObjectID, targetPercent int, completedAt time.Time) (bool, error)
+	ReleaseMilestoneReward(ctx context.Context, userID primitive.ObjectID, targetPercent int, completedAt time.Time) error
+}
+
+type GoalStore interface {
+	GetActiveByUserId(ctx context.Context, userID string) ([]entities.Goal, error)
+	SetActiveByUserId(ctx context.Context, userID string, goals []entities.Goal) error
+	DeleteByUserId(ctx context.Context, userID string) error
+}
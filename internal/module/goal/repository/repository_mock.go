@@ -0,0 +1,273 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=repository_mock.go -package=goal_repository
+//
+
+// Package goal_repository is a generated GoMock package.
+package goal_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddContribution mocks base method.
+func (m *MockRepository) AddContribution(ctx context.Context, goalID, transactionID primitive.ObjectID, amount entities.Money) (*entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddContribution", ctx, goalID, transactionID, amount)
+	ret0, _ := ret[0].(*entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddContribution indicates an expected call of AddContribution.
+func (mr *MockRepositoryMockRecorder) AddContribution(ctx, goalID, transactionID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContribution", reflect.TypeOf((*MockRepository)(nil).AddContribution), ctx, goalID, transactionID, amount)
+}
+
+// ClaimMilestoneReward mocks base method.
+func (m *MockRepository) ClaimMilestoneReward(ctx context.Context, userID primitive.ObjectID, targetPercent int, completedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimMilestoneReward", ctx, userID, targetPercent, completedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimMilestoneReward indicates an expected call of ClaimMilestoneReward.
+func (mr *MockRepositoryMockRecorder) ClaimMilestoneReward(ctx, userID, targetPercent, completedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimMilestoneReward", reflect.TypeOf((*MockRepository)(nil).ClaimMilestoneReward), ctx, userID, targetPercent, completedAt)
+}
+
+// CompleteMilestone mocks base method.
+func (m *MockRepository) CompleteMilestone(ctx context.Context, goalID primitive.ObjectID, index int, completedAt time.Time, rewardWithheld bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMilestone", ctx, goalID, index, completedAt, rewardWithheld)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteMilestone indicates an expected call of CompleteMilestone.
+func (mr *MockRepositoryMockRecorder) CompleteMilestone(ctx, goalID, index, completedAt, rewardWithheld any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMilestone", reflect.TypeOf((*MockRepository)(nil).CompleteMilestone), ctx, goalID, index, completedAt, rewardWithheld)
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, goal *entities.Goal) (*entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, goal)
+	ret0, _ := ret[0].(*entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, goal)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, userID, goalID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, goalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, userID, goalID)
+}
+
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, userID, goalID primitive.ObjectID) (*entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, userID, goalID)
+	ret0, _ := ret[0].(*entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, userID, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, userID, goalID)
+}
+
+// FindByUserId mocks base method.
+func (m *MockRepository) FindByUserId(ctx context.Context, userID primitive.ObjectID, status string) ([]entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, userID, status)
+	ret0, _ := ret[0].([]entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockRepositoryMockRecorder) FindByUserId(ctx, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockRepository)(nil).FindByUserId), ctx, userID, status)
+}
+
+// ReleaseMilestoneReward mocks base method.
+func (m *MockRepository) ReleaseMilestoneReward(ctx context.Context, userID primitive.ObjectID, targetPercent int, completedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseMilestoneReward", ctx, userID, targetPercent, completedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseMilestoneReward indicates an expected call of ReleaseMilestoneReward.
+func (mr *MockRepositoryMockRecorder) ReleaseMilestoneReward(ctx, userID, targetPercent, completedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseMilestoneReward", reflect.TypeOf((*MockRepository)(nil).ReleaseMilestoneReward), ctx, userID, targetPercent, completedAt)
+}
+
+// RemoveContributions mocks base method.
+func (m *MockRepository) RemoveContributions(ctx context.Context, userID, transactionID primitive.ObjectID) ([]entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveContributions", ctx, userID, transactionID)
+	ret0, _ := ret[0].([]entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveContributions indicates an expected call of RemoveContributions.
+func (mr *MockRepositoryMockRecorder) RemoveContributions(ctx, userID, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContributions", reflect.TypeOf((*MockRepository)(nil).RemoveContributions), ctx, userID, transactionID)
+}
+
+// ReopenMilestone mocks base method.
+func (m *MockRepository) ReopenMilestone(ctx context.Context, goalID primitive.ObjectID, index int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenMilestone", ctx, goalID, index)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenMilestone indicates an expected call of ReopenMilestone.
+func (mr *MockRepositoryMockRecorder) ReopenMilestone(ctx, goalID, index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenMilestone", reflect.TypeOf((*MockRepository)(nil).ReopenMilestone), ctx, goalID, index)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, goal *entities.Goal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, goal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, goal)
+}
+
+// MockGoalStore is a mock of GoalStore interface.
+type MockGoalStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoalStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockGoalStoreMockRecorder is the mock recorder for MockGoalStore.
+type MockGoalStoreMockRecorder struct {
+	mock *MockGoalStore
+}
+
+// NewMockGoalStore creates a new mock instance.
+func NewMockGoalStore(ctrl *gomock.Controller) *MockGoalStore {
+	mock := &MockGoalStore{ctrl: ctrl}
+	mock.recorder = &MockGoalStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoalStore) EXPECT() *MockGoalStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockGoalStore) DeleteByUserId(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockGoalStoreMockRecorder) DeleteByUserId(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockGoalStore)(nil).DeleteByUserId), ctx, userID)
+}
+
+// GetActiveByUserId mocks base method.
+func (m *MockGoalStore) GetActiveByUserId(ctx context.Context, userID string) ([]entities.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByUserId", ctx, userID)
+	ret0, _ := ret[0].([]entities.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveByUserId indicates an expected call of GetActiveByUserId.
+func (mr *MockGoalStoreMockRecorder) GetActiveByUserId(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByUserId", reflect.TypeOf((*MockGoalStore)(nil).GetActiveByUserId), ctx, userID)
+}
+
+// SetActiveByUserId mocks base method.
+func (m *MockGoalStore) SetActiveByUserId(ctx context.Context, userID string, goals []entities.Goal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActiveByUserId", ctx, userID, goals)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetActiveByUserId indicates an expected call of SetActiveByUserId.
+func (mr *MockGoalStoreMockRecorder) SetActiveByUserId(ctx, userID, goals any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActiveByUserId", reflect.TypeOf((*MockGoalStore)(nil).SetActiveByUserId), ctx, userID, goals)
+}
@@ -0,0 +1,74 @@
+package goal_usecase
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+// allocateIncome splits an income amount across active goals given in funding order.
+// Goals with an allocation percent take their share first and the rest fills the
+// remaining goals by priority. No goal receives more than it still needs, and
+// whatever is left after every goal is funded stays unallocated.
+func allocateIncome(amount int64, goals []entities.Goal) map[primitive.ObjectID]int64 {
+	shares := make(map[primitive.ObjectID]int64, len(goals))
+	remaining := amount
+
+	for _, goal := range goals {
+		if goal.AllocationPercent == 0 {
+			continue
+		}
+		share := min(amount*int64(goal.AllocationPercent)/100, goal.Remaining())
+		if share > 0 {
+			shares[goal.ID] = share
+			remaining -= share
+		}
+	}
+
+	for _, goal := range goals {
+		if goal.AllocationPercent != 0 || remaining == 0 {
+			continue
+		}
+		share := min(remaining, goal.Remaining())
+		if share > 0 {
+			shares[goal.ID] = share
+			remaining -= share
+		}
+	}
+
+	return shares
+}
+
+// allocateExpense splits an expense across active goals given in funding order, the
+// mirror of allocateIncome. Goals with an allocation percent give up their share first
+// and the rest comes out of the remaining goals, the one funded last first. No goal
+// gives up more than it has saved, so savings never go below zero.
+func allocateExpense(amount int64, goals []entities.Goal) map[primitive.ObjectID]int64 {
+	shares := make(map[primitive.ObjectID]int64, len(goals))
+	remaining := amount
+
+	for _, goal := range goals {
+		if goal.AllocationPercent == 0 {
+			continue
+		}
+		share := min(amount*int64(goal.AllocationPercent)/100, goal.CurrentAmount.Amount)
+		if share > 0 {
+			shares[goal.ID] = share
+			remaining -= share
+		}
+	}
+
+	for i := len(goals) - 1; i >= 0; i-- {
+		goal := goals[i]
+		if goal.AllocationPercent != 0 || remaining == 0 {
+			continue
+		}
+		share := min(remaining, goal.CurrentAmount.Amount)
+		if share > 0 {
+			shares[goal.ID] = share
+			remaining -= share
+		}
+	}
+
+	return shares
+}
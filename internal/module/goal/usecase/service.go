@@ -2,30 +2,721 @@ package goal_usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	goal_domain "github.com/Financial-Partner/server/internal/module/goal/domain"
+	goal_repository "github.com/Financial-Partner/server/internal/module/goal/repository"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
+)
+
+const (
+	// suggestionPeriod is the number of days a suggested goal spans.
+	suggestionPeriod = 30
+	// suggestionLookback is the number of days of transaction history used for suggestions.
+	suggestionLookback = 90
+	// suggestionSavingRate is the share of the monthly surplus we suggest putting aside.
+	suggestionSavingRate = 0.5
 )
 
+// defaultMilestones returns the progress track attached to every new goal, with the
+// characters the configuration has completing them unlock.
+func defaultMilestones(characters map[int]string) []entities.GoalMilestone {
+	milestones := []entities.GoalMilestone{
+		{Title: "First steps", TargetPercent: 25, Reward: 10},
+		{Title: "Halfway there", TargetPercent: 50, Reward: 20},
+		{Title: "Almost there", TargetPercent: 75, Reward: 30},
+		{Title: "Goal reached", TargetPercent: 100, Reward: 50},
+	}
+	for i := range milestones {
+		milestones[i].CharacterID = characters[milestones[i].TargetPercent]
+	}
+	return milestones
+}
+
 type Service struct {
+	repo            goal_repository.Repository
+	store           goal_repository.GoalStore
+	transactionRepo transaction_repository.Repository
+	userService     user_domain.UserService
+	transactor      goal_domain.Transactor
+	strategy        goal_domain.SuggestionStrategy
+	cfg             goal_domain.Config
+	log             logger.Logger
 }
 
-func NewService() *Service {
-	return &Service{}
+func NewService(
+	repo goal_repository.Repository,
+	store goal_repository.GoalStore,
+	transactionRepo transaction_repository.Repository,
+	userService user_domain.UserService,
+	transactor goal_domain.Transactor,
+	strategy goal_domain.SuggestionStrategy,
+	cfg goal_domain.Config,
+	log logger.Logger,
+) *Service {
+	return &Service{
+		repo:            repo,
+		store:           store,
+		transactionRepo: transactionRepo,
+		userService:     userService,
+		transactor:      transactor,
+		strategy:        strategy,
+		cfg:             cfg,
+		log:             log,
+	}
 }
 
-func (s *Service) GetGoalSuggestion(ctx context.Context, userID string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error) {
-	return nil, nil
+func (s *Service) GetGoalSuggestion(ctx context.Context, userID, locale string, req *dto.GoalSuggestionRequest) (*entities.GoalSuggestion, error) {
+	currency := entities.DefaultCurrency
+	if req.Currency != "" {
+		money, err := entities.NewMoney(0, req.Currency)
+		if err != nil {
+			return nil, goal_domain.ErrInvalidCurrency
+		}
+		currency = money.Currency
+	}
+
+	cashFlow := goal_domain.CashFlow{
+		Currency:        currency,
+		DailyIncome:     req.DailyIncome,
+		WeeklyIncome:    req.WeeklyIncome,
+		MonthlyIncome:   monthlyAmount(req.MonthlyIncome, req.WeeklyIncome, req.DailyIncome),
+		DailyExpenses:   req.DailyExpenses,
+		WeeklyExpenses:  req.WeeklyExpenses,
+		MonthlyExpenses: monthlyAmount(req.MonthlyExpenses, req.WeeklyExpenses, req.DailyExpenses),
+	}
+
+	return s.strategy.Suggest(cashFlow, locale), nil
 }
 
-func (s *Service) GetAutoGoalSuggestion(ctx context.Context, userID string) (*entities.GoalSuggestion, error) {
-	return nil, nil
+// GetAutoGoalSuggestion suggests a goal from the user's average income and expenses
+// over the transactions of the last suggestionLookback days, in their base currency.
+func (s *Service) GetAutoGoalSuggestion(ctx context.Context, userID, locale string) (*entities.GoalSuggestion, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	now := time.Now().UTC()
+	transactions, err := s.transactionRepo.FindByUserIdBetween(ctx, objectID, now.AddDate(0, 0, -suggestionLookback), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	earliest := now
+	currency := entities.DefaultCurrency
+	if len(transactions) > 0 {
+		currency = transactions[0].BaseAmount.Currency
+	}
+	income := entities.Money{Currency: currency}
+	expenses := entities.Money{Currency: currency}
+	for _, transaction := range transactions {
+		switch strings.ToLower(transaction.Type) {
+		case entities.TransactionTypeIncome:
+			income, err = income.Add(transaction.BaseAmount)
+		case entities.TransactionTypeExpense:
+			expenses, err = expenses.Add(transaction.BaseAmount)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to total transactions: %w", err)
+		}
+		if transaction.Date.Before(earliest) {
+			earliest = transaction.Date
+		}
+	}
+
+	// Average over at least one suggestion period so a short history with a
+	// single salary payment isn't extrapolated into an inflated monthly income.
+	days := int64(now.Sub(earliest).Hours() / 24)
+	days = min(max(days, suggestionPeriod), suggestionLookback)
+
+	return s.strategy.Suggest(averageCashFlow(currency, income.Amount, expenses.Amount, days), locale), nil
 }
 
 func (s *Service) CreateGoal(ctx context.Context, userID string, req *dto.CreateGoalRequest) (*entities.Goal, error) {
-	return nil, nil
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	active, err := s.getActiveGoals(ctx, userID, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetAmount, err := entities.NewMoney(req.TargetAmount.Amount, req.TargetAmount.Currency)
+	if err != nil {
+		return nil, goal_domain.ErrInvalidGoal
+	}
+
+	now := time.Now().UTC()
+	goal := &entities.Goal{
+		UserID:            objectID,
+		Name:              strings.TrimSpace(req.Name),
+		TargetAmount:      targetAmount,
+		CurrentAmount:     entities.Money{Amount: 0, Currency: targetAmount.Currency},
+		Period:            req.Period,
+		Priority:          req.Priority,
+		AllocationPercent: req.AllocationPercent,
+		Status:            entities.GoalStatusActive,
+		Milestones:        defaultMilestones(s.cfg.MilestoneCharacters),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if err := validateGoal(goal, active); err != nil {
+		return nil, err
+	}
+
+	createdGoal, err := s.repo.Create(ctx, goal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+
+	s.deleteGoalsFromStore(ctx, userID)
+
+	return createdGoal, nil
+}
+
+// GetGoals lists the user's goals, optionally only those with the given status.
+func (s *Service) GetGoals(ctx context.Context, userID, status string) ([]entities.Goal, error) {
+	switch status {
+	case "", entities.GoalStatusActive, entities.GoalStatusCompleted, entities.GoalStatusFailed:
+	default:
+		return nil, goal_domain.ErrInvalidGoalStatus
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Loading the active goals first closes elapsed ones, so every goal is
+	// listed under its final status.
+	active, err := s.getActiveGoals(ctx, userID, objectID)
+	if err != nil {
+		return nil, err
+	}
+	if status == entities.GoalStatusActive {
+		return active, nil
+	}
+
+	goals, err := s.repo.FindByUserId(ctx, objectID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	return goals, nil
+}
+
+func (s *Service) GetGoal(ctx context.Context, userID, goalID string) (*entities.Goal, error) {
+	userObjectID, goalObjectID, err := parseGoalIDs(userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	goal, err := s.repo.FindById(ctx, userObjectID, goalObjectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, goal_domain.ErrGoalNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goal: %w", err)
+	}
+
+	closed, err := s.closeIfElapsed(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+	if closed {
+		s.deleteGoalsFromStore(ctx, userID)
+	}
+
+	return goal, nil
+}
+
+func (s *Service) UpdateGoal(ctx context.Context, userID, goalID string, req *dto.UpdateGoalRequest) (*entities.Goal, error) {
+	goal, err := s.GetGoal(ctx, userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+	if goal.Status != entities.GoalStatusActive {
+		return nil, goal_domain.ErrGoalNotActive
+	}
+
+	active, err := s.getActiveGoals(ctx, userID, goal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetAmount, err := entities.NewMoney(req.TargetAmount.Amount, req.TargetAmount.Currency)
+	if err != nil {
+		return nil, goal_domain.ErrInvalidGoal
+	}
+	// The currency can change only while nothing has been saved towards the goal.
+	if goal.CurrentAmount.IsZero() {
+		goal.CurrentAmount.Currency = targetAmount.Currency
+	}
+
+	goal.Name = strings.TrimSpace(req.Name)
+	goal.TargetAmount = targetAmount
+	goal.Period = req.Period
+	goal.Priority = req.Priority
+	goal.AllocationPercent = req.AllocationPercent
+	if err := validateGoal(goal, active); err != nil {
+		return nil, err
+	}
+	if goal.CurrentAmount.Amount >= goal.TargetAmount.Amount {
+		goal.Status = entities.GoalStatusCompleted
+	}
+
+	err = s.repo.Update(ctx, goal)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, goal_domain.ErrGoalNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update goal: %w", err)
+	}
+	s.deleteGoalsFromStore(ctx, userID)
+
+	if err := s.completeMilestones(ctx, userID, goal); err != nil {
+		return nil, err
+	}
+
+	return goal, nil
+}
+
+func (s *Service) DeleteGoal(ctx context.Context, userID, goalID string) error {
+	userObjectID, goalObjectID, err := parseGoalIDs(userID, goalID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.Delete(ctx, userObjectID, goalObjectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return goal_domain.ErrGoalNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	s.deleteGoalsFromStore(ctx, userID)
+
+	return nil
+}
+
+func (s *Service) GetGoalMilestones(ctx context.Context, userID, goalID string) ([]entities.GoalMilestone, error) {
+	goal, err := s.GetGoal(ctx, userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+	return goal.Milestones, nil
+}
+
+// HandleTransactionEvent keeps the progress of the user's goals at their net savings
+// since the goals were created. New income is allocated across the active goals and
+// new expenses are taken out of them; what an updated or deleted transaction had
+// contributed is taken back first, and an update's new amount is allocated again.
+func (s *Service) HandleTransactionEvent(ctx context.Context, event transaction_domain.TransactionEvent) error {
+	transaction := &event.Transaction
+	switch event.Type {
+	case transaction_domain.EventTransactionCreated:
+		return s.allocate(ctx, transaction)
+	case transaction_domain.EventTransactionUpdated:
+		// Edits that don't change the amount, such as a new description, leave the
+		// goals as they are.
+		if event.Previous != nil && allocatable(event.Previous) == allocatable(transaction) {
+			return nil
+		}
+		if err := s.withdraw(ctx, transaction); err != nil {
+			return err
+		}
+		return s.allocate(ctx, transaction)
+	case transaction_domain.EventTransactionDeleted:
+		return s.withdraw(ctx, transaction)
+	}
+	return nil
+}
+
+// allocatable returns the amount of the transaction that is allocated to goals in the
+// base currency: positive for income and negative for expenses.
+func allocatable(transaction *entities.Transaction) entities.Money {
+	if transaction.BaseAmount.Amount <= 0 {
+		return entities.Money{}
+	}
+	switch strings.ToLower(transaction.Type) {
+	case entities.TransactionTypeIncome:
+		return transaction.BaseAmount
+	case entities.TransactionTypeExpense:
+		return entities.Money{Amount: -transaction.BaseAmount.Amount, Currency: transaction.BaseAmount.Currency}
+	}
+	return entities.Money{}
+}
+
+// allocate splits the transaction's income across the user's active goals, or takes
+// its expense out of them. Only goals created by the day of the transaction take part,
+// as a goal counts the savings made since it was created.
+func (s *Service) allocate(ctx context.Context, transaction *entities.Transaction) error {
+	amount := allocatable(transaction)
+	if amount.IsZero() {
+		return nil
+	}
+
+	userID := transaction.UserID.Hex()
+	active, err := s.getActiveGoals(ctx, userID, transaction.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Transactions are allocated in the user's base currency, so they only count
+	// towards goals saved for in that currency.
+	goals := make([]entities.Goal, 0, len(active))
+	for _, goal := range active {
+		created := goal.CreatedAt.UTC().Truncate(24 * time.Hour)
+		if goal.CurrentAmount.Currency == amount.Currency && !transaction.Date.Before(created) {
+			goals = append(goals, goal)
+		}
+	}
+
+	var shares map[primitive.ObjectID]int64
+	if amount.Amount > 0 {
+		shares = allocateIncome(amount.Amount, goals)
+	} else {
+		shares = allocateExpense(-amount.Amount, goals)
+	}
+	if len(shares) == 0 {
+		return nil
+	}
+	defer s.deleteGoalsFromStore(ctx, userID)
+
+	for _, goal := range goals {
+		if shares[goal.ID] == 0 {
+			continue
+		}
+		share := entities.Money{Amount: shares[goal.ID], Currency: amount.Currency}
+		if amount.Amount < 0 {
+			share.Amount = -share.Amount
+		}
+		if err := s.addProgress(ctx, userID, goal.ID, transaction.ID, share); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withdraw takes back what the transaction contributed to the user's goals. Goals and
+// milestones that taking back income leaves unreached are reopened, and active goals
+// that taking back an expense lets reach their target are completed. Expenses
+// themselves never reopen milestones: rewards earned stay earned.
+func (s *Service) withdraw(ctx context.Context, transaction *entities.Transaction) error {
+	goals, err := s.repo.RemoveContributions(ctx, transaction.UserID, transaction.ID)
+	if err != nil {
+		return fmt.Errorf("failed to withdraw goal progress: %w", err)
+	}
+	if len(goals) == 0 {
+		return nil
+	}
+
+	userID := transaction.UserID.Hex()
+	defer s.deleteGoalsFromStore(ctx, userID)
+
+	for i := range goals {
+		if err := s.reopenGoal(ctx, userID, &goals[i]); err != nil {
+			return err
+		}
+		if goals[i].Status != entities.GoalStatusActive {
+			continue
+		}
+		if err := s.completeIfReached(ctx, userID, &goals[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addProgress credits a share of the transaction to a goal, or debits it for an
+// expense, and completes the goal and its milestones once they are reached.
+func (s *Service) addProgress(ctx context.Context, userID string, goalID, transactionID primitive.ObjectID, amount entities.Money) error {
+	goal, err := s.repo.AddContribution(ctx, goalID, transactionID, amount)
+	if err != nil {
+		return fmt.Errorf("failed to update goal progress: %w", err)
+	}
+
+	return s.completeIfReached(ctx, userID, goal)
+}
+
+// completeIfReached completes an active goal that has reached its target and the
+// milestones it has reached.
+func (s *Service) completeIfReached(ctx context.Context, userID string, goal *entities.Goal) error {
+	if goal.CurrentAmount.Amount >= goal.TargetAmount.Amount {
+		goal.Status = entities.GoalStatusCompleted
+		if err := s.repo.Update(ctx, goal); err != nil {
+			return fmt.Errorf("failed to complete goal: %w", err)
+		}
+	}
+
+	return s.completeMilestones(ctx, userID, goal)
+}
+
+// getActiveGoals returns the user's active goals in funding order, closing any
+// whose period has elapsed.
+func (s *Service) getActiveGoals(ctx context.Context, userID string, objectID primitive.ObjectID) ([]entities.Goal, error) {
+	goals, err := s.store.GetActiveByUserId(ctx, userID)
+	cached := err == nil
+	if !cached {
+		goals, err = s.repo.FindByUserId(ctx, objectID, entities.GoalStatusActive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get goals: %w", err)
+		}
+	}
+
+	active := make([]entities.Goal, 0, len(goals))
+	for i := range goals {
+		closed, err := s.closeIfElapsed(ctx, &goals[i])
+		if err != nil {
+			return nil, err
+		}
+		if closed {
+			cached = false
+			continue
+		}
+		active = append(active, goals[i])
+	}
+
+	if !cached {
+		s.setActiveGoalsToStore(ctx, userID, active)
+	}
+
+	return active, nil
+}
+
+// errMilestoneCompleted aborts the completion of a milestone a concurrent update has
+// already completed.
+var errMilestoneCompleted = errors.New("milestone already completed")
+
+// completeMilestones marks every milestone the goal's progress has reached, credits
+// its reward and unlocks its character, if any. Marking and crediting happen in one
+// transaction, and a milestone that was already marked by a concurrent update is
+// skipped, so each reward is paid once.
+//
+// The reward of a milestone is paid once a month whatever the goal, so creating and
+// deleting small goals can't farm diamonds; a milestone whose reward was already
+// earned that month completes with its reward withheld.
+func (s *Service) completeMilestones(ctx context.Context, userID string, goal *entities.Goal) error {
+	progress := goal.ProgressPercent()
+
+	for i := range goal.Milestones {
+		milestone := &goal.Milestones[i]
+		if milestone.IsCompleted || progress < milestone.TargetPercent {
+			continue
+		}
+
+		completedAt := time.Now().UTC()
+		withheld := false
+		err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+			claimed := milestone.Reward > 0
+			if claimed {
+				var err error
+				claimed, err = s.repo.ClaimMilestoneReward(ctx, goal.UserID, milestone.TargetPercent, completedAt)
+				if err != nil {
+					return err
+				}
+			}
+			withheld = milestone.Reward > 0 && !claimed
+
+			completed, err := s.repo.CompleteMilestone(ctx, goal.ID, i, completedAt, withheld)
+			if err != nil {
+				return err
+			}
+			if !completed {
+				return errMilestoneCompleted
+			}
+			if claimed {
+				if _, err := s.userService.UpdateWallet(ctx, userID, milestone.Reward, entities.Money{}); err != nil {
+					return err
+				}
+			}
+			if milestone.CharacterID != "" {
+				return s.userService.UnlockCharacter(ctx, userID, milestone.CharacterID)
+			}
+			return nil
+		})
+		if errors.Is(err, errMilestoneCompleted) {
+			milestone.IsCompleted = true
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to complete milestone %q: %w", milestone.Title, err)
+		}
+
+		milestone.IsCompleted = true
+		milestone.CompletedAt = &completedAt
+		milestone.RewardWithheld = withheld
+	}
+
+	return nil
+}
+
+// reopenGoal moves a completed goal that no longer reaches its target back to active,
+// or to failed if its period is over, and reopens the milestones it no longer reaches.
+func (s *Service) reopenGoal(ctx context.Context, userID string, goal *entities.Goal) error {
+	if goal.Status == entities.GoalStatusCompleted && goal.CurrentAmount.Amount < goal.TargetAmount.Amount {
+		goal.Status = entities.GoalStatusActive
+		closed, err := s.closeIfElapsed(ctx, goal)
+		if err != nil {
+			return err
+		}
+		if !closed {
+			if err := s.repo.Update(ctx, goal); err != nil {
+				return fmt.Errorf("failed to reopen goal: %w", err)
+			}
+		}
+	}
+
+	return s.reopenMilestones(ctx, userID, goal)
+}
+
+// reopenMilestones reopens every completed milestone the goal's progress no longer
+// reaches and takes back its reward, if it was paid, both in one transaction. The
+// reward can then be earned again that month. A milestone whose reward the user has
+// already spent stays completed, so completing it again can't pay twice. Unlocked
+// characters are kept; unlocking them again has no effect.
+func (s *Service) reopenMilestones(ctx context.Context, userID string, goal *entities.Goal) error {
+	progress := goal.ProgressPercent()
+
+	for i := range goal.Milestones {
+		milestone := &goal.Milestones[i]
+		if !milestone.IsCompleted || progress >= milestone.TargetPercent {
+			continue
+		}
+
+		err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+			reopened, err := s.repo.ReopenMilestone(ctx, goal.ID, i)
+			if err != nil || !reopened || milestone.Reward == 0 || milestone.RewardWithheld {
+				return err
+			}
+			if _, err := s.userService.UpdateWallet(ctx, userID, -milestone.Reward, entities.Money{}); err != nil {
+				return err
+			}
+			if milestone.CompletedAt == nil {
+				return nil
+			}
+			return s.repo.ReleaseMilestoneReward(ctx, goal.UserID, milestone.TargetPercent, *milestone.CompletedAt)
+		})
+		if errors.Is(err, user_domain.ErrInsufficientBalance) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to reopen milestone %q: %w", milestone.Title, err)
+		}
+
+		milestone.IsCompleted = false
+		milestone.CompletedAt = nil
+		milestone.RewardWithheld = false
+	}
+
+	return nil
+}
+
+// closeIfElapsed moves an active goal to completed or failed once its period is over
+// and reports whether it did.
+func (s *Service) closeIfElapsed(ctx context.Context, goal *entities.Goal) (bool, error) {
+	if goal.Status != entities.GoalStatusActive || time.Now().Before(goal.Deadline()) {
+		return false, nil
+	}
+
+	if goal.CurrentAmount.Amount >= goal.TargetAmount.Amount {
+		goal.Status = entities.GoalStatusCompleted
+	} else {
+		goal.Status = entities.GoalStatusFailed
+	}
+
+	if err := s.repo.Update(ctx, goal); err != nil {
+		return false, fmt.Errorf("failed to update goal status: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *Service) setActiveGoalsToStore(ctx context.Context, userID string, goals []entities.Goal) {
+	if err := s.store.SetActiveByUserId(ctx, userID, goals); err != nil {
+		s.log.Warnf("Failed to cache goals for userID %s: %v", userID, err)
+	}
+}
+
+func (s *Service) deleteGoalsFromStore(ctx context.Context, userID string) {
+	if err := s.store.DeleteByUserId(ctx, userID); err != nil {
+		s.log.Warnf("Failed to delete cached goals for userID %s: %v", userID, err)
+	}
+}
+
+// validateGoal checks the goal's settings and that the allocation percents of the
+// user's active goals, including this one, add up to at most 100.
+func validateGoal(goal *entities.Goal, active []entities.Goal) error {
+	if goal.TargetAmount.Amount <= 0 || goal.TargetAmount.Currency != goal.CurrentAmount.Currency ||
+		goal.Period <= 0 || goal.Priority < 0 || goal.AllocationPercent < 0 || goal.AllocationPercent > 100 {
+		return goal_domain.ErrInvalidGoal
+	}
+
+	total := goal.AllocationPercent
+	for _, other := range active {
+		if other.ID != goal.ID {
+			total += other.AllocationPercent
+		}
+	}
+	if total > 100 {
+		return goal_domain.ErrAllocationExceeded
+	}
+
+	return nil
+}
+
+// parseGoalIDs parses the owner and goal IDs. A malformed goal ID can't match
+// any goal, so it is reported as not found.
+func parseGoalIDs(userID, goalID string) (primitive.ObjectID, primitive.ObjectID, error) {
+	userObjectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, fmt.Errorf("invalid user ID: %w", err)
+	}
+	goalObjectID, err := primitive.ObjectIDFromHex(goalID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, goal_domain.ErrGoalNotFound
+	}
+	return userObjectID, goalObjectID, nil
+}
+
+// monthlyAmount prefers the monthly figure and falls back to scaling the weekly or daily one.
+func monthlyAmount(monthly, weekly, daily int64) int64 {
+	switch {
+	case monthly != 0:
+		return monthly
+	case weekly != 0:
+		return weekly * suggestionPeriod / 7
+	default:
+		return daily * suggestionPeriod
+	}
 }
 
-func (s *Service) GetGoal(ctx context.Context, userID string) (*entities.Goal, error) {
-	return nil, nil
+// averageCashFlow spreads income and expense totals over the given number of days.
+func averageCashFlow(currency string, income, expenses, days int64) goal_domain.CashFlow {
+	return goal_domain.CashFlow{
+		Currency:        currency,
+		DailyIncome:     income / days,
+		WeeklyIncome:    income * 7 / days,
+		MonthlyIncome:   income * suggestionPeriod / days,
+		DailyExpenses:   expenses / days,
+		WeeklyExpenses:  expenses * 7 / days,
+		MonthlyExpenses: expenses * suggestionPeriod / days,
+	}
 }
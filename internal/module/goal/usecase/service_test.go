@@ -0,0 +1,1480 @@
+package goal_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	goal_domain "github.com/Financial-Partner/server/internal/module/goal/domain"
+	goal_repository "github.com/Financial-Partner/server/internal/module/goal/repository"
+	goal_usecase "github.com/Financial-Partner/server/internal/module/goal/usecase"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
+)
+
+type Mocks struct {
+	ctrl                *gomock.Controller
+	mockRepo            *goal_repository.MockRepository
+	mockStore           *goal_repository.MockGoalStore
+	mockTransactionRepo *transaction_repository.MockRepository
+	mockUserService     *user_domain.MockUserService
+	mockTransactor      *goal_domain.MockTransactor
+}
+
+func NewMocks(t *testing.T) *Mocks {
+	ctrl := gomock.NewController(t)
+
+	return &Mocks{
+		ctrl:                ctrl,
+		mockRepo:            goal_repository.NewMockRepository(ctrl),
+		mockStore:           goal_repository.NewMockGoalStore(ctrl),
+		mockTransactionRepo: transaction_repository.NewMockRepository(ctrl),
+		mockUserService:     user_domain.NewMockUserService(ctrl),
+		mockTransactor:      goal_domain.NewMockTransactor(ctrl),
+	}
+}
+
+func (m *Mocks) newService() *goal_usecase.Service {
+	return goal_usecase.NewService(m.mockRepo, m.mockStore, m.mockTransactionRepo, m.mockUserService, m.mockTransactor, goal_usecase.NewSavingsRateStrategy(), goal_domain.Config{}, logger.NewNopLogger())
+}
+
+// expectTransactions runs each transaction body directly, as a committed transaction would.
+func (m *Mocks) expectTransactions(times int) {
+	m.mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).Times(times).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+}
+
+// activeGoal returns an active goal of the user with no progress and a long period.
+func activeGoal(userID primitive.ObjectID) entities.Goal {
+	return entities.Goal{
+		ID:            primitive.NewObjectID(),
+		UserID:        userID,
+		Name:          "Trip",
+		TargetAmount:  entities.Money{Amount: 10000, Currency: "USD"},
+		CurrentAmount: entities.Money{Amount: 0, Currency: "USD"},
+		Period:        30,
+		Status:        entities.GoalStatusActive,
+		CreatedAt:     time.Now().UTC().AddDate(0, 0, -5),
+	}
+}
+
+func TestGetGoals(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Active goals from store", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goals := []entities.Goal{activeGoal(userID), activeGoal(userID)}
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return(goals, nil)
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), entities.GoalStatusActive)
+		require.NoError(t, err)
+		assert.Equal(t, goals, result)
+	})
+
+	t.Run("Active goals from repository", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goals := []entities.Goal{activeGoal(userID)}
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, entities.GoalStatusActive).Return(goals, nil)
+		mocks.mockStore.EXPECT().SetActiveByUserId(gomock.Any(), userID.Hex(), goals).Return(errors.New("redis error"))
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), entities.GoalStatusActive)
+		require.NoError(t, err)
+		assert.Equal(t, goals, result)
+	})
+
+	t.Run("Closes elapsed goals", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		reached := activeGoal(userID)
+		reached.CurrentAmount = reached.TargetAmount
+		reached.CreatedAt = time.Now().UTC().AddDate(0, -2, 0)
+		missed := activeGoal(userID)
+		missed.CreatedAt = time.Now().UTC().AddDate(0, -2, 0)
+		ongoing := activeGoal(userID)
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{reached, missed, ongoing}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+		mocks.mockStore.EXPECT().SetActiveByUserId(gomock.Any(), userID.Hex(), []entities.Goal{ongoing}).Return(nil)
+
+		closed := []entities.Goal{reached, missed}
+		closed[0].Status = entities.GoalStatusCompleted
+		closed[1].Status = entities.GoalStatusFailed
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, "").Return(append(closed, ongoing), nil)
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), "")
+		require.NoError(t, err)
+		assert.Len(t, result, 3)
+	})
+
+	t.Run("Completed goals", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		completed := activeGoal(userID)
+		completed.Status = entities.GoalStatusCompleted
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{}, nil)
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, entities.GoalStatusCompleted).Return([]entities.Goal{completed}, nil)
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), entities.GoalStatusCompleted)
+		require.NoError(t, err)
+		assert.Equal(t, []entities.Goal{completed}, result)
+	})
+
+	t.Run("Invalid status", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), "archived")
+		assert.ErrorIs(t, err, goal_domain.ErrInvalidGoalStatus)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoals(context.Background(), "invalid", "")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, entities.GoalStatusActive).Return(nil, errors.New("db error"))
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), "")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Close error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		elapsed := activeGoal(userID)
+		elapsed.CreatedAt = time.Now().UTC().AddDate(0, -2, 0)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{elapsed}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), "")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("History repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{}, nil)
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, entities.GoalStatusFailed).Return(nil, errors.New("db error"))
+
+		result, err := service.GetGoals(context.Background(), userID.Hex(), entities.GoalStatusFailed)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetGoal(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+
+		result, err := service.GetGoal(context.Background(), userID.Hex(), goal.ID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, &goal, result)
+	})
+
+	t.Run("Closes elapsed goal", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.CreatedAt = time.Now().UTC().AddDate(0, -2, 0)
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), &goal).Return(nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(errors.New("redis error"))
+
+		result, err := service.GetGoal(context.Background(), userID.Hex(), goal.ID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, entities.GoalStatusFailed, result.Status)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goalID := primitive.NewObjectID()
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goalID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := service.GetGoal(context.Background(), userID.Hex(), goalID.Hex())
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Malformed goal ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoal(context.Background(), userID.Hex(), "invalid")
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoal(context.Background(), "invalid", primitive.NewObjectID().Hex())
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, goal_domain.ErrGoalNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goalID := primitive.NewObjectID()
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goalID).Return(nil, errors.New("db error"))
+
+		result, err := service.GetGoal(context.Background(), userID.Hex(), goalID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Close error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.CreatedAt = time.Now().UTC().AddDate(0, -2, 0)
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), &goal).Return(errors.New("db error"))
+
+		result, err := service.GetGoal(context.Background(), userID.Hex(), goal.ID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCreateGoal(t *testing.T) {
+	userID := primitive.NewObjectID()
+	req := &dto.CreateGoalRequest{Name: " Trip ", TargetAmount: dto.Money{Amount: 10000, Currency: "USD"}, Period: 30, Priority: 2, AllocationPercent: 30}
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		existing := activeGoal(userID)
+		existing.AllocationPercent = 70
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{existing}, nil)
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, goal *entities.Goal) (*entities.Goal, error) {
+				goal.ID = primitive.NewObjectID()
+				return goal, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		result, err := service.CreateGoal(context.Background(), userID.Hex(), req)
+		require.NoError(t, err)
+		assert.Equal(t, userID, result.UserID)
+		assert.Equal(t, "Trip", result.Name)
+		assert.Equal(t, entities.Money{Amount: 10000, Currency: "USD"}, result.TargetAmount)
+		assert.Equal(t, entities.Money{Amount: 0, Currency: "USD"}, result.CurrentAmount)
+		assert.Equal(t, req.Period, result.Period)
+		assert.Equal(t, req.Priority, result.Priority)
+		assert.Equal(t, req.AllocationPercent, result.AllocationPercent)
+		assert.Equal(t, entities.GoalStatusActive, result.Status)
+		assert.Len(t, result.Milestones, 4)
+	})
+
+	t.Run("Milestones unlock the configured characters", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := goal_usecase.NewService(mocks.mockRepo, mocks.mockStore, mocks.mockTransactionRepo, mocks.mockUserService,
+			mocks.mockTransactor, goal_usecase.NewSavingsRateStrategy(),
+			goal_domain.Config{MilestoneCharacters: map[int]string{100: "piggy"}}, logger.NewNopLogger())
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{}, nil)
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, goal *entities.Goal) (*entities.Goal, error) {
+				return goal, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		result, err := service.CreateGoal(context.Background(), userID.Hex(), req)
+		require.NoError(t, err)
+		require.Len(t, result.Milestones, 4)
+		assert.Empty(t, result.Milestones[0].CharacterID)
+		assert.Equal(t, "piggy", result.Milestones[3].CharacterID)
+	})
+
+	t.Run("Invalid request", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{}, nil)
+
+		result, err := service.CreateGoal(context.Background(), userID.Hex(), &dto.CreateGoalRequest{TargetAmount: dto.Money{Amount: 1000, Currency: "USD"}, Period: 30, AllocationPercent: 120})
+		assert.ErrorIs(t, err, goal_domain.ErrInvalidGoal)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{}, nil)
+
+		result, err := service.CreateGoal(context.Background(), userID.Hex(), &dto.CreateGoalRequest{TargetAmount: dto.Money{Amount: 1000, Currency: "dollars"}, Period: 30})
+		assert.ErrorIs(t, err, goal_domain.ErrInvalidGoal)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Allocation exceeded", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		existing := activeGoal(userID)
+		existing.AllocationPercent = 80
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{existing}, nil)
+
+		result, err := service.CreateGoal(context.Background(), userID.Hex(), req)
+		assert.ErrorIs(t, err, goal_domain.ErrAllocationExceeded)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.CreateGoal(context.Background(), "invalid", req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Get goals error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, entities.GoalStatusActive).Return(nil, errors.New("db error"))
+
+		result, err := service.CreateGoal(context.Background(), userID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Create error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{}, nil)
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.CreateGoal(context.Background(), userID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestUpdateGoal(t *testing.T) {
+	userID := primitive.NewObjectID()
+	req := &dto.UpdateGoalRequest{Name: "Emergency fund", TargetAmount: dto.Money{Amount: 20000, Currency: "USD"}, Period: 60, Priority: 1, AllocationPercent: 50}
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.AllocationPercent = 60
+		other := activeGoal(userID)
+		other.AllocationPercent = 50
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal, other}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), &goal).Return(nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "Emergency fund", result.Name)
+		assert.Equal(t, entities.Money{Amount: 20000, Currency: "USD"}, result.TargetAmount)
+		assert.Equal(t, 60, result.Period)
+		assert.Equal(t, 1, result.Priority)
+		assert.Equal(t, 50, result.AllocationPercent)
+		assert.Equal(t, entities.GoalStatusActive, result.Status)
+	})
+
+	t.Run("Lowering the target completes the goal", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.CurrentAmount = entities.Money{Amount: 6000, Currency: "USD"}
+		goal.Milestones = []entities.GoalMilestone{{Title: "Goal reached", TargetPercent: 100, Reward: 50}}
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), &goal).Return(nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 100, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(50), entities.Money{}).Return(&entities.User{}, nil)
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(),
+			&dto.UpdateGoalRequest{Name: "Trip", TargetAmount: dto.Money{Amount: 5000, Currency: "USD"}, Period: 30})
+		require.NoError(t, err)
+		assert.Equal(t, entities.GoalStatusCompleted, result.Status)
+		assert.True(t, result.Milestones[0].IsCompleted)
+	})
+
+	t.Run("Goal not active", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.Status = entities.GoalStatusCompleted
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(), req)
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotActive)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goalID := primitive.NewObjectID()
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goalID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goalID.Hex(), req)
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Allocation exceeded", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		other := activeGoal(userID)
+		other.AllocationPercent = 60
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal, other}, nil)
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(), req)
+		assert.ErrorIs(t, err, goal_domain.ErrAllocationExceeded)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Get goals error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, entities.GoalStatusActive).Return(nil, errors.New("db error"))
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Deleted concurrently", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), &goal).Return(mongo.ErrNoDocuments)
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(), req)
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Update error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), &goal).Return(errors.New("db error"))
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Milestone error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.CurrentAmount = entities.Money{Amount: 6000, Currency: "USD"}
+		goal.Milestones = []entities.GoalMilestone{{Title: "Goal reached", TargetPercent: 100, Reward: 50}}
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), &goal).Return(nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 100, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(false, errors.New("db error"))
+
+		result, err := service.UpdateGoal(context.Background(), userID.Hex(), goal.ID.Hex(),
+			&dto.UpdateGoalRequest{TargetAmount: dto.Money{Amount: 5000, Currency: "USD"}, Period: 30})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestDeleteGoal(t *testing.T) {
+	userID := primitive.NewObjectID()
+	goalID := primitive.NewObjectID()
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().Delete(gomock.Any(), userID, goalID).Return(nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.DeleteGoal(context.Background(), userID.Hex(), goalID.Hex())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().Delete(gomock.Any(), userID, goalID).Return(mongo.ErrNoDocuments)
+
+		err := service.DeleteGoal(context.Background(), userID.Hex(), goalID.Hex())
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotFound)
+	})
+
+	t.Run("Malformed goal ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		err := service.DeleteGoal(context.Background(), userID.Hex(), "invalid")
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotFound)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().Delete(gomock.Any(), userID, goalID).Return(errors.New("db error"))
+
+		err := service.DeleteGoal(context.Background(), userID.Hex(), goalID.Hex())
+		assert.Error(t, err)
+	})
+}
+
+func TestGetGoalSuggestion(t *testing.T) {
+	userID := primitive.NewObjectID().Hex()
+
+	t.Run("Monthly surplus", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoalSuggestion(context.Background(), userID, "en", &dto.GoalSuggestionRequest{
+			MonthlyIncome:   50000,
+			MonthlyExpenses: 30000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 10000, Currency: "USD"}, result.SuggestedAmount)
+		assert.Equal(t, 30, result.Period)
+		assert.Contains(t, result.Message, "100.00 USD")
+	})
+
+	t.Run("Amounts in another currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoalSuggestion(context.Background(), userID, "en", &dto.GoalSuggestionRequest{
+			MonthlyIncome:   50000,
+			MonthlyExpenses: 30000,
+			Currency:        "jpy",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 10000, Currency: "JPY"}, result.SuggestedAmount)
+		assert.Contains(t, result.Message, "10000 JPY")
+	})
+
+	t.Run("Invalid currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoalSuggestion(context.Background(), userID, "en", &dto.GoalSuggestionRequest{
+			MonthlyIncome: 50000,
+			Currency:      "XYZ",
+		})
+		assert.ErrorIs(t, err, goal_domain.ErrInvalidCurrency)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Falls back to weekly and daily figures", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoalSuggestion(context.Background(), userID, "en", &dto.GoalSuggestionRequest{
+			WeeklyIncome:  14000,
+			DailyExpenses: 1000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(15000), result.SuggestedAmount.Amount)
+	})
+
+	t.Run("Expenses exceed income", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetGoalSuggestion(context.Background(), userID, "en", &dto.GoalSuggestionRequest{
+			MonthlyIncome:   1000,
+			MonthlyExpenses: 3000,
+		})
+		require.NoError(t, err)
+		assert.Zero(t, result.SuggestedAmount.Amount)
+	})
+}
+
+func TestGetAutoGoalSuggestion(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		now := time.Now().UTC()
+		mocks.mockTransactionRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return([]entities.Transaction{
+			{Amount: entities.Money{Amount: 46000, Currency: "EUR"}, BaseAmount: entities.Money{Amount: 50000, Currency: "USD"}, Type: entities.TransactionTypeIncome, Date: now.AddDate(0, 0, -3)},
+			{Amount: entities.Money{Amount: 20000, Currency: "USD"}, BaseAmount: entities.Money{Amount: 20000, Currency: "USD"}, Type: "Expense", Date: now.AddDate(0, 0, -2)},
+		}, nil)
+
+		result, err := service.GetAutoGoalSuggestion(context.Background(), userID.Hex(), "en")
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 15000, Currency: "USD"}, result.SuggestedAmount)
+	})
+
+	t.Run("Averages over recorded history", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		strategy := goal_domain.NewMockSuggestionStrategy(mocks.ctrl)
+		service := goal_usecase.NewService(mocks.mockRepo, mocks.mockStore, mocks.mockTransactionRepo,
+			mocks.mockUserService, mocks.mockTransactor, strategy, goal_domain.Config{}, logger.NewNopLogger())
+
+		now := time.Now().UTC()
+		mocks.mockTransactionRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return([]entities.Transaction{
+			{Amount: entities.Money{Amount: 60000, Currency: "USD"}, BaseAmount: entities.Money{Amount: 60000, Currency: "USD"}, Type: entities.TransactionTypeIncome, Date: now.AddDate(0, 0, -60)},
+			{Amount: entities.Money{Amount: 30000, Currency: "USD"}, BaseAmount: entities.Money{Amount: 30000, Currency: "USD"}, Type: entities.TransactionTypeExpense, Date: now.AddDate(0, 0, -10)},
+		}, nil)
+		expected := &entities.GoalSuggestion{SuggestedAmount: entities.Money{Amount: 7500, Currency: "USD"}, Period: 30}
+		strategy.EXPECT().Suggest(goal_domain.CashFlow{
+			Currency:        "USD",
+			DailyIncome:     1000,
+			WeeklyIncome:    7000,
+			MonthlyIncome:   30000,
+			DailyExpenses:   500,
+			WeeklyExpenses:  3500,
+			MonthlyExpenses: 15000,
+		}, "zh-TW").Return(expected)
+
+		result, err := service.GetAutoGoalSuggestion(context.Background(), userID.Hex(), "zh-TW")
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("No history", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		result, err := service.GetAutoGoalSuggestion(context.Background(), userID.Hex(), "en")
+		require.NoError(t, err)
+		assert.Zero(t, result.SuggestedAmount.Amount)
+		assert.NotEmpty(t, result.Message)
+	})
+
+	t.Run("Mixed base currencies", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		now := time.Now().UTC()
+		mocks.mockTransactionRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return([]entities.Transaction{
+			{BaseAmount: entities.Money{Amount: 50000, Currency: "USD"}, Type: entities.TransactionTypeIncome, Date: now.AddDate(0, 0, -3)},
+			{BaseAmount: entities.Money{Amount: 46000, Currency: "EUR"}, Type: entities.TransactionTypeIncome, Date: now.AddDate(0, 0, -2)},
+		}, nil)
+
+		result, err := service.GetAutoGoalSuggestion(context.Background(), userID.Hex(), "en")
+		assert.ErrorIs(t, err, entities.ErrCurrencyMismatch)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.GetAutoGoalSuggestion(context.Background(), userID.Hex(), "en")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		result, err := service.GetAutoGoalSuggestion(context.Background(), "invalid", "en")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestHandleTransactionEvent(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	incomeEvent := func(amount int64) transaction_domain.TransactionEvent {
+		return transaction_domain.TransactionEvent{
+			Type: transaction_domain.EventTransactionCreated,
+			Transaction: entities.Transaction{
+				ID:         primitive.NewObjectID(),
+				UserID:     userID,
+				Amount:     entities.Money{Amount: amount, Currency: "USD"},
+				BaseAmount: entities.Money{Amount: amount, Currency: "USD"},
+				Type:       entities.TransactionTypeIncome,
+				Date:       time.Now().UTC().Truncate(24 * time.Hour),
+			},
+		}
+	}
+
+	// expectAddAmount returns the goal with the amount added, as the repository would.
+	expectAddAmount := func(mocks *Mocks, goal entities.Goal, amount int64) {
+		mocks.mockRepo.EXPECT().AddContribution(gomock.Any(), goal.ID, gomock.Any(), entities.Money{Amount: amount, Currency: "USD"}).DoAndReturn(
+			func(_ context.Context, _, _ primitive.ObjectID, amount entities.Money) (*entities.Goal, error) {
+				goal.CurrentAmount.Amount += amount.Amount
+				return &goal, nil
+			},
+		)
+	}
+
+	t.Run("Allocates by percent and then by priority", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		// Funding order: first, second, percent. The percent goal takes 20% first,
+		// then first is filled up and second receives the rest.
+		first := activeGoal(userID)
+		first.TargetAmount = entities.Money{Amount: 3000, Currency: "USD"}
+		first.CurrentAmount = entities.Money{Amount: 2000, Currency: "USD"}
+		second := activeGoal(userID)
+		second.Priority = 1
+		percent := activeGoal(userID)
+		percent.Priority = 2
+		percent.AllocationPercent = 20
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{first, second, percent}, nil)
+		expectAddAmount(mocks, first, 1000)
+		expectAddAmount(mocks, second, 3000)
+		expectAddAmount(mocks, percent, 1000)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, goal *entities.Goal) error {
+				assert.Equal(t, first.ID, goal.ID)
+				assert.Equal(t, entities.GoalStatusCompleted, goal.Status)
+				return nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), incomeEvent(5000))
+		require.NoError(t, err)
+	})
+
+	t.Run("Leaves income unallocated once goals are funded", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.TargetAmount = entities.Money{Amount: 1000, Currency: "USD"}
+		goal.AllocationPercent = 50
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+		expectAddAmount(mocks, goal, 1000)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), incomeEvent(5000))
+		require.NoError(t, err)
+	})
+
+	t.Run("Allocates in the base currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		// Income in euros funds the goal saved for in the base currency, not the one
+		// saved for in euros.
+		euro := activeGoal(userID)
+		euro.TargetAmount = entities.Money{Amount: 10000, Currency: "EUR"}
+		euro.CurrentAmount = entities.Money{Amount: 0, Currency: "EUR"}
+		goal := activeGoal(userID)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{euro, goal}, nil)
+		expectAddAmount(mocks, goal, 5000)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		event := incomeEvent(5000)
+		event.Transaction.Amount = entities.Money{Amount: 4600, Currency: "EUR"}
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("Takes expenses out of savings", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		// Withdrawal order: percent, then second before first. The percent goal gives
+		// up 20% of the expense, second all it has and first the rest.
+		first := activeGoal(userID)
+		first.CurrentAmount = entities.Money{Amount: 5000, Currency: "USD"}
+		second := activeGoal(userID)
+		second.Priority = 1
+		second.CurrentAmount = entities.Money{Amount: 2000, Currency: "USD"}
+		percent := activeGoal(userID)
+		percent.Priority = 2
+		percent.AllocationPercent = 20
+		percent.CurrentAmount = entities.Money{Amount: 3000, Currency: "USD"}
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{first, second, percent}, nil)
+		expectAddAmount(mocks, first, -2000)
+		expectAddAmount(mocks, second, -2000)
+		expectAddAmount(mocks, percent, -1000)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		event := incomeEvent(5000)
+		event.Transaction.Type = entities.TransactionTypeExpense
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("Leaves savings at zero", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.CurrentAmount = entities.Money{Amount: 1000, Currency: "USD"}
+		empty := activeGoal(userID)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal, empty}, nil)
+		expectAddAmount(mocks, goal, -1000)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		event := incomeEvent(5000)
+		event.Transaction.Type = entities.TransactionTypeExpense
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("Skips goals created after the transaction", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+
+		event := incomeEvent(5000)
+		event.Transaction.Date = goal.CreatedAt.AddDate(0, 0, -1)
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("Ignores transactions of other types", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		event := incomeEvent(5000)
+		event.Transaction.Type = "transfer"
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("No active goals", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{}, nil)
+
+		err := service.HandleTransactionEvent(context.Background(), incomeEvent(5000))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Get goals error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindByUserId(gomock.Any(), userID, entities.GoalStatusActive).Return(nil, errors.New("db error"))
+
+		err := service.HandleTransactionEvent(context.Background(), incomeEvent(5000))
+		assert.Error(t, err)
+	})
+
+	t.Run("Add amount error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().AddContribution(gomock.Any(), goal.ID, gomock.Any(), entities.Money{Amount: 5000, Currency: "USD"}).Return(nil, errors.New("db error"))
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), incomeEvent(5000))
+		assert.Error(t, err)
+	})
+
+	t.Run("Complete goal error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{goal}, nil)
+		expectAddAmount(mocks, goal, 10000)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), incomeEvent(20000))
+		assert.Error(t, err)
+	})
+}
+
+func TestGetGoalMilestones(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Success", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.Milestones = []entities.GoalMilestone{{Title: "First steps", TargetPercent: 25, Reward: 10}}
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goal.ID).Return(&goal, nil)
+
+		result, err := service.GetGoalMilestones(context.Background(), userID.Hex(), goal.ID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, goal.Milestones, result)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goalID := primitive.NewObjectID()
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, goalID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := service.GetGoalMilestones(context.Background(), userID.Hex(), goalID.Hex())
+		assert.ErrorIs(t, err, goal_domain.ErrGoalNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestWithdrawGoalProgress(t *testing.T) {
+	userID := primitive.NewObjectID()
+	income := entities.Transaction{
+		ID:         primitive.NewObjectID(),
+		UserID:     userID,
+		Amount:     entities.Money{Amount: 6000, Currency: "USD"},
+		BaseAmount: entities.Money{Amount: 6000, Currency: "USD"},
+		Type:       entities.TransactionTypeIncome,
+		Date:       time.Now().UTC().Truncate(24 * time.Hour),
+	}
+	deleted := transaction_domain.TransactionEvent{Type: transaction_domain.EventTransactionDeleted, Transaction: income}
+	completedAt := time.Now().UTC().AddDate(0, 0, -1)
+
+	// withdrawnGoal returns a goal completed with the income's help, as it is once the
+	// income's contribution of 6000 is taken back.
+	withdrawnGoal := func() entities.Goal {
+		goal := activeGoal(userID)
+		goal.Status = entities.GoalStatusCompleted
+		goal.CurrentAmount = entities.Money{Amount: 4000, Currency: "USD"}
+		goal.Milestones = []entities.GoalMilestone{
+			{Title: "First steps", TargetPercent: 25, Reward: 10, IsCompleted: true, CompletedAt: &completedAt},
+			{Title: "Halfway there", TargetPercent: 50, Reward: 20, IsCompleted: true, CompletedAt: &completedAt},
+			{Title: "Almost there", TargetPercent: 75, IsCompleted: true, CompletedAt: &completedAt},
+		}
+		return goal
+	}
+
+	t.Run("Reopens the goal and takes back milestone rewards", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := withdrawnGoal()
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, goal *entities.Goal) error {
+				assert.Equal(t, entities.GoalStatusActive, goal.Status)
+				return nil
+			},
+		)
+		mocks.expectTransactions(2)
+		mocks.mockRepo.EXPECT().ReopenMilestone(gomock.Any(), goal.ID, 1).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-20), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().ReleaseMilestoneReward(gomock.Any(), userID, 50, completedAt).Return(nil)
+		mocks.mockRepo.EXPECT().ReopenMilestone(gomock.Any(), goal.ID, 2).Return(true, nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fails a reopened goal whose period is over", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := withdrawnGoal()
+		goal.CreatedAt = time.Now().UTC().AddDate(0, 0, -60)
+		goal.Milestones = nil
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, goal *entities.Goal) error {
+				assert.Equal(t, entities.GoalStatusFailed, goal.Status)
+				return nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		require.NoError(t, err)
+	})
+
+	t.Run("Keeps a milestone whose reward was spent", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := withdrawnGoal()
+		goal.Status = entities.GoalStatusActive
+		goal.Milestones = goal.Milestones[:2]
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{goal}, nil)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ReopenMilestone(gomock.Any(), goal.ID, 1).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(-20), entities.Money{}).Return(nil, user_domain.ErrInsufficientBalance)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		require.NoError(t, err)
+	})
+
+	t.Run("Keeps a withheld reward", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := withdrawnGoal()
+		goal.Status = entities.GoalStatusActive
+		goal.Milestones = goal.Milestones[:2]
+		goal.Milestones[1].RewardWithheld = true
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{goal}, nil)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ReopenMilestone(gomock.Any(), goal.ID, 1).Return(true, nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		require.NoError(t, err)
+	})
+
+	t.Run("Reopen milestone error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := withdrawnGoal()
+		goal.Status = entities.GoalStatusActive
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{goal}, nil)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ReopenMilestone(gomock.Any(), goal.ID, 1).Return(false, errors.New("db error"))
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		assert.Error(t, err)
+	})
+
+	t.Run("Reopen goal error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := withdrawnGoal()
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		assert.Error(t, err)
+	})
+
+	t.Run("Completes a goal an expense no longer holds back", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := activeGoal(userID)
+		goal.CurrentAmount = entities.Money{Amount: 10000, Currency: "USD"}
+		expense := deleted
+		expense.Transaction.Type = entities.TransactionTypeExpense
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{goal}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, goal *entities.Goal) error {
+				assert.Equal(t, entities.GoalStatusCompleted, goal.Status)
+				return nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), expense)
+		require.NoError(t, err)
+	})
+
+	t.Run("Nothing contributed", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return(nil, nil)
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		require.NoError(t, err)
+	})
+
+	t.Run("Remove contributions error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return(nil, errors.New("db error"))
+
+		err := service.HandleTransactionEvent(context.Background(), deleted)
+		assert.Error(t, err)
+	})
+
+	t.Run("Reallocates an updated amount", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		previous := income
+		updated := income
+		updated.Amount = entities.Money{Amount: 2000, Currency: "USD"}
+		updated.BaseAmount = entities.Money{Amount: 2000, Currency: "USD"}
+		event := transaction_domain.TransactionEvent{Type: transaction_domain.EventTransactionUpdated, Transaction: updated, Previous: &previous}
+
+		goal := activeGoal(userID)
+		goal.CurrentAmount = entities.Money{Amount: 6000, Currency: "USD"}
+		withdrawn := goal
+		withdrawn.CurrentAmount = entities.Money{Amount: 0, Currency: "USD"}
+		mocks.mockRepo.EXPECT().RemoveContributions(gomock.Any(), userID, income.ID).Return([]entities.Goal{withdrawn}, nil)
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{withdrawn}, nil)
+		mocks.mockRepo.EXPECT().AddContribution(gomock.Any(), goal.ID, income.ID, entities.Money{Amount: 2000, Currency: "USD"}).Return(&withdrawn, nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil).Times(2)
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("Ignores updates that keep the income", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		previous := income
+		previous.Description = "Salary"
+		event := transaction_domain.TransactionEvent{Type: transaction_domain.EventTransactionUpdated, Transaction: income, Previous: &previous}
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+}
+
+func TestCompleteMilestones(t *testing.T) {
+	userID := primitive.NewObjectID()
+	event := transaction_domain.TransactionEvent{
+		Type: transaction_domain.EventTransactionCreated,
+		Transaction: entities.Transaction{
+			ID:         primitive.NewObjectID(),
+			UserID:     userID,
+			Amount:     entities.Money{Amount: 3000, Currency: "USD"},
+			BaseAmount: entities.Money{Amount: 3000, Currency: "USD"},
+			Type:       entities.TransactionTypeIncome,
+			Date:       time.Now().UTC().Truncate(24 * time.Hour),
+		},
+	}
+
+	newGoal := func() entities.Goal {
+		goal := activeGoal(userID)
+		goal.Milestones = []entities.GoalMilestone{
+			{Title: "First steps", TargetPercent: 25, Reward: 10},
+			{Title: "Halfway there", TargetPercent: 50, Reward: 20},
+			{Title: "Almost there", TargetPercent: 75, Reward: 30},
+		}
+		return goal
+	}
+
+	// expectProgress makes the goal's current amount reach the given value after the event.
+	expectProgress := func(mocks *Mocks, goal *entities.Goal, current int64) {
+		mocks.mockStore.EXPECT().GetActiveByUserId(gomock.Any(), userID.Hex()).Return([]entities.Goal{*goal}, nil)
+		mocks.mockRepo.EXPECT().AddContribution(gomock.Any(), goal.ID, event.Transaction.ID, entities.Money{Amount: 3000, Currency: "USD"}).DoAndReturn(
+			func(context.Context, primitive.ObjectID, primitive.ObjectID, entities.Money) (*entities.Goal, error) {
+				goal.CurrentAmount = entities.Money{Amount: current, Currency: "USD"}
+				return goal, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+	}
+
+	t.Run("Credits reached milestones", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		expectProgress(mocks, &goal, 5000)
+		mocks.expectTransactions(2)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(true, nil)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 50, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 1, gomock.Any(), false).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(10), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(20), entities.Money{}).Return(&entities.User{}, nil)
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+		assert.True(t, goal.Milestones[0].IsCompleted)
+		assert.NotNil(t, goal.Milestones[0].CompletedAt)
+		assert.True(t, goal.Milestones[1].IsCompleted)
+		assert.False(t, goal.Milestones[2].IsCompleted)
+	})
+
+	t.Run("Skips completed milestones", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		goal.Milestones[0].IsCompleted = true
+		expectProgress(mocks, &goal, 3000)
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+	})
+
+	t.Run("Milestone completed concurrently", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		expectProgress(mocks, &goal, 3000)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(false, nil)
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+		assert.True(t, goal.Milestones[0].IsCompleted)
+	})
+
+	t.Run("Withholds a reward already earned this month", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		expectProgress(mocks, &goal, 3000)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(false, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), true).Return(true, nil)
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+		assert.True(t, goal.Milestones[0].IsCompleted)
+		assert.True(t, goal.Milestones[0].RewardWithheld)
+	})
+
+	t.Run("Claim reward error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		expectProgress(mocks, &goal, 3000)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(false, errors.New("db error"))
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		assert.Error(t, err)
+		assert.False(t, goal.Milestones[0].IsCompleted)
+	})
+
+	t.Run("Reward error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		expectProgress(mocks, &goal, 3000)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(10), entities.Money{}).Return(nil, errors.New("db error"))
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		assert.Error(t, err)
+		assert.False(t, goal.Milestones[0].IsCompleted)
+	})
+
+	t.Run("Unlocks the milestone's character", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		goal.Milestones[0].CharacterID = "char_001"
+		expectProgress(mocks, &goal, 3000)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(10), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockUserService.EXPECT().UnlockCharacter(gomock.Any(), userID.Hex(), "char_001").Return(nil)
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		require.NoError(t, err)
+		assert.True(t, goal.Milestones[0].IsCompleted)
+	})
+
+	t.Run("Unlock error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		goal.Milestones[0].CharacterID = "char_001"
+		expectProgress(mocks, &goal, 3000)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(10), entities.Money{}).Return(&entities.User{}, nil)
+		mocks.mockUserService.EXPECT().UnlockCharacter(gomock.Any(), userID.Hex(), "char_001").Return(errors.New("db error"))
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		assert.Error(t, err)
+		assert.False(t, goal.Milestones[0].IsCompleted)
+	})
+
+	t.Run("Complete milestone error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		defer mocks.ctrl.Finish()
+		service := mocks.newService()
+
+		goal := newGoal()
+		expectProgress(mocks, &goal, 3000)
+		mocks.expectTransactions(1)
+		mocks.mockRepo.EXPECT().ClaimMilestoneReward(gomock.Any(), userID, 25, gomock.Any()).Return(true, nil)
+		mocks.mockRepo.EXPECT().CompleteMilestone(gomock.Any(), goal.ID, 0, gomock.Any(), false).Return(false, errors.New("db error"))
+
+		err := service.HandleTransactionEvent(context.Background(), event)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,79 @@
+package goal_usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	goal_domain "github.com/Financial-Partner/server/internal/module/goal/domain"
+)
+
+const defaultLocale = "en"
+
+type suggestionMessages struct {
+	save      string
+	deficit   string
+	noHistory string
+}
+
+// suggestionCatalog is keyed by primary language subtag.
+var suggestionCatalog = map[string]suggestionMessages{
+	"en": {
+		save:      "Based on your income and expense analysis, we recommend that you can save %s per month.",
+		deficit:   "Your expenses currently exceed your income. Try cutting back on spending before setting a saving goal.",
+		noHistory: "There is not enough income and expense history yet. Keep recording your transactions and check back for a suggestion.",
+	},
+	"zh": {
+		save:      "根據您的收支分析，建議您每月可以存下 %s。",
+		deficit:   "您目前的支出高於收入，建議先減少開銷再設定存錢目標。",
+		noHistory: "目前還沒有足夠的收支紀錄，持續記帳一段時間後再來看看建議吧！",
+	},
+}
+
+// SavingsRateStrategy suggests putting aside a fixed share of the monthly surplus.
+type SavingsRateStrategy struct {
+	rate   float64
+	period int
+}
+
+func NewSavingsRateStrategy() *SavingsRateStrategy {
+	return &SavingsRateStrategy{
+		rate:   suggestionSavingRate,
+		period: suggestionPeriod,
+	}
+}
+
+func (s *SavingsRateStrategy) Suggest(cashFlow goal_domain.CashFlow, locale string) *entities.GoalSuggestion {
+	messages := suggestionCatalog[resolveLocale(locale)]
+	suggestion := &entities.GoalSuggestion{
+		SuggestedAmount: entities.Money{Currency: cashFlow.Currency},
+		Period:          s.period,
+	}
+
+	surplus := cashFlow.MonthlyIncome - cashFlow.MonthlyExpenses
+	switch {
+	case cashFlow.MonthlyIncome == 0 && cashFlow.MonthlyExpenses == 0:
+		suggestion.Message = messages.noHistory
+	case surplus <= 0:
+		suggestion.Message = messages.deficit
+	default:
+		suggestion.SuggestedAmount.Amount = int64(float64(surplus) * s.rate)
+		suggestion.Message = fmt.Sprintf(messages.save, suggestion.SuggestedAmount)
+	}
+
+	return suggestion
+}
+
+// resolveLocale returns the first language in an Accept-Language style list that
+// has a message catalog, falling back to English.
+func resolveLocale(locale string) string {
+	for _, tag := range strings.Split(locale, ",") {
+		tag, _, _ = strings.Cut(tag, ";")
+		language, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+		language = strings.ToLower(language)
+		if _, ok := suggestionCatalog[language]; ok {
+			return language
+		}
+	}
+	return defaultLocale
+}
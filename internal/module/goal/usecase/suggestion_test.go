@@ -0,0 +1,49 @@
+package goal_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	goal_domain "github.com/Financial-Partner/server/internal/module/goal/domain"
+	goal_usecase "github.com/Financial-Partner/server/internal/module/goal/usecase"
+)
+
+func TestSavingsRateStrategy(t *testing.T) {
+	strategy := goal_usecase.NewSavingsRateStrategy()
+
+	t.Run("Suggests half of the monthly surplus", func(t *testing.T) {
+		result := strategy.Suggest(goal_domain.CashFlow{Currency: "TWD", MonthlyIncome: 50000, MonthlyExpenses: 30000}, "en")
+		assert.Equal(t, entities.Money{Amount: 10000, Currency: "TWD"}, result.SuggestedAmount)
+		assert.Equal(t, 30, result.Period)
+		assert.Equal(t, "Based on your income and expense analysis, we recommend that you can save 100.00 TWD per month.", result.Message)
+	})
+
+	t.Run("Expenses exceed income", func(t *testing.T) {
+		result := strategy.Suggest(goal_domain.CashFlow{MonthlyIncome: 1000, MonthlyExpenses: 3000}, "en")
+		assert.Zero(t, result.SuggestedAmount.Amount)
+		assert.Contains(t, result.Message, "exceed your income")
+	})
+
+	t.Run("No history", func(t *testing.T) {
+		result := strategy.Suggest(goal_domain.CashFlow{}, "en")
+		assert.Zero(t, result.SuggestedAmount.Amount)
+		assert.Contains(t, result.Message, "not enough")
+	})
+
+	t.Run("Localized message", func(t *testing.T) {
+		result := strategy.Suggest(goal_domain.CashFlow{Currency: "TWD", MonthlyIncome: 50000, MonthlyExpenses: 30000}, "zh-TW")
+		assert.Equal(t, "根據您的收支分析，建議您每月可以存下 100.00 TWD。", result.Message)
+	})
+
+	t.Run("Picks the first supported language", func(t *testing.T) {
+		result := strategy.Suggest(goal_domain.CashFlow{MonthlyIncome: 1000, MonthlyExpenses: 3000}, "fr-FR;q=0.9, zh-Hant-TW;q=0.8, en;q=0.7")
+		assert.Equal(t, "您目前的支出高於收入，建議先減少開銷再設定存錢目標。", result.Message)
+	})
+
+	t.Run("Falls back to English", func(t *testing.T) {
+		result := strategy.Suggest(goal_domain.CashFlow{MonthlyIncome: 1000, MonthlyExpenses: 3000}, "fr-FR")
+		assert.Contains(t, result.Message, "exceed your income")
+	})
+}
@@ -0,0 +1,13 @@
+package investment_domain
+
+import "errors"
+
+var (
+	ErrInvalidOpportunityID = errors.New("invalid opportunity ID")
+	ErrOpportunityNotFound  = errors.New("investment opportunity not found")
+	ErrAmountBelowMinimum   = errors.New("investment amount is below the opportunity's minimum")
+	ErrInsufficientSavings  = errors.New("not enough savings to invest")
+	ErrCurrencyMismatch     = errors.New("investment must be made in the opportunity's currency")
+	ErrSavingsCurrency      = errors.New("opportunity is in another currency than the user's savings")
+	ErrInvalidOpportunity   = errors.New("opportunity duration days must be positive and its variation and minimum amount must not be negative")
+)
@@ -2,6 +2,7 @@ package investment_domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
@@ -13,5 +14,23 @@ type InvestmentService interface {
 	GetOpportunities(ctx context.Context, userID string) ([]entities.Opportunity, error)
 	CreateUserInvestment(ctx context.Context, userID string, req *dto.CreateUserInvestmentRequest) (*entities.Investment, error)
 	GetUserInvestments(ctx context.Context, userID string) ([]entities.Investment, error)
-	CreateOpportunity(ctx context.Context, userID string, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error)
+	CreateOpportunity(ctx context.Context, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error)
+	GetPortfolio(ctx context.Context, userID string) (*entities.Portfolio, error)
+}
+
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// TransactionRecorder records the transactions investments make on a user's behalf,
+// such as payouts, in the user's base currency. TransactionRecorded announces a
+// recorded transaction once the database transaction it was recorded in commits.
+type TransactionRecorder interface {
+	RecordTransaction(ctx context.Context, transaction *entities.Transaction) (*entities.Transaction, error)
+	TransactionRecorded(ctx context.Context, transaction *entities.Transaction)
+}
+
+// PriceFeed reports how an opportunity's market price moved between two days.
+type PriceFeed interface {
+	PriceChange(ctx context.Context, opportunity *entities.Opportunity, from, to time.Time) (float64, error)
 }
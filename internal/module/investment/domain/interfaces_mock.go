@@ -12,6 +12,7 @@ package investment_domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/Financial-Partner/server/internal/entities"
 	dto "github.com/Financial-Partner/server/internal/interfaces/http/dto"
@@ -42,6 +43,21 @@ func (m *MockInvestmentService) EXPECT() *MockInvestmentServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateOpportunity mocks base method.
+func (m *MockInvestmentService) CreateOpportunity(ctx context.Context, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOpportunity", ctx, req)
+	ret0, _ := ret[0].(*entities.Opportunity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOpportunity indicates an expected call of CreateOpportunity.
+func (mr *MockInvestmentServiceMockRecorder) CreateOpportunity(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpportunity", reflect.TypeOf((*MockInvestmentService)(nil).CreateOpportunity), ctx, req)
+}
+
 // CreateUserInvestment mocks base method.
 func (m *MockInvestmentService) CreateUserInvestment(ctx context.Context, userID string, req *dto.CreateUserInvestmentRequest) (*entities.Investment, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +88,21 @@ func (mr *MockInvestmentServiceMockRecorder) GetOpportunities(ctx, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpportunities", reflect.TypeOf((*MockInvestmentService)(nil).GetOpportunities), ctx, userID)
 }
 
+// GetPortfolio mocks base method.
+func (m *MockInvestmentService) GetPortfolio(ctx context.Context, userID string) (*entities.Portfolio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPortfolio", ctx, userID)
+	ret0, _ := ret[0].(*entities.Portfolio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPortfolio indicates an expected call of GetPortfolio.
+func (mr *MockInvestmentServiceMockRecorder) GetPortfolio(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortfolio", reflect.TypeOf((*MockInvestmentService)(nil).GetPortfolio), ctx, userID)
+}
+
 // GetUserInvestments mocks base method.
 func (m *MockInvestmentService) GetUserInvestments(ctx context.Context, userID string) ([]entities.Investment, error) {
 	m.ctrl.T.Helper()
@@ -86,3 +117,131 @@ func (mr *MockInvestmentServiceMockRecorder) GetUserInvestments(ctx, userID any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInvestments", reflect.TypeOf((*MockInvestmentService)(nil).GetUserInvestments), ctx, userID)
 }
+
+// MockTransactor is a mock of Transactor interface.
+type MockTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactorMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactorMockRecorder is the mock recorder for MockTransactor.
+type MockTransactorMockRecorder struct {
+	mock *MockTransactor
+}
+
+// NewMockTransactor creates a new mock instance.
+func NewMockTransactor(ctrl *gomock.Controller) *MockTransactor {
+	mock := &MockTransactor{ctrl: ctrl}
+	mock.recorder = &MockTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactor) EXPECT() *MockTransactorMockRecorder {
+	return m.recorder
+}
+
+// WithTransaction mocks base method.
+func (m *MockTransactor) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockTransactorMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactor)(nil).WithTransaction), ctx, fn)
+}
+
+// MockTransactionRecorder is a mock of TransactionRecorder interface.
+type MockTransactionRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionRecorderMockRecorder is the mock recorder for MockTransactionRecorder.
+type MockTransactionRecorderMockRecorder struct {
+	mock *MockTransactionRecorder
+}
+
+// NewMockTransactionRecorder creates a new mock instance.
+func NewMockTransactionRecorder(ctrl *gomock.Controller) *MockTransactionRecorder {
+	mock := &MockTransactionRecorder{ctrl: ctrl}
+	mock.recorder = &MockTransactionRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionRecorder) EXPECT() *MockTransactionRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordTransaction mocks base method.
+func (m *MockTransactionRecorder) RecordTransaction(ctx context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordTransaction", ctx, transaction)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordTransaction indicates an expected call of RecordTransaction.
+func (mr *MockTransactionRecorderMockRecorder) RecordTransaction(ctx, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTransaction", reflect.TypeOf((*MockTransactionRecorder)(nil).RecordTransaction), ctx, transaction)
+}
+
+// TransactionRecorded mocks base method.
+func (m *MockTransactionRecorder) TransactionRecorded(ctx context.Context, transaction *entities.Transaction) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TransactionRecorded", ctx, transaction)
+}
+
+// TransactionRecorded indicates an expected call of TransactionRecorded.
+func (mr *MockTransactionRecorderMockRecorder) TransactionRecorded(ctx, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionRecorded", reflect.TypeOf((*MockTransactionRecorder)(nil).TransactionRecorded), ctx, transaction)
+}
+
+// MockPriceFeed is a mock of PriceFeed interface.
+type MockPriceFeed struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceFeedMockRecorder
+	isgomock struct{}
+}
+
+// MockPriceFeedMockRecorder is the mock recorder for MockPriceFeed.
+type MockPriceFeedMockRecorder struct {
+	mock *MockPriceFeed
+}
+
+// NewMockPriceFeed creates a new mock instance.
+func NewMockPriceFeed(ctrl *gomock.Controller) *MockPriceFeed {
+	mock := &MockPriceFeed{ctrl: ctrl}
+	mock.recorder = &MockPriceFeedMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceFeed) EXPECT() *MockPriceFeedMockRecorder {
+	return m.recorder
+}
+
+// PriceChange mocks base method.
+func (m *MockPriceFeed) PriceChange(ctx context.Context, opportunity *entities.Opportunity, from, to time.Time) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceChange", ctx, opportunity, from, to)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PriceChange indicates an expected call of PriceChange.
+func (mr *MockPriceFeedMockRecorder) PriceChange(ctx, opportunity, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceChange", reflect.TypeOf((*MockPriceFeed)(nil).PriceChange), ctx, opportunity, from, to)
+}
@@ -2,8 +2,10 @@ package investment_repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Financial-Partner/server/internal/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 //go:generate mockgen -source=repository.go -destination=repository_mock.go -package=investment_repository
@@ -11,15 +13,19 @@ import (
 type Repository interface {
 	CreateInvestment(ctx context.Context, entity *entities.Investment) (*entities.Investment, error)
 	CreateOpportunity(ctx context.Context, entity *entities.Opportunity) (*entities.Opportunity, error)
-	FindOpportunitiesByUserId(ctx context.Context, userID string) ([]entities.Opportunity, error)
-	FindInvestmentsByUserId(ctx context.Context, userID string) ([]entities.Investment, error)
+	FindOpportunities(ctx context.Context) ([]entities.Opportunity, error)
+	FindOpportunityById(ctx context.Context, id primitive.ObjectID) (*entities.Opportunity, error)
+	FindInvestmentsByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.Investment, error)
+	FindMaturedInvestments(ctx context.Context, now time.Time, limit int64) ([]entities.Investment, error)
+	SettleInvestment(ctx context.Context, id primitive.ObjectID, payout entities.Money, settledAt time.Time) (bool, error)
+	RecordSettlementFailure(ctx context.Context, id primitive.ObjectID, retryAt time.Time) error
 }
 
 type InvestmentStore interface {
-	GetOpportunities(ctx context.Context, userID string) ([]entities.Opportunity, error)
+	GetOpportunities(ctx context.Context) ([]entities.Opportunity, error)
 	GetInvestments(ctx context.Context, userID string) ([]entities.Investment, error)
-	SetOpportunities(ctx context.Context, userID string, opportunities []entities.Opportunity) error
+	SetOpportunities(ctx context.Context, opportunities []entities.Opportunity) error
 	SetInvestments(ctx context.Context, userID string, investments []entities.Investment) error
 	DeleteInvestments(ctx context.Context, userID string) error
-	DeleteOpportunities(ctx context.Context, userID string) error
+	DeleteOpportunities(ctx context.Context) error
 }
@@ -12,8 +12,10 @@ package investment_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/Financial-Partner/server/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,8 +58,23 @@ func (mr *MockRepositoryMockRecorder) CreateInvestment(ctx, entity any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvestment", reflect.TypeOf((*MockRepository)(nil).CreateInvestment), ctx, entity)
 }
 
+// CreateOpportunity mocks base method.
+func (m *MockRepository) CreateOpportunity(ctx context.Context, entity *entities.Opportunity) (*entities.Opportunity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOpportunity", ctx, entity)
+	ret0, _ := ret[0].(*entities.Opportunity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOpportunity indicates an expected call of CreateOpportunity.
+func (mr *MockRepositoryMockRecorder) CreateOpportunity(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpportunity", reflect.TypeOf((*MockRepository)(nil).CreateOpportunity), ctx, entity)
+}
+
 // FindInvestmentsByUserId mocks base method.
-func (m *MockRepository) FindInvestmentsByUserId(ctx context.Context, userID string) ([]entities.Investment, error) {
+func (m *MockRepository) FindInvestmentsByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.Investment, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindInvestmentsByUserId", ctx, userID)
 	ret0, _ := ret[0].([]entities.Investment)
@@ -71,19 +88,78 @@ func (mr *MockRepositoryMockRecorder) FindInvestmentsByUserId(ctx, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInvestmentsByUserId", reflect.TypeOf((*MockRepository)(nil).FindInvestmentsByUserId), ctx, userID)
 }
 
-// FindOpportunitiesByUserId mocks base method.
-func (m *MockRepository) FindOpportunitiesByUserId(ctx context.Context, userID string) ([]entities.Opportunity, error) {
+// FindMaturedInvestments mocks base method.
+func (m *MockRepository) FindMaturedInvestments(ctx context.Context, now time.Time, limit int64) ([]entities.Investment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOpportunitiesByUserId", ctx, userID)
+	ret := m.ctrl.Call(m, "FindMaturedInvestments", ctx, now, limit)
+	ret0, _ := ret[0].([]entities.Investment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMaturedInvestments indicates an expected call of FindMaturedInvestments.
+func (mr *MockRepositoryMockRecorder) FindMaturedInvestments(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMaturedInvestments", reflect.TypeOf((*MockRepository)(nil).FindMaturedInvestments), ctx, now, limit)
+}
+
+// FindOpportunities mocks base method.
+func (m *MockRepository) FindOpportunities(ctx context.Context) ([]entities.Opportunity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpportunities", ctx)
 	ret0, _ := ret[0].([]entities.Opportunity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindOpportunitiesByUserId indicates an expected call of FindOpportunitiesByUserId.
-func (mr *MockRepositoryMockRecorder) FindOpportunitiesByUserId(ctx, userID any) *gomock.Call {
+// FindOpportunities indicates an expected call of FindOpportunities.
+func (mr *MockRepositoryMockRecorder) FindOpportunities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpportunities", reflect.TypeOf((*MockRepository)(nil).FindOpportunities), ctx)
+}
+
+// FindOpportunityById mocks base method.
+func (m *MockRepository) FindOpportunityById(ctx context.Context, id primitive.ObjectID) (*entities.Opportunity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpportunityById", ctx, id)
+	ret0, _ := ret[0].(*entities.Opportunity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpportunityById indicates an expected call of FindOpportunityById.
+func (mr *MockRepositoryMockRecorder) FindOpportunityById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpportunityById", reflect.TypeOf((*MockRepository)(nil).FindOpportunityById), ctx, id)
+}
+
+// RecordSettlementFailure mocks base method.
+func (m *MockRepository) RecordSettlementFailure(ctx context.Context, id primitive.ObjectID, retryAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSettlementFailure", ctx, id, retryAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSettlementFailure indicates an expected call of RecordSettlementFailure.
+func (mr *MockRepositoryMockRecorder) RecordSettlementFailure(ctx, id, retryAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSettlementFailure", reflect.TypeOf((*MockRepository)(nil).RecordSettlementFailure), ctx, id, retryAt)
+}
+
+// SettleInvestment mocks base method.
+func (m *MockRepository) SettleInvestment(ctx context.Context, id primitive.ObjectID, payout entities.Money, settledAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleInvestment", ctx, id, payout, settledAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleInvestment indicates an expected call of SettleInvestment.
+func (mr *MockRepositoryMockRecorder) SettleInvestment(ctx, id, payout, settledAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpportunitiesByUserId", reflect.TypeOf((*MockRepository)(nil).FindOpportunitiesByUserId), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleInvestment", reflect.TypeOf((*MockRepository)(nil).SettleInvestment), ctx, id, payout, settledAt)
 }
 
 // MockInvestmentStore is a mock of InvestmentStore interface.
@@ -125,17 +201,17 @@ func (mr *MockInvestmentStoreMockRecorder) DeleteInvestments(ctx, userID any) *g
 }
 
 // DeleteOpportunities mocks base method.
-func (m *MockInvestmentStore) DeleteOpportunities(ctx context.Context, userID string) error {
+func (m *MockInvestmentStore) DeleteOpportunities(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOpportunities", ctx, userID)
+	ret := m.ctrl.Call(m, "DeleteOpportunities", ctx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteOpportunities indicates an expected call of DeleteOpportunities.
-func (mr *MockInvestmentStoreMockRecorder) DeleteOpportunities(ctx, userID any) *gomock.Call {
+func (mr *MockInvestmentStoreMockRecorder) DeleteOpportunities(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpportunities", reflect.TypeOf((*MockInvestmentStore)(nil).DeleteOpportunities), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpportunities", reflect.TypeOf((*MockInvestmentStore)(nil).DeleteOpportunities), ctx)
 }
 
 // GetInvestments mocks base method.
@@ -154,18 +230,18 @@ func (mr *MockInvestmentStoreMockRecorder) GetInvestments(ctx, userID any) *gomo
 }
 
 // GetOpportunities mocks base method.
-func (m *MockInvestmentStore) GetOpportunities(ctx context.Context, userID string) ([]entities.Opportunity, error) {
+func (m *MockInvestmentStore) GetOpportunities(ctx context.Context) ([]entities.Opportunity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOpportunities", ctx, userID)
+	ret := m.ctrl.Call(m, "GetOpportunities", ctx)
 	ret0, _ := ret[0].([]entities.Opportunity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOpportunities indicates an expected call of GetOpportunities.
-func (mr *MockInvestmentStoreMockRecorder) GetOpportunities(ctx, userID any) *gomock.Call {
+func (mr *MockInvestmentStoreMockRecorder) GetOpportunities(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpportunities", reflect.TypeOf((*MockInvestmentStore)(nil).GetOpportunities), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpportunities", reflect.TypeOf((*MockInvestmentStore)(nil).GetOpportunities), ctx)
 }
 
 // SetInvestments mocks base method.
@@ -183,15 +259,15 @@ func (mr *MockInvestmentStoreMockRecorder) SetInvestments(ctx, userID, investmen
 }
 
 // SetOpportunities mocks base method.
-func (m *MockInvestmentStore) SetOpportunities(ctx context.Context, userID string, opportunities []entities.Opportunity) error {
+func (m *MockInvestmentStore) SetOpportunities(ctx context.Context, opportunities []entities.Opportunity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetOpportunities", ctx, userID, opportunities)
+	ret := m.ctrl.Call(m, "SetOpportunities", ctx, opportunities)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetOpportunities indicates an expected call of SetOpportunities.
-func (mr *MockInvestmentStoreMockRecorder) SetOpportunities(ctx, userID, opportunities any) *gomock.Call {
+func (mr *MockInvestmentStoreMockRecorder) SetOpportunities(ctx, opportunities any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOpportunities", reflect.TypeOf((*MockInvestmentStore)(nil).SetOpportunities), ctx, userID, opportunities)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOpportunities", reflect.TypeOf((*MockInvestmentStore)(nil).SetOpportunities), ctx, opportunities)
 }
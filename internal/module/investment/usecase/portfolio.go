@@ -0,0 +1,221 @@
+package investment_usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+// untaggedAllocation groups the value of opportunities without tags.
+const untaggedAllocation = "untagged"
+
+// holdingTotals accumulates the amounts of a holding in the portfolio's currency.
+type holdingTotals struct {
+	costBasis, currentValue, realizedPnL entities.Money
+}
+
+// GetPortfolio aggregates the user's investments by opportunity. Open investments
+// are valued at the opportunity's current market price. Amounts are in the user's
+// base currency, investments made in another currency being converted at today's
+// rate, so that holdings of different currencies add up.
+func (s *Service) GetPortfolio(ctx context.Context, userID string) (*entities.Portfolio, error) {
+	investments, err := s.GetUserInvestments(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	opportunities, err := s.GetOpportunities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[primitive.ObjectID]*entities.Opportunity, len(opportunities))
+	for i := range opportunities {
+		byID[opportunities[i].ID] = &opportunities[i]
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	user, err := s.userRepo.FindById(ctx, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	currency := user.BaseCurrency()
+	zero := entities.Money{Currency: currency}
+
+	now := time.Now().UTC()
+	portfolio := &entities.Portfolio{Currency: currency}
+	holdings := make(map[primitive.ObjectID]*entities.Holding)
+	totals := make(map[primitive.ObjectID]*holdingTotals)
+	for _, investment := range investments {
+		opportunity := byID[investment.OpportunityID]
+
+		holding, ok := holdings[investment.OpportunityID]
+		if !ok {
+			holding = &entities.Holding{OpportunityID: investment.OpportunityID}
+			if opportunity != nil {
+				holding.Title = opportunity.Title
+				holding.Tags = opportunity.Tags
+			}
+			holdings[investment.OpportunityID] = holding
+			totals[investment.OpportunityID] = &holdingTotals{zero, zero, zero}
+		}
+		total := totals[investment.OpportunityID]
+
+		amount, err := s.convert(ctx, investment.Amount, currency, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if investment.Status == entities.InvestmentStatusSettled {
+			payout, err := s.convert(ctx, investment.Payout, currency, now)
+			if err != nil {
+				return nil, err
+			}
+			pnl, err := payout.Sub(amount)
+			if err == nil {
+				total.realizedPnL, err = total.realizedPnL.Add(pnl)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to total investments: %w", err)
+			}
+			continue
+		}
+
+		value, err := s.convert(ctx, s.currentValue(ctx, &investment, opportunity, now), currency, now)
+		if err != nil {
+			return nil, err
+		}
+		total.costBasis, err = total.costBasis.Add(amount)
+		if err == nil {
+			total.currentValue, err = total.currentValue.Add(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to total investments: %w", err)
+		}
+	}
+
+	sums := holdingTotals{zero, zero, zero}
+	unrealizedPnL := zero
+	for id, holding := range holdings {
+		total := totals[id]
+		unrealized, err := total.currentValue.Sub(total.costBasis)
+		if err == nil {
+			sums.costBasis, err = sums.costBasis.Add(total.costBasis)
+		}
+		if err == nil {
+			sums.currentValue, err = sums.currentValue.Add(total.currentValue)
+		}
+		if err == nil {
+			sums.realizedPnL, err = sums.realizedPnL.Add(total.realizedPnL)
+		}
+		if err == nil {
+			unrealizedPnL, err = unrealizedPnL.Add(unrealized)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to total investments: %w", err)
+		}
+
+		holding.CostBasis = total.costBasis.Amount
+		holding.CurrentValue = total.currentValue.Amount
+		holding.UnrealizedPnL = unrealized.Amount
+		holding.RealizedPnL = total.realizedPnL.Amount
+		portfolio.Holdings = append(portfolio.Holdings, *holding)
+	}
+	portfolio.CostBasis = sums.costBasis.Amount
+	portfolio.CurrentValue = sums.currentValue.Amount
+	portfolio.UnrealizedPnL = unrealizedPnL.Amount
+	portfolio.RealizedPnL = sums.realizedPnL.Amount
+	slices.SortFunc(portfolio.Holdings, func(a, b entities.Holding) int {
+		return cmp.Or(cmp.Compare(b.CurrentValue, a.CurrentValue), cmp.Compare(a.Title, b.Title))
+	})
+	portfolio.Allocations = allocateByTag(portfolio.Holdings, portfolio.CurrentValue)
+
+	return portfolio, nil
+}
+
+// currentValue values an open investment at the opportunity's latest price, or at
+// its price at maturity once the investment has matured. It falls back to the
+// amount invested when no price is available.
+func (s *Service) currentValue(ctx context.Context, investment *entities.Investment, opportunity *entities.Opportunity, now time.Time) entities.Money {
+	if opportunity == nil {
+		s.log.Warnf("Opportunity %s of investment %s not found", investment.OpportunityID.Hex(), investment.ID.Hex())
+		return investment.Amount
+	}
+
+	change, err := s.priceFeed.PriceChange(ctx, opportunity, investment.CreatedAt, minTime(now, investment.MaturesAt))
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to get price change of opportunity %s", opportunity.ID.Hex())
+		return investment.Amount
+	}
+	value, err := investment.Amount.Scale(max(change, 0))
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to value investment %s", investment.ID.Hex())
+		return investment.Amount
+	}
+	return value
+}
+
+// convert returns the amount in currency at the rate of the given day. A zero amount,
+// such as the payout of a total loss, converts without a rate.
+func (s *Service) convert(ctx context.Context, amount entities.Money, currency string, on time.Time) (entities.Money, error) {
+	if amount.Currency == currency || amount.IsZero() {
+		return entities.Money{Amount: amount.Amount, Currency: currency}, nil
+	}
+
+	rate, err := s.rates.Rate(ctx, amount.Currency, currency, on)
+	if err != nil {
+		return entities.Money{}, fmt.Errorf("failed to get %s/%s rate: %w", amount.Currency, currency, err)
+	}
+	converted, err := amount.Convert(currency, rate)
+	if err != nil {
+		return entities.Money{}, fmt.Errorf("failed to convert %s: %w", amount, err)
+	}
+	return converted, nil
+}
+
+// allocateByTag splits the current value of each holding evenly across its tags,
+// so the allocations add up to the whole portfolio.
+func allocateByTag(holdings []entities.Holding, total int64) []entities.TagAllocation {
+	values := make(map[string]float64)
+	for _, holding := range holdings {
+		if holding.CurrentValue == 0 {
+			continue
+		}
+		if len(holding.Tags) == 0 {
+			values[untaggedAllocation] += float64(holding.CurrentValue)
+			continue
+		}
+		for _, tag := range holding.Tags {
+			values[tag] += float64(holding.CurrentValue) / float64(len(holding.Tags))
+		}
+	}
+
+	allocations := make([]entities.TagAllocation, 0, len(values))
+	for tag, value := range values {
+		allocations = append(allocations, entities.TagAllocation{
+			Tag:     tag,
+			Value:   int64(math.Round(value)),
+			Percent: math.Round(value/float64(total)*10000) / 100,
+		})
+	}
+	slices.SortFunc(allocations, func(a, b entities.TagAllocation) int {
+		return cmp.Or(cmp.Compare(b.Value, a.Value), cmp.Compare(a.Tag, b.Tag))
+	})
+	return allocations
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,194 @@
+package investment_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+func TestGetPortfolio(t *testing.T) {
+	userID := primitive.NewObjectID()
+	realEstate := entities.Opportunity{ID: primitive.NewObjectID(), Title: "Real Estate", Tags: []string{"high risk", "long term"}}
+	bonds := entities.Opportunity{ID: primitive.NewObjectID(), Title: "Bonds", Tags: []string{"low risk"}}
+	gold := entities.Opportunity{ID: primitive.NewObjectID(), Title: "Gold"}
+	opportunities := []entities.Opportunity{realEstate, bonds, gold}
+	user := &entities.User{ID: userID}
+
+	newInvestment := func(opportunityID primitive.ObjectID, amount int64) entities.Investment {
+		return entities.Investment{
+			ID:            primitive.NewObjectID(),
+			UserID:        userID,
+			OpportunityID: opportunityID,
+			Amount:        entities.Money{Amount: amount, Currency: "USD"},
+			Status:        entities.InvestmentStatusOpen,
+			MaturesAt:     time.Now().AddDate(0, 0, 30),
+			CreatedAt:     time.Now().AddDate(0, 0, -10),
+		}
+	}
+	settled := func(investment entities.Investment, payout int64) entities.Investment {
+		investment.Status = entities.InvestmentStatusSettled
+		investment.Payout = entities.Money{Amount: payout, Currency: "USD"}
+		return investment
+	}
+
+	t.Run("Aggregates holdings by opportunity", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		growing := newInvestment(realEstate.ID, 1000)
+		matured := newInvestment(realEstate.ID, 500)
+		matured.MaturesAt = time.Now().AddDate(0, 0, -1)
+		stable := newInvestment(bonds.ID, 2000)
+		unpriced := newInvestment(gold.ID, 300)
+		orphan := newInvestment(primitive.NewObjectID(), 100)
+		investments := []entities.Investment{
+			growing,
+			matured,
+			settled(newInvestment(realEstate.ID, 1000), 1300),
+			stable,
+			settled(newInvestment(bonds.ID, 500), 400),
+			unpriced,
+			orphan,
+		}
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return(investments, nil)
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(opportunities, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(user, nil)
+		mocks.mockPriceFeed.EXPECT().PriceChange(gomock.Any(), &realEstate, growing.CreatedAt, gomock.Any()).Return(1.2, nil)
+		mocks.mockPriceFeed.EXPECT().PriceChange(gomock.Any(), &realEstate, matured.CreatedAt, matured.MaturesAt).Return(0.9, nil)
+		mocks.mockPriceFeed.EXPECT().PriceChange(gomock.Any(), &bonds, stable.CreatedAt, gomock.Any()).Return(1.05, nil)
+		mocks.mockPriceFeed.EXPECT().PriceChange(gomock.Any(), &gold, unpriced.CreatedAt, gomock.Any()).Return(0.0, errors.New("db error"))
+
+		portfolio, err := service.GetPortfolio(context.Background(), userID.Hex())
+		require.NoError(t, err)
+
+		assert.Equal(t, "USD", portfolio.Currency)
+		assert.Equal(t, []entities.Holding{
+			{OpportunityID: bonds.ID, Title: "Bonds", Tags: bonds.Tags, CostBasis: 2000, CurrentValue: 2100, UnrealizedPnL: 100, RealizedPnL: -100},
+			{OpportunityID: realEstate.ID, Title: "Real Estate", Tags: realEstate.Tags, CostBasis: 1500, CurrentValue: 1650, UnrealizedPnL: 150, RealizedPnL: 300},
+			{OpportunityID: gold.ID, Title: "Gold", CostBasis: 300, CurrentValue: 300},
+			{OpportunityID: orphan.OpportunityID, CostBasis: 100, CurrentValue: 100},
+		}, portfolio.Holdings)
+		assert.Equal(t, int64(3900), portfolio.CostBasis)
+		assert.Equal(t, int64(4150), portfolio.CurrentValue)
+		assert.Equal(t, int64(250), portfolio.UnrealizedPnL)
+		assert.Equal(t, int64(200), portfolio.RealizedPnL)
+		assert.Equal(t, []entities.TagAllocation{
+			{Tag: "low risk", Value: 2100, Percent: 50.6},
+			{Tag: "high risk", Value: 825, Percent: 19.88},
+			{Tag: "long term", Value: 825, Percent: 19.88},
+			{Tag: "untagged", Value: 400, Percent: 9.64},
+		}, portfolio.Allocations)
+	})
+
+	t.Run("Converts holdings to the base currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		inDollars := newInvestment(bonds.ID, 1000)
+		inYen := newInvestment(bonds.ID, 15000)
+		inYen.Amount.Currency = "JPY"
+		lostInYen := settled(newInvestment(gold.ID, 3000), 0)
+		lostInYen.Amount.Currency = "JPY"
+		lostInYen.Payout.Currency = "JPY"
+		euros := &entities.User{ID: userID, Currency: "EUR"}
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return([]entities.Investment{inDollars, inYen, lostInYen}, nil)
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(opportunities, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(euros, nil)
+		mocks.mockPriceFeed.EXPECT().PriceChange(gomock.Any(), &bonds, gomock.Any(), gomock.Any()).Return(1.1, nil).Times(2)
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "USD", "EUR", gomock.Any()).Return(0.9, nil).Times(2)
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "JPY", "EUR", gomock.Any()).Return(0.006, nil).Times(3)
+
+		portfolio, err := service.GetPortfolio(context.Background(), userID.Hex())
+		require.NoError(t, err)
+
+		assert.Equal(t, "EUR", portfolio.Currency)
+		assert.Equal(t, []entities.Holding{
+			{OpportunityID: bonds.ID, Title: "Bonds", Tags: bonds.Tags, CostBasis: 9900, CurrentValue: 10890, UnrealizedPnL: 990},
+			{OpportunityID: gold.ID, Title: "Gold", RealizedPnL: -1800},
+		}, portfolio.Holdings)
+		assert.Equal(t, int64(9900), portfolio.CostBasis)
+		assert.Equal(t, int64(10890), portfolio.CurrentValue)
+		assert.Equal(t, int64(990), portfolio.UnrealizedPnL)
+		assert.Equal(t, int64(-1800), portfolio.RealizedPnL)
+	})
+
+	t.Run("Rate error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		inYen := newInvestment(bonds.ID, 15000)
+		inYen.Amount.Currency = "JPY"
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return([]entities.Investment{inYen}, nil)
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(opportunities, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(user, nil)
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "JPY", "USD", gomock.Any()).Return(0.0, errors.New("rate not found"))
+
+		portfolio, err := service.GetPortfolio(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, portfolio)
+	})
+
+	t.Run("User error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return([]entities.Investment{}, nil)
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(opportunities, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		portfolio, err := service.GetPortfolio(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, portfolio)
+	})
+
+	t.Run("No investments", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return([]entities.Investment{}, nil)
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(opportunities, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(user, nil)
+
+		portfolio, err := service.GetPortfolio(context.Background(), userID.Hex())
+		require.NoError(t, err)
+		assert.Empty(t, portfolio.Holdings)
+		assert.Empty(t, portfolio.Allocations)
+		assert.Zero(t, portfolio.CurrentValue)
+	})
+
+	t.Run("Opportunities error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return([]entities.Investment{}, nil)
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(nil, errors.New("cache miss"))
+		mocks.mockRepo.EXPECT().FindOpportunities(gomock.Any()).Return(nil, errors.New("db error"))
+
+		portfolio, err := service.GetPortfolio(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, portfolio)
+	})
+
+	t.Run("Investments error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return(nil, errors.New("cache miss"))
+		mocks.mockRepo.EXPECT().FindInvestmentsByUserId(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		portfolio, err := service.GetPortfolio(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, portfolio)
+	})
+}
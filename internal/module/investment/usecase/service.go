@@ -2,30 +2,199 @@ package investment_usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	investment_domain "github.com/Financial-Partner/server/internal/module/investment/domain"
+	investment_repository "github.com/Financial-Partner/server/internal/module/investment/repository"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
+	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type Service struct {
+	repo         investment_repository.Repository
+	store        investment_repository.InvestmentStore
+	userRepo     user_repository.Repository
+	userService  user_domain.UserService
+	priceFeed    investment_domain.PriceFeed
+	rates        exchange_domain.RateProvider
+	transactions investment_domain.TransactionRecorder
+	transactor   investment_domain.Transactor
+	log          logger.Logger
 }
 
-func NewService() *Service {
-	return &Service{}
+func NewService(
+	repo investment_repository.Repository,
+	store investment_repository.InvestmentStore,
+	userRepo user_repository.Repository,
+	userService user_domain.UserService,
+	priceFeed investment_domain.PriceFeed,
+	rates exchange_domain.RateProvider,
+	transactions investment_domain.TransactionRecorder,
+	transactor investment_domain.Transactor,
+	log logger.Logger,
+) *Service {
+	return &Service{
+		repo:         repo,
+		store:        store,
+		userRepo:     userRepo,
+		userService:  userService,
+		priceFeed:    priceFeed,
+		rates:        rates,
+		transactions: transactions,
+		transactor:   transactor,
+		log:          log,
+	}
 }
 
+// GetOpportunities returns the opportunity catalog, which is the same for every user.
 func (s *Service) GetOpportunities(ctx context.Context, userID string) ([]entities.Opportunity, error) {
-	return nil, nil
+	cachedOpportunities, err := s.store.GetOpportunities(ctx)
+	if err == nil && cachedOpportunities != nil {
+		return cachedOpportunities, nil
+	}
+
+	opportunities, err := s.repo.FindOpportunities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opportunities: %w", err)
+	}
+
+	if cacheErr := s.store.SetOpportunities(ctx, opportunities); cacheErr != nil {
+		s.log.Warnf("Failed to cache opportunities: %v", cacheErr)
+	}
+
+	return opportunities, nil
 }
 
+// CreateUserInvestment invests in an opportunity. The amount is debited from the
+// user's savings in the same transaction that records the investment.
 func (s *Service) CreateUserInvestment(ctx context.Context, userID string, req *dto.CreateUserInvestmentRequest) (*entities.Investment, error) {
-	return nil, nil
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	opportunityID, err := primitive.ObjectIDFromHex(req.OpportunityID)
+	if err != nil {
+		return nil, investment_domain.ErrInvalidOpportunityID
+	}
+
+	opportunity, err := s.repo.FindOpportunityById(ctx, opportunityID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, investment_domain.ErrOpportunityNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opportunity: %w", err)
+	}
+
+	amount, err := entities.NewMoney(req.Amount.Amount, req.Amount.Currency)
+	if err != nil || amount.Currency != opportunity.MinAmount.Currency {
+		return nil, investment_domain.ErrCurrencyMismatch
+	}
+	if amount.Amount <= 0 || amount.Amount < opportunity.MinAmount.Amount {
+		return nil, investment_domain.ErrAmountBelowMinimum
+	}
+
+	now := time.Now().UTC()
+	investment := &entities.Investment{
+		UserID:        objectID,
+		OpportunityID: opportunityID,
+		Amount:        amount,
+		Status:        entities.InvestmentStatusOpen,
+		MaturesAt:     now.AddDate(0, 0, opportunity.DurationDays),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	var createdInvestment *entities.Investment
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		debit := entities.Money{Amount: -amount.Amount, Currency: amount.Currency}
+		if _, err := s.userService.UpdateWallet(ctx, userID, 0, debit); err != nil {
+			return err
+		}
+
+		var err error
+		createdInvestment, err = s.repo.CreateInvestment(ctx, investment)
+		return err
+	})
+	if errors.Is(err, user_domain.ErrInsufficientBalance) {
+		return nil, investment_domain.ErrInsufficientSavings
+	}
+	if errors.Is(err, user_domain.ErrCurrencyMismatch) {
+		return nil, investment_domain.ErrSavingsCurrency
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create investment: %w", err)
+	}
+
+	if cacheErr := s.store.DeleteInvestments(ctx, userID); cacheErr != nil {
+		s.log.Warnf("Failed to delete investment cache for userID %s: %v", userID, cacheErr)
+	}
+
+	return createdInvestment, nil
 }
 
 func (s *Service) GetUserInvestments(ctx context.Context, userID string) ([]entities.Investment, error) {
-	return nil, nil
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	cachedInvestments, err := s.store.GetInvestments(ctx, userID)
+	if err == nil && cachedInvestments != nil {
+		return cachedInvestments, nil
+	}
+
+	investments, err := s.repo.FindInvestmentsByUserId(ctx, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get investments: %w", err)
+	}
+
+	if cacheErr := s.store.SetInvestments(ctx, userID, investments); cacheErr != nil {
+		s.log.Warnf("Failed to cache investments for userID %s: %v", userID, cacheErr)
+	}
+
+	return investments, nil
 }
 
-func (s *Service) CreateOpportunity(ctx context.Context, userID string, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error) {
-	return nil, nil
+func (s *Service) CreateOpportunity(ctx context.Context, req *dto.CreateOpportunityRequest) (*entities.Opportunity, error) {
+	minAmount, err := entities.NewMoney(req.MinAmount.Amount, req.MinAmount.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid minimum amount: %w", err)
+	}
+	if minAmount.Amount < 0 || req.DurationDays <= 0 || req.Variation < 0 {
+		return nil, investment_domain.ErrInvalidOpportunity
+	}
+
+	now := time.Now().UTC()
+	opportunity := &entities.Opportunity{
+		Title:        req.Title,
+		Description:  req.Description,
+		Tags:         req.Tags,
+		IsIncrease:   req.IsIncrease,
+		Variation:    req.Variation,
+		Duration:     req.Duration,
+		DurationDays: req.DurationDays,
+		MinAmount:    minAmount,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	createdOpportunity, err := s.repo.CreateOpportunity(ctx, opportunity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create opportunity: %w", err)
+	}
+
+	if cacheErr := s.store.DeleteOpportunities(ctx); cacheErr != nil {
+		s.log.Warnf("Failed to delete opportunity cache: %v", cacheErr)
+	}
+
+	return createdOpportunity, nil
 }
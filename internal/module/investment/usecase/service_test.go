@@ -0,0 +1,385 @@
+package investment_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	investment_domain "github.com/Financial-Partner/server/internal/module/investment/domain"
+	investment_repository "github.com/Financial-Partner/server/internal/module/investment/repository"
+	investment_usecase "github.com/Financial-Partner/server/internal/module/investment/usecase"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
+	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
+)
+
+type Mocks struct {
+	ctrl             *gomock.Controller
+	mockRepo         *investment_repository.MockRepository
+	mockStore        *investment_repository.MockInvestmentStore
+	mockUserRepo     *user_repository.MockRepository
+	mockUserService  *user_domain.MockUserService
+	mockPriceFeed    *investment_domain.MockPriceFeed
+	mockRates        *exchange_domain.MockRateProvider
+	mockTransactions *investment_domain.MockTransactionRecorder
+	mockTransactor   *investment_domain.MockTransactor
+}
+
+func NewMocks(t *testing.T) *Mocks {
+	ctrl := gomock.NewController(t)
+
+	return &Mocks{
+		ctrl:             ctrl,
+		mockRepo:         investment_repository.NewMockRepository(ctrl),
+		mockStore:        investment_repository.NewMockInvestmentStore(ctrl),
+		mockUserRepo:     user_repository.NewMockRepository(ctrl),
+		mockUserService:  user_domain.NewMockUserService(ctrl),
+		mockPriceFeed:    investment_domain.NewMockPriceFeed(ctrl),
+		mockRates:        exchange_domain.NewMockRateProvider(ctrl),
+		mockTransactions: investment_domain.NewMockTransactionRecorder(ctrl),
+		mockTransactor:   investment_domain.NewMockTransactor(ctrl),
+	}
+}
+
+func (m *Mocks) newService() *investment_usecase.Service {
+	return investment_usecase.NewService(
+		m.mockRepo,
+		m.mockStore,
+		m.mockUserRepo,
+		m.mockUserService,
+		m.mockPriceFeed,
+		m.mockRates,
+		m.mockTransactions,
+		m.mockTransactor,
+		logger.NewNopLogger(),
+	)
+}
+
+// expectTransaction runs the transaction body directly, as a committed transaction would.
+func (m *Mocks) expectTransaction() {
+	m.mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+}
+
+func TestGetOpportunities(t *testing.T) {
+	userID := primitive.NewObjectID().Hex()
+	opportunities := []entities.Opportunity{
+		{ID: primitive.NewObjectID(), Title: "Real Estate", MinAmount: entities.Money{Amount: 1000, Currency: "USD"}},
+	}
+
+	t.Run("From store", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(opportunities, nil)
+
+		result, err := service.GetOpportunities(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, opportunities, result)
+	})
+
+	t.Run("From repository on cache miss", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindOpportunities(gomock.Any()).Return(opportunities, nil)
+		mocks.mockStore.EXPECT().SetOpportunities(gomock.Any(), opportunities).Return(errors.New("cache error"))
+
+		result, err := service.GetOpportunities(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, opportunities, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetOpportunities(gomock.Any()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindOpportunities(gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.GetOpportunities(context.Background(), userID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCreateOpportunity(t *testing.T) {
+	req := &dto.CreateOpportunityRequest{
+		Title:        "Real Estate",
+		Description:  "Invest in real estate",
+		Tags:         []string{"high risk", "long term"},
+		IsIncrease:   true,
+		Variation:    20,
+		Duration:     "a month",
+		DurationDays: 30,
+		MinAmount:    dto.Money{Amount: 1000, Currency: "USD"},
+	}
+
+	t.Run("Success invalidates the catalog", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().CreateOpportunity(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, opportunity *entities.Opportunity) (*entities.Opportunity, error) {
+				opportunity.ID = primitive.NewObjectID()
+				return opportunity, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteOpportunities(gomock.Any()).Return(errors.New("cache error"))
+
+		result, err := service.CreateOpportunity(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, req.Title, result.Title)
+		assert.Equal(t, entities.Money{Amount: 1000, Currency: "USD"}, result.MinAmount)
+		assert.False(t, result.CreatedAt.IsZero())
+	})
+
+	t.Run("Invalid currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		invalid := *req
+		invalid.MinAmount = dto.Money{Amount: 1000, Currency: "XYZ"}
+
+		result, err := service.CreateOpportunity(context.Background(), &invalid)
+		assert.ErrorIs(t, err, entities.ErrInvalidCurrency)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid duration, variation or minimum", func(t *testing.T) {
+		for _, mutate := range []func(*dto.CreateOpportunityRequest){
+			func(r *dto.CreateOpportunityRequest) { r.DurationDays = 0 },
+			func(r *dto.CreateOpportunityRequest) { r.DurationDays = -30 },
+			func(r *dto.CreateOpportunityRequest) { r.Variation = -20 },
+			func(r *dto.CreateOpportunityRequest) { r.MinAmount.Amount = -1 },
+		} {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			invalid := *req
+			mutate(&invalid)
+
+			result, err := service.CreateOpportunity(context.Background(), &invalid)
+			assert.ErrorIs(t, err, investment_domain.ErrInvalidOpportunity)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().CreateOpportunity(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.CreateOpportunity(context.Background(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCreateUserInvestment(t *testing.T) {
+	userID := primitive.NewObjectID()
+	opportunityID := primitive.NewObjectID()
+	opportunity := &entities.Opportunity{ID: opportunityID, DurationDays: 30, MinAmount: entities.Money{Amount: 500, Currency: "USD"}}
+	req := &dto.CreateUserInvestmentRequest{OpportunityID: opportunityID.Hex(), Amount: dto.Money{Amount: 1000, Currency: "USD"}}
+
+	t.Run("Debits savings and invalidates the user's investments", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(opportunity, nil)
+		mocks.expectTransaction()
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: -1000, Currency: "USD"}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().CreateInvestment(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, investment *entities.Investment) (*entities.Investment, error) {
+				return investment, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteInvestments(gomock.Any(), userID.Hex()).Return(nil)
+
+		result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), req)
+		require.NoError(t, err)
+		assert.Equal(t, userID, result.UserID)
+		assert.Equal(t, opportunityID, result.OpportunityID)
+		assert.Equal(t, entities.Money{Amount: 1000, Currency: "USD"}, result.Amount)
+		assert.Equal(t, entities.InvestmentStatusOpen, result.Status)
+		assert.Equal(t, result.CreatedAt.AddDate(0, 0, 30), result.MaturesAt)
+	})
+
+	t.Run("Insufficient savings", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(opportunity, nil)
+		mocks.expectTransaction()
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: -1000, Currency: "USD"}).Return(nil, user_domain.ErrInsufficientBalance)
+
+		result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), req)
+		assert.ErrorIs(t, err, investment_domain.ErrInsufficientSavings)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Savings in another currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(opportunity, nil)
+		mocks.expectTransaction()
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: -1000, Currency: "USD"}).Return(nil, user_domain.ErrCurrencyMismatch)
+
+		result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), req)
+		assert.ErrorIs(t, err, investment_domain.ErrSavingsCurrency)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error rolls back the debit", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(opportunity, nil)
+		mocks.mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				err := fn(ctx)
+				assert.Error(t, err)
+				return err
+			},
+		)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: -1000, Currency: "USD"}).Return(&entities.User{}, nil)
+		mocks.mockRepo.EXPECT().CreateInvestment(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Amount below the minimum", func(t *testing.T) {
+		for _, amount := range []int64{0, -100, 499} {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(opportunity, nil)
+
+			result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), &dto.CreateUserInvestmentRequest{OpportunityID: opportunityID.Hex(), Amount: dto.Money{Amount: amount, Currency: "USD"}})
+			assert.ErrorIs(t, err, investment_domain.ErrAmountBelowMinimum)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("Currency other than the opportunity's", func(t *testing.T) {
+		for _, currency := range []string{"EUR", "XYZ"} {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(opportunity, nil)
+
+			result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), &dto.CreateUserInvestmentRequest{OpportunityID: opportunityID.Hex(), Amount: dto.Money{Amount: 1000, Currency: currency}})
+			assert.ErrorIs(t, err, investment_domain.ErrCurrencyMismatch)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("Opportunity not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), req)
+		assert.ErrorIs(t, err, investment_domain.ErrOpportunityNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Opportunity error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunityID).Return(nil, errors.New("db error"))
+
+		result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid opportunity ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		result, err := service.CreateUserInvestment(context.Background(), userID.Hex(), &dto.CreateUserInvestmentRequest{OpportunityID: "invalid", Amount: dto.Money{Amount: 1000, Currency: "USD"}})
+		assert.ErrorIs(t, err, investment_domain.ErrInvalidOpportunityID)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		result, err := service.CreateUserInvestment(context.Background(), "invalid", req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetUserInvestments(t *testing.T) {
+	userID := primitive.NewObjectID()
+	investments := []entities.Investment{
+		{ID: primitive.NewObjectID(), UserID: userID, OpportunityID: primitive.NewObjectID(), Amount: entities.Money{Amount: 1000, Currency: "USD"}},
+	}
+
+	t.Run("From store", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return(investments, nil)
+
+		result, err := service.GetUserInvestments(context.Background(), userID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, investments, result)
+	})
+
+	t.Run("From repository on cache miss", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindInvestmentsByUserId(gomock.Any(), userID).Return(investments, nil)
+		mocks.mockStore.EXPECT().SetInvestments(gomock.Any(), userID.Hex(), investments).Return(errors.New("cache error"))
+
+		result, err := service.GetUserInvestments(context.Background(), userID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, investments, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetInvestments(gomock.Any(), userID.Hex()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindInvestmentsByUserId(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		result, err := service.GetUserInvestments(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		result, err := service.GetUserInvestments(context.Background(), "invalid")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
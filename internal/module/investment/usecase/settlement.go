@@ -0,0 +1,193 @@
+package investment_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// settlementBatchSize caps how many investments one settlement run handles;
+	// the rest are picked up by the next run.
+	settlementBatchSize       = 100
+	defaultSettlementInterval = time.Minute
+
+	// An investment that fails to settle is retried after settlementRetryDelay,
+	// doubling with each failure up to maxSettlementRetryDelay, so that it doesn't
+	// hold back the investments maturing after it.
+	settlementRetryDelay    = time.Minute
+	maxSettlementRetryDelay = 24 * time.Hour
+
+	payoutCategory = "Investment"
+)
+
+// SettleMaturedInvestments settles the open investments that have matured by now
+// and returns how many it settled. An investment that fails to settle is logged
+// and retried later, backing off while it keeps failing.
+func (s *Service) SettleMaturedInvestments(ctx context.Context, now time.Time) (int, error) {
+	investments, err := s.repo.FindMaturedInvestments(ctx, now, settlementBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get matured investments: %w", err)
+	}
+
+	opportunities := make(map[primitive.ObjectID]*entities.Opportunity)
+	settled := 0
+	for i := range investments {
+		investment := &investments[i]
+
+		opportunity, ok := opportunities[investment.OpportunityID]
+		if !ok {
+			opportunity, err = s.repo.FindOpportunityById(ctx, investment.OpportunityID)
+			if err != nil {
+				s.log.WithError(err).Warnf("Failed to get opportunity %s for investment %s", investment.OpportunityID.Hex(), investment.ID.Hex())
+				s.deferSettlement(ctx, investment, now)
+				continue
+			}
+			opportunities[investment.OpportunityID] = opportunity
+		}
+
+		done, err := s.settle(ctx, investment, opportunity, now)
+		if err != nil {
+			s.log.WithError(err).Warnf("Failed to settle investment %s", investment.ID.Hex())
+			s.deferSettlement(ctx, investment, now)
+			continue
+		}
+		if done {
+			settled++
+		}
+	}
+
+	return settled, nil
+}
+
+// deferSettlement puts off the next attempt to settle an investment that failed to.
+func (s *Service) deferSettlement(ctx context.Context, investment *entities.Investment, now time.Time) {
+	// Past 11 doublings the delay exceeds the maximum; capping the shift avoids overflow.
+	delay := min(settlementRetryDelay<<min(investment.SettlementAttempts, 11), maxSettlementRetryDelay)
+	if err := s.repo.RecordSettlementFailure(ctx, investment.ID, now.Add(delay)); err != nil {
+		s.log.WithError(err).Warnf("Failed to defer settlement of investment %s", investment.ID.Hex())
+	}
+}
+
+// settle marks the investment as settled, credits its payout to the user's savings
+// and records the gain as an income transaction, or the loss as an expense, all in one
+// transaction, which is announced once it commits. Only the gain or loss is recorded:
+// the principal was the user's savings before it was invested, so it is neither
+// earned nor spent. The payout follows the opportunity's market price from the day
+// the investment was made to the day it matured. It reports false when the investment
+// had already been settled.
+func (s *Service) settle(ctx context.Context, investment *entities.Investment, opportunity *entities.Opportunity, now time.Time) (bool, error) {
+	userID := investment.UserID.Hex()
+	change, err := s.priceFeed.PriceChange(ctx, opportunity, investment.CreatedAt, investment.MaturesAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to get price change: %w", err)
+	}
+	payout, err := investment.Amount.Scale(max(change, 0))
+	if err != nil {
+		return false, fmt.Errorf("failed to compute payout: %w", err)
+	}
+	gain, err := payout.Sub(investment.Amount)
+	if err != nil {
+		return false, fmt.Errorf("failed to compute gain: %w", err)
+	}
+	record := &entities.Transaction{
+		UserID:      investment.UserID,
+		Amount:      gain,
+		Category:    payoutCategory,
+		Type:        entities.TransactionTypeIncome,
+		Date:        now,
+		Description: fmt.Sprintf("Gain on %s", opportunity.Title),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if gain.Amount < 0 {
+		record.Amount.Amount = -gain.Amount
+		record.Type = entities.TransactionTypeExpense
+		record.Description = fmt.Sprintf("Loss on %s", opportunity.Title)
+	}
+
+	settled := false
+	var transaction *entities.Transaction
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transaction = nil
+		settled, err = s.repo.SettleInvestment(ctx, investment.ID, payout, now)
+		if err != nil || !settled {
+			return err
+		}
+
+		if !payout.IsZero() {
+			if _, err := s.userService.UpdateWallet(ctx, userID, 0, payout); err != nil {
+				return err
+			}
+		}
+		if gain.IsZero() {
+			return nil
+		}
+
+		transaction, err = s.transactions.RecordTransaction(ctx, record)
+		return err
+	})
+	if err != nil || !settled {
+		return false, err
+	}
+
+	if cacheErr := s.store.DeleteInvestments(ctx, userID); cacheErr != nil {
+		s.log.Warnf("Failed to delete investment cache for userID %s: %v", userID, cacheErr)
+	}
+	if transaction != nil {
+		s.transactions.TransactionRecorded(ctx, transaction)
+	}
+
+	return true, nil
+}
+
+// SettlementWorker periodically settles matured investments in the background.
+type SettlementWorker struct {
+	service  *Service
+	interval time.Duration
+	log      logger.Logger
+}
+
+func NewSettlementWorker(service *Service, interval time.Duration, log logger.Logger) *SettlementWorker {
+	if interval <= 0 {
+		interval = defaultSettlementInterval
+	}
+	return &SettlementWorker{
+		service:  service,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run settles matured investments once immediately and then on every tick until
+// ctx is cancelled.
+func (w *SettlementWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.settle(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *SettlementWorker) settle(ctx context.Context) {
+	settled, err := w.service.SettleMaturedInvestments(ctx, time.Now().UTC())
+	if err != nil {
+		w.log.WithError(err).Errorf("Failed to settle matured investments")
+		return
+	}
+	if settled > 0 {
+		w.log.Infof("Settled %d matured investments", settled)
+	}
+}
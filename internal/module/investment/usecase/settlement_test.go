@@ -0,0 +1,324 @@
+package investment_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	investment_usecase "github.com/Financial-Partner/server/internal/module/investment/usecase"
+)
+
+func TestOpportunityExpectedGrowth(t *testing.T) {
+	testCases := []struct {
+		name        string
+		opportunity entities.Opportunity
+		expected    float64
+	}{
+		{"Gain", entities.Opportunity{IsIncrease: true, Variation: 20}, 1.2},
+		{"Loss", entities.Opportunity{IsIncrease: false, Variation: 20}, 0.8},
+		{"Total loss", entities.Opportunity{IsIncrease: false, Variation: 100}, 0},
+		{"Loss beyond the stake", entities.Opportunity{IsIncrease: false, Variation: 150}, 0},
+		{"No variation", entities.Opportunity{IsIncrease: true}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, tc.opportunity.ExpectedGrowth(), 1e-9)
+		})
+	}
+}
+
+func TestSettleMaturedInvestments(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	userID := primitive.NewObjectID()
+	opportunity := &entities.Opportunity{
+		ID:         primitive.NewObjectID(),
+		Title:      "Real Estate",
+		IsIncrease: true,
+		Variation:  20,
+	}
+	newInvestment := func() entities.Investment {
+		return entities.Investment{
+			ID:            primitive.NewObjectID(),
+			UserID:        userID,
+			OpportunityID: opportunity.ID,
+			Amount:        entities.Money{Amount: 1000, Currency: "USD"},
+			Status:        entities.InvestmentStatusOpen,
+			MaturesAt:     now.AddDate(0, 0, -1),
+			CreatedAt:     now.AddDate(0, 0, -31),
+		}
+	}
+	expectPriceChange := func(mocks *Mocks, opportunity *entities.Opportunity, change float64, err error) *gomock.Call {
+		return mocks.mockPriceFeed.EXPECT().
+			PriceChange(gomock.Any(), opportunity, now.AddDate(0, 0, -31), now.AddDate(0, 0, -1)).
+			Return(change, err)
+	}
+
+	t.Run("Credits the payout and records the gain", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		first, second := newInvestment(), newInvestment()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{first, second}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil).Times(1)
+		for _, investment := range []entities.Investment{first, second} {
+			expectPriceChange(mocks, opportunity, 1.2, nil)
+			mocks.expectTransaction()
+			mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), investment.ID, entities.Money{Amount: 1200, Currency: "USD"}, now).Return(true, nil)
+		}
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: 1200, Currency: "USD"}).Return(&entities.User{}, nil).Times(2)
+		mocks.mockTransactions.EXPECT().RecordTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				assert.Equal(t, userID, transaction.UserID)
+				assert.Equal(t, entities.Money{Amount: 200, Currency: "USD"}, transaction.Amount)
+				assert.Equal(t, entities.TransactionTypeIncome, transaction.Type)
+				assert.Equal(t, "Gain on Real Estate", transaction.Description)
+				assert.Equal(t, now, transaction.Date)
+				return transaction, nil
+			},
+		).Times(2)
+		mocks.mockStore.EXPECT().DeleteInvestments(gomock.Any(), userID.Hex()).Return(errors.New("cache error")).Times(2)
+		mocks.mockTransactions.EXPECT().TransactionRecorded(gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, transaction *entities.Transaction) {
+				assert.Equal(t, userID, transaction.UserID)
+			},
+		).Times(2)
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 2, settled)
+	})
+
+	t.Run("Records a loss as an expense", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		investment := newInvestment()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{investment}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 0.7, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), investment.ID, entities.Money{Amount: 700, Currency: "USD"}, now).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: 700, Currency: "USD"}).Return(&entities.User{}, nil)
+		mocks.mockTransactions.EXPECT().RecordTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				assert.Equal(t, entities.Money{Amount: 300, Currency: "USD"}, transaction.Amount)
+				assert.Equal(t, entities.TransactionTypeExpense, transaction.Type)
+				assert.Equal(t, "Loss on Real Estate", transaction.Description)
+				return transaction, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteInvestments(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockTransactions.EXPECT().TransactionRecorded(gomock.Any(), gomock.Any())
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, settled)
+	})
+
+	t.Run("Records nothing when the price is unchanged", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		investment := newInvestment()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{investment}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 1, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), investment.ID, entities.Money{Amount: 1000, Currency: "USD"}, now).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: 1000, Currency: "USD"}).Return(&entities.User{}, nil)
+		mocks.mockStore.EXPECT().DeleteInvestments(gomock.Any(), userID.Hex()).Return(nil)
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, settled)
+	})
+
+	t.Run("Total loss settles without a payout", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		investment := newInvestment()
+		lost := &entities.Opportunity{ID: opportunity.ID, Title: "Real Estate", Variation: 100}
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{investment}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(lost, nil)
+		expectPriceChange(mocks, lost, 0, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), investment.ID, entities.Money{Amount: 0, Currency: "USD"}, now).Return(true, nil)
+		mocks.mockTransactions.EXPECT().RecordTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				assert.Equal(t, entities.Money{Amount: 1000, Currency: "USD"}, transaction.Amount)
+				assert.Equal(t, entities.TransactionTypeExpense, transaction.Type)
+				return transaction, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteInvestments(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockTransactions.EXPECT().TransactionRecorded(gomock.Any(), gomock.Any())
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, settled)
+	})
+
+	t.Run("Skips investments settled concurrently", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		investment := newInvestment()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{investment}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 1.2, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), investment.ID, entities.Money{Amount: 1200, Currency: "USD"}, now).Return(false, nil)
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 0, settled)
+	})
+
+	t.Run("Failures are deferred", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		orphan, failing := newInvestment(), newInvestment()
+		orphan.OpportunityID = primitive.NewObjectID()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{orphan, failing}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), orphan.OpportunityID).Return(nil, errors.New("db error"))
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 1.2, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), failing.ID, entities.Money{Amount: 1200, Currency: "USD"}, now).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: 1200, Currency: "USD"}).Return(nil, errors.New("db error"))
+		mocks.mockRepo.EXPECT().RecordSettlementFailure(gomock.Any(), orphan.ID, now.Add(time.Minute)).Return(nil)
+		mocks.mockRepo.EXPECT().RecordSettlementFailure(gomock.Any(), failing.ID, now.Add(time.Minute)).Return(errors.New("db error"))
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 0, settled)
+	})
+
+	t.Run("Transaction record error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		investment := newInvestment()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{investment}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 1.2, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), investment.ID, entities.Money{Amount: 1200, Currency: "USD"}, now).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: 1200, Currency: "USD"}).Return(&entities.User{}, nil)
+		mocks.mockTransactions.EXPECT().RecordTransaction(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		mocks.mockRepo.EXPECT().RecordSettlementFailure(gomock.Any(), investment.ID, now.Add(time.Minute)).Return(nil)
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 0, settled)
+	})
+
+	t.Run("Rounds the payout to the nearest unit", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		investment := newInvestment()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{investment}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 0.93456, nil)
+		mocks.expectTransaction()
+		mocks.mockRepo.EXPECT().SettleInvestment(gomock.Any(), investment.ID, entities.Money{Amount: 935, Currency: "USD"}, now).Return(true, nil)
+		mocks.mockUserService.EXPECT().UpdateWallet(gomock.Any(), userID.Hex(), int64(0), entities.Money{Amount: 935, Currency: "USD"}).Return(&entities.User{}, nil)
+		mocks.mockTransactions.EXPECT().RecordTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				return transaction, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteInvestments(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockTransactions.EXPECT().TransactionRecorded(gomock.Any(), gomock.Any())
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, settled)
+	})
+
+	t.Run("Price feed error leaves the investment for the next run", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		investment := newInvestment()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{investment}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 0, errors.New("db error"))
+		mocks.mockRepo.EXPECT().RecordSettlementFailure(gomock.Any(), investment.ID, now.Add(time.Minute)).Return(nil)
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 0, settled)
+	})
+
+	t.Run("Backs off while an investment keeps failing", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		retried, stuck := newInvestment(), newInvestment()
+		retried.SettlementAttempts = 3
+		stuck.SettlementAttempts = 40
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return([]entities.Investment{retried, stuck}, nil)
+		mocks.mockRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		expectPriceChange(mocks, opportunity, 0, errors.New("db error")).Times(2)
+		mocks.mockRepo.EXPECT().RecordSettlementFailure(gomock.Any(), retried.ID, now.Add(8*time.Minute)).Return(nil)
+		mocks.mockRepo.EXPECT().RecordSettlementFailure(gomock.Any(), stuck.ID, now.Add(24*time.Hour)).Return(nil)
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, 0, settled)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), now, int64(100)).Return(nil, errors.New("db error"))
+
+		settled, err := service.SettleMaturedInvestments(context.Background(), now)
+		assert.Error(t, err)
+		assert.Equal(t, 0, settled)
+	})
+}
+
+func TestSettlementWorker(t *testing.T) {
+	t.Run("Settles until cancelled", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		gomock.InOrder(
+			mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), gomock.Any(), int64(100)).Return(nil, errors.New("db error")),
+			mocks.mockRepo.EXPECT().FindMaturedInvestments(gomock.Any(), gomock.Any(), int64(100)).DoAndReturn(
+				func(context.Context, time.Time, int64) ([]entities.Investment, error) {
+					cancel()
+					return nil, nil
+				},
+			),
+		)
+
+		done := make(chan struct{})
+		go func() {
+			investment_usecase.NewSettlementWorker(service, time.Millisecond, logger.NewNopLogger()).Run(ctx)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("worker did not stop after cancellation")
+		}
+	})
+}
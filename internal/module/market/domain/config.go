@@ -0,0 +1,28 @@
+package market_domain
+
+import "strings"
+
+// Config holds the parameters of the simulated market.
+type Config struct {
+	// Seed makes price paths reproducible: the same seed always yields the same prices.
+	Seed uint64
+	// Volatility is the daily volatility of opportunities without a tag in TagVolatility.
+	Volatility float64
+	// TagVolatility sets the daily volatility by opportunity tag, e.g. "high risk".
+	TagVolatility map[string]float64
+}
+
+// VolatilityFor returns the volatility of the riskiest of the tags, or the default
+// volatility when none of them is configured.
+func (c Config) VolatilityFor(tags []string) float64 {
+	volatility, found := 0.0, false
+	for _, tag := range tags {
+		if v, ok := c.TagVolatility[strings.ToLower(tag)]; ok && (!found || v > volatility) {
+			volatility, found = v, true
+		}
+	}
+	if !found {
+		return c.Volatility
+	}
+	return volatility
+}
@@ -0,0 +1,15 @@
+package market_domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=interfaces_mock.go -package=market_domain
+
+type MarketService interface {
+	GetPrices(ctx context.Context, opportunityID string) ([]entities.PricePoint, error)
+	PriceChange(ctx context.Context, opportunity *entities.Opportunity, from, to time.Time) (float64, error)
+}
@@ -0,0 +1,16 @@
+package market_repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+//go:generate mockgen -source=repository.go -destination=repository_mock.go -package=market_repository
+
+type Repository interface {
+	FindPrices(ctx context.Context, opportunityID primitive.ObjectID) ([]entities.PricePoint, error)
+	SavePrices(ctx context.Context, points []entities.PricePoint) error
+}
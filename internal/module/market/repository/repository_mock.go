@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=repository_mock.go -package=market_repository
+//
+
+// Package market_repository is a generated GoMock package.
+package market_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPrices mocks base method.
+func (m *MockRepository) FindPrices(ctx context.Context, opportunityID primitive.ObjectID) ([]entities.PricePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrices", ctx, opportunityID)
+	ret0, _ := ret[0].([]entities.PricePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrices indicates an expected call of FindPrices.
+func (mr *MockRepositoryMockRecorder) FindPrices(ctx, opportunityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrices", reflect.TypeOf((*MockRepository)(nil).FindPrices), ctx, opportunityID)
+}
+
+// SavePrices mocks base method.
+func (m *MockRepository) SavePrices(ctx context.Context, points []entities.PricePoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePrices", ctx, points)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePrices indicates an expected call of SavePrices.
+func (mr *MockRepositoryMockRecorder) SavePrices(ctx, points any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePrices", reflect.TypeOf((*MockRepository)(nil).SavePrices), ctx, points)
+}
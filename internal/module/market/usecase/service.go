@@ -0,0 +1,112 @@
+package market_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	investment_domain "github.com/Financial-Partner/server/internal/module/investment/domain"
+	investment_repository "github.com/Financial-Partner/server/internal/module/investment/repository"
+	market_domain "github.com/Financial-Partner/server/internal/module/market/domain"
+	market_repository "github.com/Financial-Partner/server/internal/module/market/repository"
+)
+
+type Service struct {
+	repo           market_repository.Repository
+	investmentRepo investment_repository.Repository
+	cfg            market_domain.Config
+	log            logger.Logger
+}
+
+func NewService(
+	repo market_repository.Repository,
+	investmentRepo investment_repository.Repository,
+	cfg market_domain.Config,
+	log logger.Logger,
+) *Service {
+	return &Service{
+		repo:           repo,
+		investmentRepo: investmentRepo,
+		cfg:            cfg,
+		log:            log,
+	}
+}
+
+// GetPrices returns the opportunity's daily prices from the day it was created up to today.
+func (s *Service) GetPrices(ctx context.Context, opportunityID string) ([]entities.PricePoint, error) {
+	objectID, err := primitive.ObjectIDFromHex(opportunityID)
+	if err != nil {
+		return nil, investment_domain.ErrInvalidOpportunityID
+	}
+
+	opportunity, err := s.investmentRepo.FindOpportunityById(ctx, objectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, investment_domain.ErrOpportunityNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opportunity: %w", err)
+	}
+
+	return s.prices(ctx, opportunity, time.Now())
+}
+
+// PriceChange returns the opportunity's price on the day of to relative to its
+// price on the day of from, e.g. 1.2 after a 20% rise.
+func (s *Service) PriceChange(ctx context.Context, opportunity *entities.Opportunity, from, to time.Time) (float64, error) {
+	points, err := s.prices(ctx, opportunity, to)
+	if err != nil {
+		return 0, err
+	}
+	return priceOn(points, to) / priceOn(points, from), nil
+}
+
+// prices returns the opportunity's stored price path, first simulating and storing
+// the days up to through that have no price yet.
+func (s *Service) prices(ctx context.Context, opportunity *entities.Opportunity, through time.Time) ([]entities.PricePoint, error) {
+	points, err := s.repo.FindPrices(ctx, opportunity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prices: %w", err)
+	}
+
+	var missing []entities.PricePoint
+	last := entities.PricePoint{
+		OpportunityID: opportunity.ID,
+		Date:          day(opportunity.CreatedAt),
+		Price:         initialPrice,
+	}
+	if len(points) > 0 {
+		last = points[len(points)-1]
+	} else {
+		missing = append(missing, last)
+	}
+	missing = append(missing, s.simulate(opportunity, last, day(through))...)
+	if len(missing) == 0 {
+		return points, nil
+	}
+
+	if err := s.repo.SavePrices(ctx, missing); err != nil {
+		return nil, fmt.Errorf("failed to save prices: %w", err)
+	}
+
+	return append(points, missing...), nil
+}
+
+// priceOn returns the price on the day of t, falling back to the first price when
+// t precedes the path. points must be sorted by date.
+func priceOn(points []entities.PricePoint, t time.Time) float64 {
+	date := day(t)
+	price := points[0].Price
+	for _, point := range points {
+		if point.Date.After(date) {
+			break
+		}
+		price = point.Price
+	}
+	return price
+}
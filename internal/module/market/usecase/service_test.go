@@ -0,0 +1,265 @@
+package market_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	investment_domain "github.com/Financial-Partner/server/internal/module/investment/domain"
+	investment_repository "github.com/Financial-Partner/server/internal/module/investment/repository"
+	market_domain "github.com/Financial-Partner/server/internal/module/market/domain"
+	market_repository "github.com/Financial-Partner/server/internal/module/market/repository"
+	market_usecase "github.com/Financial-Partner/server/internal/module/market/usecase"
+)
+
+type Mocks struct {
+	ctrl               *gomock.Controller
+	mockRepo           *market_repository.MockRepository
+	mockInvestmentRepo *investment_repository.MockRepository
+}
+
+func NewMocks(t *testing.T) *Mocks {
+	ctrl := gomock.NewController(t)
+
+	return &Mocks{
+		ctrl:               ctrl,
+		mockRepo:           market_repository.NewMockRepository(ctrl),
+		mockInvestmentRepo: investment_repository.NewMockRepository(ctrl),
+	}
+}
+
+func (m *Mocks) newService(cfg market_domain.Config) *market_usecase.Service {
+	return market_usecase.NewService(m.mockRepo, m.mockInvestmentRepo, cfg, logger.NewNopLogger())
+}
+
+var testConfig = market_domain.Config{Seed: 42, Volatility: 0.02}
+
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// simulatePath lets a service generate the opportunity's path through today from
+// scratch and returns the points it stores.
+func simulatePath(t *testing.T, cfg market_domain.Config, opportunity *entities.Opportunity) []entities.PricePoint {
+	mocks := NewMocks(t)
+	service := mocks.newService(cfg)
+
+	var saved []entities.PricePoint
+	mocks.mockInvestmentRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+	mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(nil, nil)
+	mocks.mockRepo.EXPECT().SavePrices(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, points []entities.PricePoint) error {
+			saved = points
+			return nil
+		},
+	)
+
+	points, err := service.GetPrices(context.Background(), opportunity.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, saved, points)
+	return points
+}
+
+func TestGetPrices(t *testing.T) {
+	opportunity := &entities.Opportunity{
+		ID:           primitive.NewObjectID(),
+		IsIncrease:   true,
+		Variation:    20,
+		DurationDays: 30,
+		CreatedAt:    today().AddDate(0, 0, -3).Add(15 * time.Hour),
+	}
+
+	t.Run("Simulates the path from the day the opportunity was created", func(t *testing.T) {
+		points := simulatePath(t, testConfig, opportunity)
+
+		require.Len(t, points, 4)
+		assert.Equal(t, 100.0, points[0].Price)
+		for i, point := range points {
+			assert.Equal(t, opportunity.ID, point.OpportunityID)
+			assert.Equal(t, today().AddDate(0, 0, i-3), point.Date)
+			assert.Positive(t, point.Price)
+		}
+	})
+
+	t.Run("Paths are reproducible from the seed", func(t *testing.T) {
+		assert.Equal(t, simulatePath(t, testConfig, opportunity), simulatePath(t, testConfig, opportunity))
+
+		reseeded := testConfig
+		reseeded.Seed = 7
+		assert.NotEqual(t, simulatePath(t, testConfig, opportunity), simulatePath(t, reseeded, opportunity))
+	})
+
+	t.Run("Extends a stored path the same way it would have been simulated", func(t *testing.T) {
+		path := simulatePath(t, testConfig, opportunity)
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockInvestmentRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(path[:2], nil)
+		mocks.mockRepo.EXPECT().SavePrices(gomock.Any(), path[2:]).Return(nil)
+
+		points, err := service.GetPrices(context.Background(), opportunity.ID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, path, points)
+	})
+
+	t.Run("Stored path up to date", func(t *testing.T) {
+		path := simulatePath(t, testConfig, opportunity)
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockInvestmentRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(path, nil)
+
+		points, err := service.GetPrices(context.Background(), opportunity.ID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, path, points)
+	})
+
+	t.Run("Riskier tags move the price more", func(t *testing.T) {
+		calm := market_domain.Config{Seed: 42, TagVolatility: map[string]float64{"high risk": 0.05}}
+		steady := *opportunity
+		steady.Tags = []string{"long term"}
+		risky := *opportunity
+		risky.Tags = []string{"long term", "High Risk"}
+
+		growth := opportunity.ExpectedGrowth()
+		for _, point := range simulatePath(t, calm, &steady)[1:] {
+			assert.Greater(t, point.Price, 100.0)
+			assert.Less(t, point.Price, 100*growth)
+		}
+		assert.NotEqual(t, simulatePath(t, calm, &steady), simulatePath(t, calm, &risky))
+	})
+
+	t.Run("Save error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockInvestmentRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(nil, nil)
+		mocks.mockRepo.EXPECT().SavePrices(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		points, err := service.GetPrices(context.Background(), opportunity.ID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, points)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockInvestmentRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(opportunity, nil)
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(nil, errors.New("db error"))
+
+		points, err := service.GetPrices(context.Background(), opportunity.ID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, points)
+	})
+
+	t.Run("Opportunity not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockInvestmentRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(nil, mongo.ErrNoDocuments)
+
+		points, err := service.GetPrices(context.Background(), opportunity.ID.Hex())
+		assert.ErrorIs(t, err, investment_domain.ErrOpportunityNotFound)
+		assert.Nil(t, points)
+	})
+
+	t.Run("Opportunity error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockInvestmentRepo.EXPECT().FindOpportunityById(gomock.Any(), opportunity.ID).Return(nil, errors.New("db error"))
+
+		points, err := service.GetPrices(context.Background(), opportunity.ID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, points)
+	})
+
+	t.Run("Invalid opportunity ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		points, err := service.GetPrices(context.Background(), "invalid")
+		assert.ErrorIs(t, err, investment_domain.ErrInvalidOpportunityID)
+		assert.Nil(t, points)
+	})
+}
+
+func TestPriceChange(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opportunity := &entities.Opportunity{
+		ID:           primitive.NewObjectID(),
+		IsIncrease:   true,
+		Variation:    20,
+		DurationDays: 30,
+		CreatedAt:    start,
+	}
+	stored := []entities.PricePoint{
+		{OpportunityID: opportunity.ID, Date: start, Price: 100},
+		{OpportunityID: opportunity.ID, Date: start.AddDate(0, 0, 1), Price: 110},
+		{OpportunityID: opportunity.ID, Date: start.AddDate(0, 0, 2), Price: 121},
+	}
+
+	t.Run("Compares the prices of the two days", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(stored, nil)
+
+		change, err := service.PriceChange(context.Background(), opportunity, start.Add(9*time.Hour), start.AddDate(0, 0, 2).Add(time.Hour))
+		require.NoError(t, err)
+		assert.InDelta(t, 1.21, change, 1e-9)
+
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(stored, nil)
+
+		change, err = service.PriceChange(context.Background(), opportunity, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2))
+		require.NoError(t, err)
+		assert.InDelta(t, 1.1, change, 1e-9)
+	})
+
+	t.Run("Days before the path use its first price", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(stored, nil)
+
+		change, err := service.PriceChange(context.Background(), opportunity, start.AddDate(0, 0, -5), start.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.InDelta(t, 1.1, change, 1e-9)
+	})
+
+	t.Run("Without volatility the price follows the expected growth", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(market_domain.Config{})
+
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(nil, nil)
+		mocks.mockRepo.EXPECT().SavePrices(gomock.Any(), gomock.Len(31)).Return(nil)
+
+		change, err := service.PriceChange(context.Background(), opportunity, start, start.AddDate(0, 0, 30))
+		require.NoError(t, err)
+		assert.InDelta(t, 1.2, change, 1e-9)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService(testConfig)
+
+		mocks.mockRepo.EXPECT().FindPrices(gomock.Any(), opportunity.ID).Return(nil, errors.New("db error"))
+
+		change, err := service.PriceChange(context.Background(), opportunity, start, start.AddDate(0, 0, 30))
+		assert.Error(t, err)
+		assert.Zero(t, change)
+	})
+}
@@ -0,0 +1,52 @@
+package market_usecase
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+const (
+	// initialPrice is every opportunity's price on the day it is created.
+	initialPrice = 100.0
+	// minGrowth keeps the drift of an opportunity expected to lose everything finite.
+	minGrowth = 0.01
+)
+
+// day truncates t to midnight UTC.
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// simulate extends the price path after last up to and including through with
+// geometric Brownian motion. The drift makes the expected price at maturity match
+// the opportunity's published variation.
+func (s *Service) simulate(opportunity *entities.Opportunity, last entities.PricePoint, through time.Time) []entities.PricePoint {
+	drift := math.Log(max(opportunity.ExpectedGrowth(), minGrowth)) / float64(max(opportunity.DurationDays, 1))
+	volatility := s.cfg.VolatilityFor(opportunity.Tags)
+
+	var points []entities.PricePoint
+	price := last.Price
+	for date := last.Date.AddDate(0, 0, 1); !date.After(through); date = date.AddDate(0, 0, 1) {
+		price *= math.Exp(drift - volatility*volatility/2 + volatility*s.shock(opportunity.ID, date))
+		points = append(points, entities.PricePoint{
+			OpportunityID: opportunity.ID,
+			Date:          date,
+			Price:         price,
+		})
+	}
+	return points
+}
+
+// shock draws the standard normal shock of a day. It depends only on the seed, the
+// opportunity and the date, so a path comes out the same however it is extended.
+func (s *Service) shock(opportunityID primitive.ObjectID, date time.Time) float64 {
+	h := fnv.New64a()
+	h.Write(opportunityID[:])
+	return rand.New(rand.NewPCG(s.cfg.Seed^h.Sum64(), uint64(date.Unix()))).NormFloat64()
+}
@@ -0,0 +1,9 @@
+package report_domain
+
+import "errors"
+
+var (
+	ErrInvalidReportType = errors.New("report type must be daily, weekly, monthly or yearly")
+	ErrInvalidPeriod     = errors.New("report end must be after its start")
+	ErrInvalidInterval   = errors.New("interval must be daily, weekly, monthly or yearly and split the report into at most 366 buckets")
+)
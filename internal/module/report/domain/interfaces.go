@@ -0,0 +1,20 @@
+package report_domain
+
+import (
+	"context"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=interfaces_mock.go -package=report_domain
+
+// SummaryGenerator writes a short plain-language summary of a finance report.
+type SummaryGenerator interface {
+	Summarize(ctx context.Context, report *entities.Report) (string, error)
+}
+
+// Completer answers a prompt following the given system instructions, e.g. with a
+// large language model.
+type Completer interface {
+	Complete(ctx context.Context, system, prompt string) (string, error)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=interfaces_mock.go -package=report_domain
+//
+
+// Package report_domain is a generated GoMock package.
+package report_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSummaryGenerator is a mock of SummaryGenerator interface.
+type MockSummaryGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockSummaryGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockSummaryGeneratorMockRecorder is the mock recorder for MockSummaryGenerator.
+type MockSummaryGeneratorMockRecorder struct {
+	mock *MockSummaryGenerator
+}
+
+// NewMockSummaryGenerator creates a new mock instance.
+func NewMockSummaryGenerator(ctrl *gomock.Controller) *MockSummaryGenerator {
+	mock := &MockSummaryGenerator{ctrl: ctrl}
+	mock.recorder = &MockSummaryGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSummaryGenerator) EXPECT() *MockSummaryGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Summarize mocks base method.
+func (m *MockSummaryGenerator) Summarize(ctx context.Context, report *entities.Report) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", ctx, report)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize.
+func (mr *MockSummaryGeneratorMockRecorder) Summarize(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockSummaryGenerator)(nil).Summarize), ctx, report)
+}
+
+// MockCompleter is a mock of Completer interface.
+type MockCompleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompleterMockRecorder
+	isgomock struct{}
+}
+
+// MockCompleterMockRecorder is the mock recorder for MockCompleter.
+type MockCompleterMockRecorder struct {
+	mock *MockCompleter
+}
+
+// NewMockCompleter creates a new mock instance.
+func NewMockCompleter(ctrl *gomock.Controller) *MockCompleter {
+	mock := &MockCompleter{ctrl: ctrl}
+	mock.recorder = &MockCompleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompleter) EXPECT() *MockCompleterMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockCompleter) Complete(ctx context.Context, system, prompt string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, system, prompt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockCompleterMockRecorder) Complete(ctx, system, prompt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockCompleter)(nil).Complete), ctx, system, prompt)
+}
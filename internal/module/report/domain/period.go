@@ -0,0 +1,50 @@
+package report_domain
+
+import "time"
+
+const (
+	ReportTypeDaily   = "daily"
+	ReportTypeWeekly  = "weekly"
+	ReportTypeMonthly = "monthly"
+	ReportTypeYearly  = "yearly"
+)
+
+var ReportTypes = []string{ReportTypeDaily, ReportTypeWeekly, ReportTypeMonthly, ReportTypeYearly}
+
+// PeriodStart returns the start of the period of the report type that contains t,
+// in t's location. Weeks start on Monday.
+func PeriodStart(reportType string, t time.Time) time.Time {
+	year, month, day := t.Date()
+	switch reportType {
+	case ReportTypeWeekly:
+		day -= (int(t.Weekday()) + 6) % 7
+	case ReportTypeMonthly:
+		day = 1
+	case ReportTypeYearly:
+		month, day = time.January, 1
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// NextPeriod returns the start of the period that follows the one starting at start.
+func NextPeriod(reportType string, start time.Time) time.Time {
+	switch reportType {
+	case ReportTypeWeekly:
+		return start.AddDate(0, 0, 7)
+	case ReportTypeMonthly:
+		return addMonths(start, 1)
+	case ReportTypeYearly:
+		return addMonths(start, 12)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// addMonths adds months to t, clamping the day to the end of a shorter month so that
+// a period starting on Jan 31 ends on Feb 28 rather than in March.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	month += time.Month(months)
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	return time.Date(year, month, min(day, lastDay), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
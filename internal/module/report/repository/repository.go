@@ -0,0 +1,23 @@
+package report_repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+//go:generate mockgen -source=repository.go -destination=repository_mock.go -package=report_repository
+
+type SummaryStore interface {
+	GetSummary(ctx context.Context, userID string, start, end time.Time) (*entities.ReportSummary, error)
+	SetSummary(ctx context.Context, userID string, summary *entities.ReportSummary) error
+}
+
+type SnapshotRepository interface {
+	CreateSnapshot(ctx context.Context, snapshot *entities.ReportSnapshot) (bool, error)
+	SnapshotExists(ctx context.Context, userID primitive.ObjectID, start time.Time) (bool, error)
+	FindSnapshotsByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.ReportSnapshot, error)
+}
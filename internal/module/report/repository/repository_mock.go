@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=repository_mock.go -package=report_repository
+//
+
+// Package report_repository is a generated GoMock package.
+package report_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/Financial-Partner/server/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSummaryStore is a mock of SummaryStore interface.
+type MockSummaryStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSummaryStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockSummaryStoreMockRecorder is the mock recorder for MockSummaryStore.
+type MockSummaryStoreMockRecorder struct {
+	mock *MockSummaryStore
+}
+
+// NewMockSummaryStore creates a new mock instance.
+func NewMockSummaryStore(ctrl *gomock.Controller) *MockSummaryStore {
+	mock := &MockSummaryStore{ctrl: ctrl}
+	mock.recorder = &MockSummaryStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSummaryStore) EXPECT() *MockSummaryStoreMockRecorder {
+	return m.recorder
+}
+
+// GetSummary mocks base method.
+func (m *MockSummaryStore) GetSummary(ctx context.Context, userID string, start, end time.Time) (*entities.ReportSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummary", ctx, userID, start, end)
+	ret0, _ := ret[0].(*entities.ReportSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockSummaryStoreMockRecorder) GetSummary(ctx, userID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockSummaryStore)(nil).GetSummary), ctx, userID, start, end)
+}
+
+// SetSummary mocks base method.
+func (m *MockSummaryStore) SetSummary(ctx context.Context, userID string, summary *entities.ReportSummary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSummary", ctx, userID, summary)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSummary indicates an expected call of SetSummary.
+func (mr *MockSummaryStoreMockRecorder) SetSummary(ctx, userID, summary any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSummary", reflect.TypeOf((*MockSummaryStore)(nil).SetSummary), ctx, userID, summary)
+}
+
+// MockSnapshotRepository is a mock of SnapshotRepository interface.
+type MockSnapshotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSnapshotRepositoryMockRecorder is the mock recorder for MockSnapshotRepository.
+type MockSnapshotRepositoryMockRecorder struct {
+	mock *MockSnapshotRepository
+}
+
+// NewMockSnapshotRepository creates a new mock instance.
+func NewMockSnapshotRepository(ctrl *gomock.Controller) *MockSnapshotRepository {
+	mock := &MockSnapshotRepository{ctrl: ctrl}
+	mock.recorder = &MockSnapshotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotRepository) EXPECT() *MockSnapshotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockSnapshotRepository) CreateSnapshot(ctx context.Context, snapshot *entities.ReportSnapshot) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", ctx, snapshot)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockSnapshotRepositoryMockRecorder) CreateSnapshot(ctx, snapshot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockSnapshotRepository)(nil).CreateSnapshot), ctx, snapshot)
+}
+
+// FindSnapshotsByUserId mocks base method.
+func (m *MockSnapshotRepository) FindSnapshotsByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.ReportSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSnapshotsByUserId", ctx, userID)
+	ret0, _ := ret[0].([]entities.ReportSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSnapshotsByUserId indicates an expected call of FindSnapshotsByUserId.
+func (mr *MockSnapshotRepositoryMockRecorder) FindSnapshotsByUserId(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSnapshotsByUserId", reflect.TypeOf((*MockSnapshotRepository)(nil).FindSnapshotsByUserId), ctx, userID)
+}
+
+// SnapshotExists mocks base method.
+func (m *MockSnapshotRepository) SnapshotExists(ctx context.Context, userID primitive.ObjectID, start time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotExists", ctx, userID, start)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotExists indicates an expected call of SnapshotExists.
+func (mr *MockSnapshotRepositoryMockRecorder) SnapshotExists(ctx, userID, start any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotExists", reflect.TypeOf((*MockSnapshotRepository)(nil).SnapshotExists), ctx, userID, start)
+}
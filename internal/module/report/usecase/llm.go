@@ -0,0 +1,52 @@
+package report_usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+)
+
+const summaryInstructions = "You are a personal finance assistant. Summarize the user's finance report " +
+	"in at most three short sentences of plain language, addressing the user as \"you\". Point out notable " +
+	"changes from the previous period and end with one practical tip. Only use the numbers given."
+
+// LLMSummaryGenerator has a language model write the summary from the report's figures.
+type LLMSummaryGenerator struct {
+	completer report_domain.Completer
+}
+
+func NewLLMSummaryGenerator(completer report_domain.Completer) *LLMSummaryGenerator {
+	return &LLMSummaryGenerator{completer: completer}
+}
+
+func (g *LLMSummaryGenerator) Summarize(ctx context.Context, report *entities.Report) (string, error) {
+	summary, err := g.completer.Complete(ctx, summaryInstructions, summaryPrompt(report))
+	if err != nil {
+		return "", fmt.Errorf("failed to complete summary: %w", err)
+	}
+	return summary, nil
+}
+
+// summaryPrompt lays the report out as plain text, which models follow more reliably
+// than raw JSON.
+func summaryPrompt(report *entities.Report) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Period: %s to %s\n", report.Start.Format("2006-01-02"), report.End.Add(-time.Nanosecond).Format("2006-01-02"))
+	fmt.Fprintf(&b, "Income: %s\nExpenses: %s\nNet: %s\n", reportAmount(report, report.Revenue), reportAmount(report, report.Expenses), reportAmount(report, report.NetProfit))
+
+	if len(report.Categories) > 0 {
+		b.WriteString("Expenses by category:\n")
+		for i, category := range report.Categories {
+			fmt.Fprintf(&b, "- %s: %s (%s%%)\n", category, reportAmount(report, report.Amounts[i]), formatPercent(report.Percentages[i]*100))
+		}
+	}
+
+	previous := report.Previous
+	fmt.Fprintf(&b, "Previous period: income %s, expenses %s, net %s\n", reportAmount(report, previous.Revenue), reportAmount(report, previous.Expenses), reportAmount(report, previous.NetProfit))
+
+	return b.String()
+}
@@ -0,0 +1,49 @@
+package report_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+	report_usecase "github.com/Financial-Partner/server/internal/module/report/usecase"
+)
+
+func TestLLMSummaryGenerator(t *testing.T) {
+	t.Run("Prompts with the report figures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		completer := report_domain.NewMockCompleter(ctrl)
+		generator := report_usecase.NewLLMSummaryGenerator(completer)
+
+		completer.EXPECT().Complete(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, system, prompt string) (string, error) {
+				assert.Contains(t, system, "personal finance assistant")
+				assert.Equal(t, "Period: 2025-03-01 to 2025-03-31\n"+
+					"Income: 50.00 USD\nExpenses: 21.00 USD\nNet: 29.00 USD\n"+
+					"Expenses by category:\n- Rent: 15.00 USD (71.4%)\n- Food: 6.00 USD (28.6%)\n"+
+					"Previous period: income 40.00 USD, expenses 25.20 USD, net 14.80 USD\n", prompt)
+				return "You saved more than last month.", nil
+			},
+		)
+
+		summary, err := generator.Summarize(context.Background(), newSummaryReport())
+		require.NoError(t, err)
+		assert.Equal(t, "You saved more than last month.", summary)
+	})
+
+	t.Run("Completion error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		completer := report_domain.NewMockCompleter(ctrl)
+		generator := report_usecase.NewLLMSummaryGenerator(completer)
+
+		completer.EXPECT().Complete(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("timeout"))
+
+		summary, err := generator.Summarize(context.Background(), newSummaryReport())
+		assert.Error(t, err)
+		assert.Empty(t, summary)
+	})
+}
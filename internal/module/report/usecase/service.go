@@ -2,22 +2,317 @@ package report_usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+	report_repository "github.com/Financial-Partner/server/internal/module/report/repository"
+	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
+	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
 )
 
 type Service struct {
+	transactionRepo transaction_repository.Repository
+	userRepo        user_repository.Repository
+	snapshotRepo    report_repository.SnapshotRepository
+	store           report_repository.SummaryStore
+	generator       report_domain.SummaryGenerator
+	fallback        report_domain.SummaryGenerator
+	log             logger.Logger
+}
+
+func NewService(
+	transactionRepo transaction_repository.Repository,
+	userRepo user_repository.Repository,
+	snapshotRepo report_repository.SnapshotRepository,
+	store report_repository.SummaryStore,
+	generator report_domain.SummaryGenerator,
+	log logger.Logger,
+) *Service {
+	return &Service{
+		transactionRepo: transactionRepo,
+		userRepo:        userRepo,
+		snapshotRepo:    snapshotRepo,
+		store:           store,
+		generator:       generator,
+		fallback:        NewTemplateSummaryGenerator(),
+		log:             log,
+	}
 }
 
-func NewService() *Service {
-	return &Service{}
+// maxSeriesBuckets caps the length of a report's time series.
+const maxSeriesBuckets = 366
+
+// GetReport totals the user's transactions between startTime and endTime in the user's
+// base currency. The category breakdown covers expenses only, as fractions of the
+// total expenses. The time series has one bucket per interval and the comparison
+// covers the period of equal length that ends at startTime.
+func (s *Service) GetReport(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string, interval string) (*entities.Report, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	start, end, err := period(reportType, startTime, endTime, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	bucketStarts, interval, err := series(interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.transactionRepo.SumAmountByCategory(ctx, objectID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate transactions: %w", err)
+	}
+
+	buckets, err := s.transactionRepo.SumAmountByInterval(ctx, objectID, start, end, interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate transactions by %s interval: %w", interval, err)
+	}
+
+	previousTotals, err := s.transactionRepo.SumAmountByCategory(ctx, objectID, start.Add(-end.Sub(start)), start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate previous period: %w", err)
+	}
+
+	user, err := s.userRepo.FindById(ctx, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	report, err := buildReport(totals, user.BaseCurrency())
+	if err != nil {
+		return nil, err
+	}
+	previous, err := buildReport(previousTotals, user.BaseCurrency())
+	if err != nil {
+		return nil, err
+	}
+	report.Start, report.End = start, end
+	report.Currency = user.BaseCurrency()
+	report.Interval = interval
+	report.Series = fillSeries(bucketStarts, buckets)
+	report.Previous = compare(report, previous)
+
+	return report, nil
 }
 
-func (s *Service) GetReport(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string) (*entities.Report, error) {
-	return nil, nil
+// GetReportExport returns the report of the period along with its transactions, oldest
+// first, for download.
+func (s *Service) GetReportExport(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string, interval string) (*entities.ReportExport, error) {
+	report, err := s.GetReport(ctx, userID, startTime, endTime, reportType, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	transactions, err := s.transactionRepo.FindByUserIdBetween(ctx, objectID, report.Start, report.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	return &entities.ReportExport{Report: report, Transactions: transactions}, nil
 }
 
-func (s *Service) GetReportSummary(ctx context.Context, userID string) (*entities.ReportSummary, error) {
-	return nil, nil
+// GetReportSummary summarizes the report of the period. Summaries are cached until the
+// report changes. When the generator fails, the summary is written from a template
+// instead and is not cached, so the generator is tried again on the next request.
+func (s *Service) GetReportSummary(ctx context.Context, userID string, startTime time.Time, endTime time.Time, reportType string) (*entities.ReportSummary, error) {
+	report, err := s.GetReport(ctx, userID, startTime, endTime, reportType, "")
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := reportDigest(report)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, err := s.store.GetSummary(ctx, userID, report.Start, report.End)
+	if err == nil && cached != nil && cached.Digest == digest {
+		return cached, nil
+	}
+
+	summary := &entities.ReportSummary{
+		Start:  report.Start,
+		End:    report.End,
+		Digest: digest,
+	}
+
+	summary.Summary, err = s.generator.Summarize(ctx, report)
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to generate report summary for userID %s, using template", userID)
+		if summary.Summary, err = s.fallback.Summarize(ctx, report); err != nil {
+			return nil, fmt.Errorf("failed to generate report summary: %w", err)
+		}
+		return summary, nil
+	}
+
+	if cacheErr := s.store.SetSummary(ctx, userID, summary); cacheErr != nil {
+		s.log.Warnf("Failed to cache report summary for userID %s: %v", userID, cacheErr)
+	}
+
+	return summary, nil
+}
+
+// reportDigest fingerprints everything a summary may be written from.
+func reportDigest(report *entities.Report) (string, error) {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode report: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// period resolves the report's time range. A missing start defaults to the start of the
+// current period of the report type, which is monthly unless given, and a missing end
+// to the end of the period that begins at start.
+func period(reportType string, start, end, now time.Time) (time.Time, time.Time, error) {
+	if reportType == "" {
+		reportType = report_domain.ReportTypeMonthly
+	}
+	if !slices.Contains(report_domain.ReportTypes, reportType) {
+		return time.Time{}, time.Time{}, report_domain.ErrInvalidReportType
+	}
+
+	if start.IsZero() {
+		start = report_domain.PeriodStart(reportType, now)
+	}
+	if end.IsZero() {
+		end = report_domain.NextPeriod(reportType, start)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, report_domain.ErrInvalidPeriod
+	}
+
+	return start.UTC(), end.UTC(), nil
+}
+
+// series returns the start of every bucket of the report's time series along with its
+// interval. Without an interval, it picks daily buckets for up to a month, weekly ones
+// for up to half a year, monthly ones for up to ten years and yearly ones beyond.
+func series(interval string, start, end time.Time) ([]time.Time, string, error) {
+	if interval == "" {
+		switch days := end.Sub(start).Hours() / 24; {
+		case days <= 31:
+			interval = report_domain.ReportTypeDaily
+		case days <= 183:
+			interval = report_domain.ReportTypeWeekly
+		case days <= 3660:
+			interval = report_domain.ReportTypeMonthly
+		default:
+			interval = report_domain.ReportTypeYearly
+		}
+	}
+	if !slices.Contains(report_domain.ReportTypes, interval) {
+		return nil, "", report_domain.ErrInvalidInterval
+	}
+
+	var starts []time.Time
+	for bucket := report_domain.PeriodStart(interval, start); bucket.Before(end); bucket = report_domain.NextPeriod(interval, bucket) {
+		if len(starts) == maxSeriesBuckets {
+			return nil, "", report_domain.ErrInvalidInterval
+		}
+		starts = append(starts, bucket)
+	}
+
+	return starts, interval, nil
+}
+
+// fillSeries lays the aggregated buckets out on the series, with empty buckets for the
+// intervals without transactions.
+func fillSeries(starts []time.Time, buckets []entities.ReportBucket) []entities.ReportBucket {
+	byStart := make(map[int64]entities.ReportBucket, len(buckets))
+	for _, bucket := range buckets {
+		byStart[bucket.Start.Unix()] = bucket
+	}
+
+	series := make([]entities.ReportBucket, 0, len(starts))
+	for _, start := range starts {
+		bucket := byStart[start.Unix()]
+		bucket.Start = start
+		bucket.Net = bucket.Income - bucket.Expense
+		series = append(series, bucket)
+	}
+	return series
+}
+
+func compare(current, previous *entities.Report) entities.ReportComparison {
+	return entities.ReportComparison{
+		Revenue:         previous.Revenue,
+		Expenses:        previous.Expenses,
+		NetProfit:       previous.NetProfit,
+		RevenueChange:   percentChange(previous.Revenue, current.Revenue),
+		ExpensesChange:  percentChange(previous.Expenses, current.Expenses),
+		NetProfitChange: percentChange(previous.NetProfit, current.NetProfit),
+	}
+}
+
+// percentChange returns the change from one total to another as a percent of the
+// first, rounded to two decimals, or nil when the first is zero.
+func percentChange(from, to int64) *float64 {
+	if from == 0 {
+		return nil
+	}
+	change := math.Round(float64(to-from)/math.Abs(float64(from))*10000) / 100
+	return &change
+}
+
+// buildReport totals the category totals, which are in currency.
+func buildReport(totals []entities.CategoryTotal, currency string) (*entities.Report, error) {
+	report := &entities.Report{
+		Categories:  []string{},
+		Amounts:     []int64{},
+		Percentages: []float64{},
+	}
+
+	revenue := entities.Money{Currency: currency}
+	expenses := entities.Money{Currency: currency}
+	var err error
+	for _, total := range totals {
+		amount := entities.Money{Amount: total.Total, Currency: currency}
+		switch total.Type {
+		case entities.TransactionTypeIncome:
+			revenue, err = revenue.Add(amount)
+		case entities.TransactionTypeExpense:
+			expenses, err = expenses.Add(amount)
+			report.Categories = append(report.Categories, total.Category)
+			report.Amounts = append(report.Amounts, total.Total)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to total transactions: %w", err)
+		}
+	}
+	netProfit, err := revenue.Sub(expenses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total transactions: %w", err)
+	}
+	report.Revenue, report.Expenses, report.NetProfit = revenue.Amount, expenses.Amount, netProfit.Amount
+
+	for _, amount := range report.Amounts {
+		percentage := 0.0
+		if report.Expenses != 0 {
+			percentage = math.Round(float64(amount)/float64(report.Expenses)*10000) / 10000
+		}
+		report.Percentages = append(report.Percentages, percentage)
+	}
+
+	return report, nil
 }
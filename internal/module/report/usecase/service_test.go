@@ -0,0 +1,498 @@
+package report_usecase_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+	report_repository "github.com/Financial-Partner/server/internal/module/report/repository"
+	report_usecase "github.com/Financial-Partner/server/internal/module/report/usecase"
+	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
+	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
+)
+
+type Mocks struct {
+	ctrl                *gomock.Controller
+	mockTransactionRepo *transaction_repository.MockRepository
+	mockUserRepo        *user_repository.MockRepository
+	mockSnapshotRepo    *report_repository.MockSnapshotRepository
+	mockStore           *report_repository.MockSummaryStore
+	mockGenerator       *report_domain.MockSummaryGenerator
+}
+
+func NewMocks(t *testing.T) *Mocks {
+	ctrl := gomock.NewController(t)
+
+	return &Mocks{
+		ctrl:                ctrl,
+		mockTransactionRepo: transaction_repository.NewMockRepository(ctrl),
+		mockUserRepo:        user_repository.NewMockRepository(ctrl),
+		mockSnapshotRepo:    report_repository.NewMockSnapshotRepository(ctrl),
+		mockStore:           report_repository.NewMockSummaryStore(ctrl),
+		mockGenerator:       report_domain.NewMockSummaryGenerator(ctrl),
+	}
+}
+
+func (m *Mocks) newService() *report_usecase.Service {
+	return report_usecase.NewService(m.mockTransactionRepo, m.mockUserRepo, m.mockSnapshotRepo, m.mockStore, m.mockGenerator, logger.NewNopLogger())
+}
+
+// expectSeries expects the time series and previous period of a report to be
+// aggregated, both without transactions, and the user to be looked up for the
+// report's currency.
+func (m *Mocks) expectSeries(userID primitive.ObjectID, start, end time.Time, interval string) {
+	m.mockTransactionRepo.EXPECT().SumAmountByInterval(gomock.Any(), userID, start, end, interval).Return(nil, nil)
+	m.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start.Add(-end.Sub(start)), start).Return(nil, nil)
+	m.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(&entities.User{ID: userID, Currency: "EUR"}, nil)
+}
+
+func TestGetReport(t *testing.T) {
+	userID := primitive.NewObjectID()
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	previousStart := time.Date(2025, time.January, 29, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Aggregates income and expenses by category", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return([]entities.CategoryTotal{
+			{Type: entities.TransactionTypeIncome, Category: "Salary", Total: 5000},
+			{Type: entities.TransactionTypeExpense, Category: "Rent", Total: 1500},
+			{Type: entities.TransactionTypeIncome, Category: "Bonus", Total: 1000},
+			{Type: entities.TransactionTypeExpense, Category: "Food", Total: 500},
+			{Type: entities.TransactionTypeExpense, Category: "Transport", Total: 100},
+		}, nil)
+		mocks.expectSeries(userID, start, end, report_domain.ReportTypeDaily)
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, report_domain.ReportTypeMonthly, "")
+		require.NoError(t, err)
+		assert.Equal(t, start, report.Start)
+		assert.Equal(t, end, report.End)
+		assert.Equal(t, "EUR", report.Currency)
+		assert.Equal(t, int64(6000), report.Revenue)
+		assert.Equal(t, int64(2100), report.Expenses)
+		assert.Equal(t, int64(3900), report.NetProfit)
+		assert.Equal(t, []string{"Rent", "Food", "Transport"}, report.Categories)
+		assert.Equal(t, []int64{1500, 500, 100}, report.Amounts)
+		assert.Equal(t, []float64{0.7143, 0.2381, 0.0476}, report.Percentages)
+	})
+
+	t.Run("No transactions", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return(nil, nil)
+		mocks.expectSeries(userID, start, end, report_domain.ReportTypeWeekly)
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, "", report_domain.ReportTypeWeekly)
+		require.NoError(t, err)
+		assert.Zero(t, report.Revenue)
+		assert.Empty(t, report.Categories)
+		assert.Empty(t, report.Amounts)
+		assert.Empty(t, report.Percentages)
+		assert.Equal(t, entities.ReportComparison{}, report.Previous)
+
+		// Weekly buckets start on Monday, so the first one starts before the report.
+		require.Len(t, report.Series, 6)
+		assert.Equal(t, time.Date(2025, time.February, 24, 0, 0, 0, 0, time.UTC), report.Series[0].Start)
+		assert.Equal(t, time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC), report.Series[5].Start)
+	})
+
+	t.Run("Zero expenses", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return([]entities.CategoryTotal{
+			{Type: entities.TransactionTypeExpense, Category: "Food", Total: 0},
+		}, nil)
+		mocks.expectSeries(userID, start, end, report_domain.ReportTypeDaily)
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, []float64{0}, report.Percentages)
+	})
+
+	periods := []struct {
+		reportType string
+		start      time.Time
+		wantEnd    time.Time
+	}{
+		{report_domain.ReportTypeDaily, time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 6, 0, 0, 0, 0, time.UTC)},
+		{report_domain.ReportTypeWeekly, time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{report_domain.ReportTypeMonthly, time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{report_domain.ReportTypeYearly, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range periods {
+		t.Run("Missing end spans one "+tc.reportType+" period", func(t *testing.T) {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, tc.start, tc.wantEnd).Return(nil, nil)
+			mocks.expectSeries(userID, tc.start, tc.wantEnd, report_domain.ReportTypeMonthly)
+
+			_, err := service.GetReport(context.Background(), userID.Hex(), tc.start, time.Time{}, tc.reportType, report_domain.ReportTypeMonthly)
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("Missing start defaults to the current period", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByInterval(gomock.Any(), userID, gomock.Any(), gomock.Any(), report_domain.ReportTypeDaily).Return(nil, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(&entities.User{ID: userID}, nil)
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), time.Time{}, time.Time{}, report_domain.ReportTypeWeekly, "")
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		assert.Equal(t, time.Monday, report.Start.Weekday())
+		assert.Equal(t, 7*24*time.Hour, report.End.Sub(report.Start))
+		assert.False(t, now.Before(report.Start))
+		assert.True(t, now.Before(report.End))
+		assert.Len(t, report.Series, 7)
+	})
+
+	t.Run("Invalid report type", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, "summary", "")
+		assert.ErrorIs(t, err, report_domain.ErrInvalidReportType)
+		assert.Nil(t, report)
+	})
+
+	t.Run("End before start", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), end, start, report_domain.ReportTypeMonthly, "")
+		assert.ErrorIs(t, err, report_domain.ErrInvalidPeriod)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return(nil, errors.New("db error"))
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, report_domain.ReportTypeMonthly, "")
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Totals overflow", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return([]entities.CategoryTotal{
+			{Type: entities.TransactionTypeIncome, Category: "Salary", Total: math.MaxInt64},
+			{Type: entities.TransactionTypeIncome, Category: "Bonus", Total: 1},
+		}, nil)
+		mocks.expectSeries(userID, start, end, report_domain.ReportTypeDaily)
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, report_domain.ReportTypeMonthly, "")
+		assert.ErrorIs(t, err, entities.ErrAmountOverflow)
+		assert.Nil(t, report)
+	})
+
+	t.Run("User error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return(nil, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByInterval(gomock.Any(), userID, start, end, report_domain.ReportTypeDaily).Return(nil, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, previousStart, start).Return(nil, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, report_domain.ReportTypeMonthly, "")
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		report, err := service.GetReport(context.Background(), "invalid", start, end, report_domain.ReportTypeMonthly, "")
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Time series and comparison", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return([]entities.CategoryTotal{
+			{Type: entities.TransactionTypeIncome, Category: "Salary", Total: 5000},
+			{Type: entities.TransactionTypeExpense, Category: "Rent", Total: 1500},
+		}, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByInterval(gomock.Any(), userID, start, end, report_domain.ReportTypeDaily).Return([]entities.ReportBucket{
+			{Start: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), Income: 5000, Expense: 0, Net: 5000},
+			{Start: time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC), Income: 0, Expense: 1500, Net: -1500},
+		}, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, previousStart, start).Return([]entities.CategoryTotal{
+			{Type: entities.TransactionTypeIncome, Category: "Salary", Total: 4000},
+			{Type: entities.TransactionTypeExpense, Category: "Rent", Total: 1500},
+			{Type: entities.TransactionTypeExpense, Category: "Food", Total: 500},
+		}, nil)
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(&entities.User{ID: userID}, nil)
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, report_domain.ReportTypeMonthly, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, report_domain.ReportTypeDaily, report.Interval)
+		require.Len(t, report.Series, 31)
+		assert.Equal(t, entities.ReportBucket{Start: start, Income: 5000, Net: 5000}, report.Series[0])
+		assert.Equal(t, entities.ReportBucket{Start: time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC)}, report.Series[1])
+		assert.Equal(t, entities.ReportBucket{Start: time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC), Expense: 1500, Net: -1500}, report.Series[14])
+		assert.Equal(t, time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC), report.Series[30].Start)
+
+		assert.Equal(t, int64(4000), report.Previous.Revenue)
+		assert.Equal(t, int64(2000), report.Previous.Expenses)
+		assert.Equal(t, int64(2000), report.Previous.NetProfit)
+		require.NotNil(t, report.Previous.RevenueChange)
+		assert.Equal(t, 25.0, *report.Previous.RevenueChange)
+		require.NotNil(t, report.Previous.ExpensesChange)
+		assert.Equal(t, -25.0, *report.Previous.ExpensesChange)
+		require.NotNil(t, report.Previous.NetProfitChange)
+		assert.Equal(t, 75.0, *report.Previous.NetProfitChange)
+	})
+
+	intervals := []struct {
+		name         string
+		end          time.Time
+		wantInterval string
+	}{
+		{"Half a year is weekly", time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC), report_domain.ReportTypeWeekly},
+		{"Two years are monthly", time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC), report_domain.ReportTypeMonthly},
+		{"Twenty years are yearly", time.Date(2045, time.March, 1, 0, 0, 0, 0, time.UTC), report_domain.ReportTypeYearly},
+	}
+	for _, tc := range intervals {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, tc.end).Return(nil, nil)
+			mocks.expectSeries(userID, start, tc.end, tc.wantInterval)
+
+			report, err := service.GetReport(context.Background(), userID.Hex(), start, tc.end, "", "")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantInterval, report.Interval)
+		})
+	}
+
+	t.Run("Unknown interval", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, "", "hourly")
+		assert.ErrorIs(t, err, report_domain.ErrInvalidInterval)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Too many buckets", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, start.AddDate(2, 0, 0), "", report_domain.ReportTypeDaily)
+		assert.ErrorIs(t, err, report_domain.ErrInvalidInterval)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Series repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return(nil, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByInterval(gomock.Any(), userID, start, end, report_domain.ReportTypeDaily).Return(nil, errors.New("db error"))
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, "", "")
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Previous period repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return(nil, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByInterval(gomock.Any(), userID, start, end, report_domain.ReportTypeDaily).Return(nil, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, previousStart, start).Return(nil, errors.New("db error"))
+
+		report, err := service.GetReport(context.Background(), userID.Hex(), start, end, "", "")
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+}
+
+func TestGetReportExport(t *testing.T) {
+	userID := primitive.NewObjectID()
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Returns the report with its transactions", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		transactions := []entities.Transaction{
+			{ID: primitive.NewObjectID(), UserID: userID, Amount: entities.Money{Amount: 1500, Currency: "USD"}, Category: "Rent", Type: entities.TransactionTypeExpense, Date: start},
+		}
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return([]entities.CategoryTotal{
+			{Type: entities.TransactionTypeExpense, Category: "Rent", Total: 1500},
+		}, nil)
+		mocks.expectSeries(userID, start, end, report_domain.ReportTypeWeekly)
+		mocks.mockTransactionRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, start, end).Return(transactions, nil)
+
+		export, err := service.GetReportExport(context.Background(), userID.Hex(), start, end, "", report_domain.ReportTypeWeekly)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1500), export.Report.Expenses)
+		assert.Equal(t, report_domain.ReportTypeWeekly, export.Report.Interval)
+		assert.Equal(t, transactions, export.Transactions)
+	})
+
+	t.Run("Transactions error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return(nil, nil)
+		mocks.expectSeries(userID, start, end, report_domain.ReportTypeDaily)
+		mocks.mockTransactionRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, start, end).Return(nil, errors.New("database error"))
+
+		export, err := service.GetReportExport(context.Background(), userID.Hex(), start, end, "", "")
+		assert.Error(t, err)
+		assert.Nil(t, export)
+	})
+
+	t.Run("Report error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		export, err := service.GetReportExport(context.Background(), userID.Hex(), end, start, "", "")
+		assert.ErrorIs(t, err, report_domain.ErrInvalidPeriod)
+		assert.Nil(t, export)
+	})
+}
+
+func TestGetReportSummary(t *testing.T) {
+	userID := primitive.NewObjectID()
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	totals := []entities.CategoryTotal{
+		{Type: entities.TransactionTypeIncome, Category: "Salary", Total: 5000},
+		{Type: entities.TransactionTypeExpense, Category: "Rent", Total: 1500},
+	}
+
+	// expectReport expects the report of March to be aggregated from totals.
+	expectReport := func(mocks *Mocks, totals []entities.CategoryTotal) {
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), userID, start, end).Return(totals, nil)
+		mocks.expectSeries(userID, start, end, report_domain.ReportTypeDaily)
+	}
+
+	t.Run("Generates and caches the summary", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		expectReport(mocks, totals)
+		mocks.mockStore.EXPECT().GetSummary(gomock.Any(), userID.Hex(), start, end).Return(nil, errors.New("cache miss"))
+		mocks.mockGenerator.EXPECT().Summarize(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, report *entities.Report) (string, error) {
+				assert.Equal(t, int64(5000), report.Revenue)
+				return "You saved 3500.", nil
+			},
+		)
+		mocks.mockStore.EXPECT().SetSummary(gomock.Any(), userID.Hex(), gomock.Any()).Return(nil)
+
+		summary, err := service.GetReportSummary(context.Background(), userID.Hex(), start, end, report_domain.ReportTypeMonthly)
+		require.NoError(t, err)
+		assert.Equal(t, "You saved 3500.", summary.Summary)
+		assert.Equal(t, start, summary.Start)
+		assert.Equal(t, end, summary.End)
+		assert.NotEmpty(t, summary.Digest)
+	})
+
+	t.Run("Cached summary is reused until the report changes", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		var cached *entities.ReportSummary
+		expectReport(mocks, totals)
+		mocks.mockStore.EXPECT().GetSummary(gomock.Any(), userID.Hex(), start, end).Return(nil, errors.New("cache miss"))
+		mocks.mockGenerator.EXPECT().Summarize(gomock.Any(), gomock.Any()).Return("You saved 3500.", nil)
+		mocks.mockStore.EXPECT().SetSummary(gomock.Any(), userID.Hex(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, userID string, summary *entities.ReportSummary) error {
+				cached = summary
+				return nil
+			},
+		)
+		_, err := service.GetReportSummary(context.Background(), userID.Hex(), start, end, "")
+		require.NoError(t, err)
+
+		expectReport(mocks, totals)
+		mocks.mockStore.EXPECT().GetSummary(gomock.Any(), userID.Hex(), start, end).Return(cached, nil)
+
+		summary, err := service.GetReportSummary(context.Background(), userID.Hex(), start, end, "")
+		require.NoError(t, err)
+		assert.Equal(t, cached, summary)
+
+		changed := append([]entities.CategoryTotal{{Type: entities.TransactionTypeExpense, Category: "Food", Total: 200}}, totals...)
+		expectReport(mocks, changed)
+		mocks.mockStore.EXPECT().GetSummary(gomock.Any(), userID.Hex(), start, end).Return(cached, nil)
+		mocks.mockGenerator.EXPECT().Summarize(gomock.Any(), gomock.Any()).Return("You saved 3300.", nil)
+		mocks.mockStore.EXPECT().SetSummary(gomock.Any(), userID.Hex(), gomock.Any()).Return(nil)
+
+		summary, err = service.GetReportSummary(context.Background(), userID.Hex(), start, end, "")
+		require.NoError(t, err)
+		assert.Equal(t, "You saved 3300.", summary.Summary)
+		assert.NotEqual(t, cached.Digest, summary.Digest)
+	})
+
+	t.Run("Falls back to the template without caching", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		expectReport(mocks, totals)
+		mocks.mockStore.EXPECT().GetSummary(gomock.Any(), userID.Hex(), start, end).Return(nil, errors.New("cache miss"))
+		mocks.mockGenerator.EXPECT().Summarize(gomock.Any(), gomock.Any()).Return("", errors.New("model unavailable"))
+
+		summary, err := service.GetReportSummary(context.Background(), userID.Hex(), start, end, "")
+		require.NoError(t, err)
+		assert.Contains(t, summary.Summary, "From Mar 1, 2025 to Mar 31, 2025 you earned 50.00 EUR and spent 15.00 EUR")
+	})
+
+	t.Run("Cache write error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		expectReport(mocks, totals)
+		mocks.mockStore.EXPECT().GetSummary(gomock.Any(), userID.Hex(), start, end).Return(nil, errors.New("cache miss"))
+		mocks.mockGenerator.EXPECT().Summarize(gomock.Any(), gomock.Any()).Return("You saved 3500.", nil)
+		mocks.mockStore.EXPECT().SetSummary(gomock.Any(), userID.Hex(), gomock.Any()).Return(errors.New("redis down"))
+
+		summary, err := service.GetReportSummary(context.Background(), userID.Hex(), start, end, "")
+		require.NoError(t, err)
+		assert.Equal(t, "You saved 3500.", summary.Summary)
+	})
+
+	t.Run("Report error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		summary, err := service.GetReportSummary(context.Background(), userID.Hex(), start, end, "summary")
+		assert.ErrorIs(t, err, report_domain.ErrInvalidReportType)
+		assert.Nil(t, summary)
+	})
+}
@@ -0,0 +1,188 @@
+package report_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+)
+
+const (
+	// snapshotBatchSize is how many users are loaded at a time while taking snapshots.
+	snapshotBatchSize       = 100
+	defaultSnapshotInterval = time.Hour
+)
+
+// GetReportHistory returns the user's monthly report snapshots, most recent first.
+func (s *Service) GetReportHistory(ctx context.Context, userID string) ([]entities.ReportSnapshot, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	snapshots, err := s.snapshotRepo.FindSnapshotsByUserId(ctx, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// SnapshotTimezones returns the timezones whose users may need report snapshots.
+func (s *Service) SnapshotTimezones(ctx context.Context) ([]string, error) {
+	timezones, err := s.userRepo.FindTimezones(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timezones: %w", err)
+	}
+	return timezones, nil
+}
+
+// SnapshotMonthlyReports stores the report of the month ending at end for every user in
+// the timezone who doesn't have one yet, and returns how many it stored. Users who
+// signed up after the month ended are skipped. A report that fails to be stored is
+// logged and reported in the error once all other users are done.
+func (s *Service) SnapshotMonthlyReports(ctx context.Context, timezone string, end time.Time) (int, error) {
+	// Transactions are dated at UTC midnight, so the report covers the UTC dates of
+	// the local calendar month rather than the instants between its local midnights.
+	year, month, _ := end.Date()
+	monthEnd := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	monthStart := monthEnd.AddDate(0, -1, 0)
+
+	saved, failed := 0, 0
+	afterID := primitive.NilObjectID
+	for {
+		users, err := s.userRepo.FindByTimezone(ctx, timezone, afterID, snapshotBatchSize)
+		if err != nil {
+			return saved, fmt.Errorf("failed to get users in %s: %w", timezone, err)
+		}
+
+		for i := range users {
+			user := &users[i]
+			if !user.CreatedAt.Before(end) {
+				continue
+			}
+
+			stored, err := s.snapshot(ctx, user.ID, timezone, monthStart, monthEnd)
+			if err != nil {
+				s.log.WithError(err).Warnf("Failed to snapshot report for userID %s", user.ID.Hex())
+				failed++
+				continue
+			}
+			if stored {
+				saved++
+			}
+		}
+
+		if len(users) < snapshotBatchSize {
+			break
+		}
+		afterID = users[len(users)-1].ID
+	}
+
+	if failed > 0 {
+		return saved, fmt.Errorf("failed to snapshot %d reports in %s", failed, timezone)
+	}
+	return saved, nil
+}
+
+// snapshot stores the user's report of [start, end) unless it was stored before, and
+// reports whether it stored it.
+func (s *Service) snapshot(ctx context.Context, userID primitive.ObjectID, timezone string, start, end time.Time) (bool, error) {
+	exists, err := s.snapshotRepo.SnapshotExists(ctx, userID, start)
+	if err != nil {
+		return false, fmt.Errorf("failed to check report snapshot: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	report, err := s.GetReport(ctx, userID.Hex(), start, end, report_domain.ReportTypeMonthly, report_domain.ReportTypeDaily)
+	if err != nil {
+		return false, err
+	}
+
+	stored, err := s.snapshotRepo.CreateSnapshot(ctx, &entities.ReportSnapshot{
+		UserID:    userID,
+		Timezone:  timezone,
+		Report:    *report,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to store report snapshot: %w", err)
+	}
+	return stored, nil
+}
+
+// SnapshotWorker stores each user's monthly report once the month has ended in the
+// user's timezone.
+type SnapshotWorker struct {
+	service  *Service
+	interval time.Duration
+	log      logger.Logger
+	// done holds, per timezone, the end of the last month whose snapshots are complete.
+	done map[string]time.Time
+}
+
+func NewSnapshotWorker(service *Service, interval time.Duration, log logger.Logger) *SnapshotWorker {
+	if interval <= 0 {
+		interval = defaultSnapshotInterval
+	}
+	return &SnapshotWorker{
+		service:  service,
+		interval: interval,
+		log:      log,
+		done:     make(map[string]time.Time),
+	}
+}
+
+// Run takes snapshots once immediately and then on every tick until ctx is cancelled.
+func (w *SnapshotWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.snapshot(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *SnapshotWorker) snapshot(ctx context.Context, now time.Time) {
+	timezones, err := w.service.SnapshotTimezones(ctx)
+	if err != nil {
+		w.log.WithError(err).Errorf("Failed to take report snapshots")
+		return
+	}
+
+	for _, timezone := range timezones {
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			w.log.WithError(err).Warnf("Skipping report snapshots in unknown timezone %s", timezone)
+			continue
+		}
+
+		end := report_domain.PeriodStart(report_domain.ReportTypeMonthly, now.In(location))
+		if w.done[timezone].Equal(end) {
+			continue
+		}
+
+		saved, err := w.service.SnapshotMonthlyReports(ctx, timezone, end)
+		if saved > 0 {
+			w.log.Infof("Saved %d report snapshots for the month ending %s in %s", saved, end.Format(time.DateOnly), timezone)
+		}
+		if err != nil {
+			w.log.WithError(err).Errorf("Failed to take report snapshots in %s", timezone)
+			continue
+		}
+		w.done[timezone] = end
+	}
+}
@@ -0,0 +1,247 @@
+package report_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	report_domain "github.com/Financial-Partner/server/internal/module/report/domain"
+	report_usecase "github.com/Financial-Partner/server/internal/module/report/usecase"
+)
+
+func TestGetReportHistory(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Returns the user's snapshots", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		snapshots := []entities.ReportSnapshot{
+			{ID: primitive.NewObjectID(), UserID: userID, Timezone: "UTC"},
+		}
+		mocks.mockSnapshotRepo.EXPECT().FindSnapshotsByUserId(gomock.Any(), userID).Return(snapshots, nil)
+
+		history, err := service.GetReportHistory(context.Background(), userID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, snapshots, history)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockSnapshotRepo.EXPECT().FindSnapshotsByUserId(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		history, err := service.GetReportHistory(context.Background(), userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, history)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		history, err := service.GetReportHistory(context.Background(), "invalid")
+		assert.Error(t, err)
+		assert.Nil(t, history)
+	})
+}
+
+func TestSnapshotTimezones(t *testing.T) {
+	t.Run("Returns the users' timezones", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockUserRepo.EXPECT().FindTimezones(gomock.Any()).Return([]string{"UTC", "Asia/Taipei"}, nil)
+
+		timezones, err := service.SnapshotTimezones(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"UTC", "Asia/Taipei"}, timezones)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockUserRepo.EXPECT().FindTimezones(gomock.Any()).Return(nil, errors.New("db error"))
+
+		timezones, err := service.SnapshotTimezones(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, timezones)
+	})
+}
+
+func TestSnapshotMonthlyReports(t *testing.T) {
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	require.NoError(t, err)
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, taipei)
+	end := time.Date(2025, time.April, 1, 0, 0, 0, 0, taipei)
+	// Transactions are dated at UTC midnight, so the report covers the UTC dates of
+	// the local month.
+	utcStart := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	utcEnd := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Stores the month's report of each user", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		user := entities.User{ID: primitive.NewObjectID(), CreatedAt: start.AddDate(0, -2, 0)}
+		mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", primitive.NilObjectID, int64(100)).Return([]entities.User{user}, nil)
+		mocks.mockSnapshotRepo.EXPECT().SnapshotExists(gomock.Any(), user.ID, utcStart).Return(false, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), user.ID, utcStart, utcEnd).Return([]entities.CategoryTotal{
+			{Type: entities.TransactionTypeIncome, Category: "Salary", Total: 5000},
+		}, nil)
+		mocks.expectSeries(user.ID, utcStart, utcEnd, report_domain.ReportTypeDaily)
+		mocks.mockSnapshotRepo.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, snapshot *entities.ReportSnapshot) (bool, error) {
+				assert.Equal(t, user.ID, snapshot.UserID)
+				assert.Equal(t, "Asia/Taipei", snapshot.Timezone)
+				assert.Equal(t, utcStart, snapshot.Report.Start)
+				assert.Equal(t, utcEnd, snapshot.Report.End)
+				assert.Equal(t, int64(5000), snapshot.Report.Revenue)
+				assert.False(t, snapshot.CreatedAt.IsZero())
+				return true, nil
+			},
+		)
+
+		saved, err := service.SnapshotMonthlyReports(context.Background(), "Asia/Taipei", end)
+		require.NoError(t, err)
+		assert.Equal(t, 1, saved)
+	})
+
+	t.Run("Pages through users", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		// Users created after the month ended have nothing to snapshot, which keeps
+		// the test down to the paging.
+		firstPage := make([]entities.User, 100)
+		for i := range firstPage {
+			firstPage[i] = entities.User{ID: primitive.NewObjectID(), CreatedAt: end}
+		}
+		secondPage := []entities.User{{ID: primitive.NewObjectID(), CreatedAt: end.Add(time.Hour)}}
+
+		gomock.InOrder(
+			mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", primitive.NilObjectID, int64(100)).Return(firstPage, nil),
+			mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", firstPage[99].ID, int64(100)).Return(secondPage, nil),
+		)
+
+		saved, err := service.SnapshotMonthlyReports(context.Background(), "Asia/Taipei", end)
+		require.NoError(t, err)
+		assert.Equal(t, 0, saved)
+	})
+
+	t.Run("Skips existing snapshots", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		user := entities.User{ID: primitive.NewObjectID(), CreatedAt: start}
+		mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", primitive.NilObjectID, int64(100)).Return([]entities.User{user}, nil)
+		mocks.mockSnapshotRepo.EXPECT().SnapshotExists(gomock.Any(), user.ID, utcStart).Return(true, nil)
+
+		saved, err := service.SnapshotMonthlyReports(context.Background(), "Asia/Taipei", end)
+		require.NoError(t, err)
+		assert.Equal(t, 0, saved)
+	})
+
+	t.Run("Continues after a failed snapshot", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		failing := entities.User{ID: primitive.NewObjectID(), CreatedAt: start}
+		user := entities.User{ID: primitive.NewObjectID(), CreatedAt: start}
+		mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", primitive.NilObjectID, int64(100)).Return([]entities.User{failing, user}, nil)
+		mocks.mockSnapshotRepo.EXPECT().SnapshotExists(gomock.Any(), failing.ID, utcStart).Return(false, errors.New("db error"))
+		mocks.mockSnapshotRepo.EXPECT().SnapshotExists(gomock.Any(), user.ID, utcStart).Return(false, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), user.ID, utcStart, utcEnd).Return(nil, nil)
+		mocks.expectSeries(user.ID, utcStart, utcEnd, report_domain.ReportTypeDaily)
+		mocks.mockSnapshotRepo.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		saved, err := service.SnapshotMonthlyReports(context.Background(), "Asia/Taipei", end)
+		assert.Error(t, err)
+		assert.Equal(t, 1, saved)
+	})
+
+	t.Run("Report error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		user := entities.User{ID: primitive.NewObjectID(), CreatedAt: start}
+		mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", primitive.NilObjectID, int64(100)).Return([]entities.User{user}, nil)
+		mocks.mockSnapshotRepo.EXPECT().SnapshotExists(gomock.Any(), user.ID, utcStart).Return(false, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), user.ID, utcStart, utcEnd).Return(nil, errors.New("db error"))
+
+		saved, err := service.SnapshotMonthlyReports(context.Background(), "Asia/Taipei", end)
+		assert.Error(t, err)
+		assert.Equal(t, 0, saved)
+	})
+
+	t.Run("Store error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		user := entities.User{ID: primitive.NewObjectID(), CreatedAt: start}
+		mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", primitive.NilObjectID, int64(100)).Return([]entities.User{user}, nil)
+		mocks.mockSnapshotRepo.EXPECT().SnapshotExists(gomock.Any(), user.ID, utcStart).Return(false, nil)
+		mocks.mockTransactionRepo.EXPECT().SumAmountByCategory(gomock.Any(), user.ID, utcStart, utcEnd).Return(nil, nil)
+		mocks.expectSeries(user.ID, utcStart, utcEnd, report_domain.ReportTypeDaily)
+		mocks.mockSnapshotRepo.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any()).Return(false, errors.New("db error"))
+
+		saved, err := service.SnapshotMonthlyReports(context.Background(), "Asia/Taipei", end)
+		assert.Error(t, err)
+		assert.Equal(t, 0, saved)
+	})
+
+	t.Run("User repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "Asia/Taipei", primitive.NilObjectID, int64(100)).Return(nil, errors.New("db error"))
+
+		saved, err := service.SnapshotMonthlyReports(context.Background(), "Asia/Taipei", end)
+		assert.Error(t, err)
+		assert.Equal(t, 0, saved)
+	})
+}
+
+func TestSnapshotWorker(t *testing.T) {
+	t.Run("Snapshots each timezone once per month until cancelled", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		gomock.InOrder(
+			mocks.mockUserRepo.EXPECT().FindTimezones(gomock.Any()).Return(nil, errors.New("db error")),
+			mocks.mockUserRepo.EXPECT().FindTimezones(gomock.Any()).Return([]string{"UTC", "Not/A_Zone"}, nil),
+			mocks.mockUserRepo.EXPECT().FindTimezones(gomock.Any()).DoAndReturn(
+				func(context.Context) ([]string, error) {
+					cancel()
+					return []string{"UTC"}, nil
+				},
+			),
+		)
+		// The month ending in UTC is complete after the first pass, so it isn't
+		// scanned again.
+		mocks.mockUserRepo.EXPECT().FindByTimezone(gomock.Any(), "UTC", primitive.NilObjectID, int64(100)).Return(nil, nil).Times(1)
+
+		done := make(chan struct{})
+		go func() {
+			report_usecase.NewSnapshotWorker(service, time.Millisecond, logger.NewNopLogger()).Run(ctx)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("worker did not stop after cancellation")
+		}
+	})
+}
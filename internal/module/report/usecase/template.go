@@ -0,0 +1,72 @@
+package report_usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+)
+
+const summaryDateLayout = "Jan 2, 2006"
+
+// TemplateSummaryGenerator summarizes a report with fixed sentences. It needs no
+// network access and always writes the same summary for the same report.
+type TemplateSummaryGenerator struct{}
+
+func NewTemplateSummaryGenerator() *TemplateSummaryGenerator {
+	return &TemplateSummaryGenerator{}
+}
+
+func (g *TemplateSummaryGenerator) Summarize(_ context.Context, report *entities.Report) (string, error) {
+	period := fmt.Sprintf("From %s to %s", report.Start.Format(summaryDateLayout), report.End.Add(-time.Nanosecond).Format(summaryDateLayout))
+	if report.Revenue == 0 && report.Expenses == 0 {
+		return period + " you recorded no income or expenses.", nil
+	}
+
+	var sentences []string
+	switch {
+	case report.NetProfit < 0:
+		sentences = append(sentences, fmt.Sprintf("%s you earned %s and spent %s, overspending by %s.", period, reportAmount(report, report.Revenue), reportAmount(report, report.Expenses), reportAmount(report, -report.NetProfit)))
+	case report.Revenue > 0:
+		sentences = append(sentences, fmt.Sprintf("%s you earned %s and spent %s, saving %s (%s%% of your income).", period, reportAmount(report, report.Revenue), reportAmount(report, report.Expenses), reportAmount(report, report.NetProfit), formatPercent(float64(report.NetProfit)/float64(report.Revenue)*100)))
+	default:
+		sentences = append(sentences, fmt.Sprintf("%s you earned %s and spent %s.", period, reportAmount(report, report.Revenue), reportAmount(report, report.Expenses)))
+	}
+
+	if len(report.Categories) > 0 {
+		sentences = append(sentences, fmt.Sprintf("Your largest expense was %s at %s%% of spending.", report.Categories[0], formatPercent(report.Percentages[0]*100)))
+	}
+	if change := report.Previous.RevenueChange; change != nil {
+		sentences = append(sentences, describeChange("Income", *change))
+	}
+	if change := report.Previous.ExpensesChange; change != nil {
+		sentences = append(sentences, describeChange("Spending", *change))
+	}
+
+	return strings.Join(sentences, " "), nil
+}
+
+func describeChange(subject string, change float64) string {
+	switch {
+	case change > 0:
+		return fmt.Sprintf("%s was up %s%% on the previous period.", subject, formatPercent(change))
+	case change < 0:
+		return fmt.Sprintf("%s was down %s%% on the previous period.", subject, formatPercent(-change))
+	default:
+		return fmt.Sprintf("%s was unchanged from the previous period.", subject)
+	}
+}
+
+// formatPercent formats a percent rounded to at most one decimal.
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(math.Round(percent*10)/10, 'f', -1, 64)
+}
+
+// reportAmount formats minor units of the report's currency, e.g. "10.50 USD".
+func reportAmount(report *entities.Report, amount int64) string {
+	return entities.Money{Amount: amount, Currency: report.Currency}.String()
+}
@@ -0,0 +1,72 @@
+package report_usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	report_usecase "github.com/Financial-Partner/server/internal/module/report/usecase"
+)
+
+func newSummaryReport() *entities.Report {
+	revenueChange, expensesChange := 25.0, -16.67
+	return &entities.Report{
+		Start:       time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		End:         time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+		Currency:    "USD",
+		Revenue:     5000,
+		Expenses:    2100,
+		NetProfit:   2900,
+		Categories:  []string{"Rent", "Food"},
+		Amounts:     []int64{1500, 600},
+		Percentages: []float64{0.7143, 0.2857},
+		Previous: entities.ReportComparison{
+			Revenue:        4000,
+			Expenses:       2520,
+			NetProfit:      1480,
+			RevenueChange:  &revenueChange,
+			ExpensesChange: &expensesChange,
+		},
+	}
+}
+
+func TestTemplateSummaryGenerator(t *testing.T) {
+	generator := report_usecase.NewTemplateSummaryGenerator()
+
+	t.Run("Savings with comparison", func(t *testing.T) {
+		summary, err := generator.Summarize(context.Background(), newSummaryReport())
+		require.NoError(t, err)
+		assert.Equal(t, "From Mar 1, 2025 to Mar 31, 2025 you earned 50.00 USD and spent 21.00 USD, saving 29.00 USD (58% of your income). "+
+			"Your largest expense was Rent at 71.4% of spending. "+
+			"Income was up 25% on the previous period. "+
+			"Spending was down 16.7% on the previous period.", summary)
+	})
+
+	t.Run("Overspending", func(t *testing.T) {
+		report := newSummaryReport()
+		report.Revenue, report.NetProfit = 1000, -1100
+		zero := 0.0
+		report.Previous = entities.ReportComparison{ExpensesChange: &zero}
+
+		summary, err := generator.Summarize(context.Background(), report)
+		require.NoError(t, err)
+		assert.Equal(t, "From Mar 1, 2025 to Mar 31, 2025 you earned 10.00 USD and spent 21.00 USD, overspending by 11.00 USD. "+
+			"Your largest expense was Rent at 71.4% of spending. "+
+			"Spending was unchanged from the previous period.", summary)
+	})
+
+	t.Run("No activity", func(t *testing.T) {
+		report := newSummaryReport()
+		report.Revenue, report.Expenses, report.NetProfit = 0, 0, 0
+		report.Categories, report.Amounts, report.Percentages = nil, nil, nil
+		report.Previous = entities.ReportComparison{}
+
+		summary, err := generator.Summarize(context.Background(), report)
+		require.NoError(t, err)
+		assert.Equal(t, "From Mar 1, 2025 to Mar 31, 2025 you recorded no income or expenses.", summary)
+	})
+}
@@ -0,0 +1,12 @@
+package transaction_domain
+
+import "errors"
+
+var (
+	ErrTransactionNotFound    = errors.New("transaction not found")
+	ErrInvalidTransactionDate = errors.New("transaction date must be formatted as YYYY-MM-DD")
+	ErrInvalidAmount          = errors.New("invalid transaction amount")
+	ErrInvalidQuery           = errors.New("invalid transaction query")
+	ErrInvalidStatement       = errors.New("invalid bank statement")
+	ErrStatementTooLarge      = errors.New("bank statement has too many transactions")
+)
@@ -0,0 +1,21 @@
+package transaction_domain
+
+import "github.com/Financial-Partner/server/internal/entities"
+
+type EventType string
+
+const (
+	EventTransactionCreated EventType = "transaction.created"
+	EventTransactionUpdated EventType = "transaction.updated"
+	EventTransactionDeleted EventType = "transaction.deleted"
+)
+
+// TransactionEvent is published by the transaction service after a transaction is
+// persisted. A deleted transaction is published as it was before deletion.
+type TransactionEvent struct {
+	Type        EventType
+	Transaction entities.Transaction
+	// Previous is an updated transaction as it was before the update; it is nil for
+	// other events.
+	Previous *entities.Transaction
+}
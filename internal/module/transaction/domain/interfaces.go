@@ -2,6 +2,7 @@ package transaction_domain
 
 import (
 	"context"
+	"io"
 
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
@@ -11,5 +12,14 @@ import (
 
 type TransactionService interface {
 	CreateTransaction(ctx context.Context, UserID string, transaction *dto.CreateTransactionRequest) (*entities.Transaction, error)
-	GetTransactions(ctx context.Context, UserId string) ([]entities.Transaction, error)
+	GetTransactions(ctx context.Context, userID string, query *dto.GetTransactionsQuery) (*entities.TransactionPage, error)
+	GetTransaction(ctx context.Context, userID, transactionID string) (*entities.Transaction, error)
+	UpdateTransaction(ctx context.Context, userID, transactionID string, req *dto.UpdateTransactionRequest) (*entities.Transaction, error)
+	DeleteTransaction(ctx context.Context, userID, transactionID string) error
+	ImportTransactions(ctx context.Context, userID string, statement io.Reader, req *dto.ImportTransactionsRequest) (*entities.TransactionImport, error)
+}
+
+// EventHandler is implemented by modules that react to changes in a user's transactions.
+type EventHandler interface {
+	HandleTransactionEvent(ctx context.Context, event TransactionEvent) error
 }
@@ -2,6 +2,7 @@ package transaction_repository
 
 import (
 	"context"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
@@ -12,11 +13,18 @@ import (
 
 type Repository interface {
 	Create(ctx context.Context, transaction *entities.Transaction) (*entities.Transaction, error)
-	FindByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.Transaction, error)
+	CreateMany(ctx context.Context, transactions []*entities.Transaction) ([]*entities.Transaction, error)
+	Update(ctx context.Context, transaction *entities.Transaction) error
+	Delete(ctx context.Context, userID, transactionID primitive.ObjectID) (*entities.Transaction, error)
+	FindById(ctx context.Context, userID, transactionID primitive.ObjectID) (*entities.Transaction, error)
+	FindByQuery(ctx context.Context, userID primitive.ObjectID, query entities.TransactionQuery) ([]entities.Transaction, error)
+	FindByUserIdBetween(ctx context.Context, userID primitive.ObjectID, start, end time.Time) ([]entities.Transaction, error)
+	SumAmountByCategory(ctx context.Context, userID primitive.ObjectID, start, end time.Time) ([]entities.CategoryTotal, error)
+	SumAmountByInterval(ctx context.Context, userID primitive.ObjectID, start, end time.Time, interval string) ([]entities.ReportBucket, error)
 }
 
 type TransactionStore interface {
-	GetByUserId(ctx context.Context, userID string) ([]entities.Transaction, error)
+	GetPage(ctx context.Context, userID string, query entities.TransactionQuery) (*entities.TransactionPage, error)
+	SetPage(ctx context.Context, userID string, query entities.TransactionQuery, page *entities.TransactionPage) error
 	DeleteByUserId(ctx context.Context, userID string) error
-	SetMultipleByUserId(ctx context.Context, userID string, transactions []entities.Transaction) error
 }
@@ -12,6 +12,7 @@ package transaction_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/Financial-Partner/server/internal/entities"
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
@@ -57,19 +58,123 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, transaction any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, transaction)
 }
 
-// FindByUserId mocks base method.
-func (m *MockRepository) FindByUserId(ctx context.Context, userID primitive.ObjectID) ([]entities.Transaction, error) {
+// CreateMany mocks base method.
+func (m *MockRepository) CreateMany(ctx context.Context, transactions []*entities.Transaction) ([]*entities.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserId", ctx, userID)
+	ret := m.ctrl.Call(m, "CreateMany", ctx, transactions)
+	ret0, _ := ret[0].([]*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockRepositoryMockRecorder) CreateMany(ctx, transactions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockRepository)(nil).CreateMany), ctx, transactions)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, userID, transactionID primitive.ObjectID) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, transactionID)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, userID, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, userID, transactionID)
+}
+
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, userID, transactionID primitive.ObjectID) (*entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, userID, transactionID)
+	ret0, _ := ret[0].(*entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, userID, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, userID, transactionID)
+}
+
+// FindByQuery mocks base method.
+func (m *MockRepository) FindByQuery(ctx context.Context, userID primitive.ObjectID, query entities.TransactionQuery) ([]entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByQuery", ctx, userID, query)
 	ret0, _ := ret[0].([]entities.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByUserId indicates an expected call of FindByUserId.
-func (mr *MockRepositoryMockRecorder) FindByUserId(ctx, userID any) *gomock.Call {
+// FindByQuery indicates an expected call of FindByQuery.
+func (mr *MockRepositoryMockRecorder) FindByQuery(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByQuery", reflect.TypeOf((*MockRepository)(nil).FindByQuery), ctx, userID, query)
+}
+
+// FindByUserIdBetween mocks base method.
+func (m *MockRepository) FindByUserIdBetween(ctx context.Context, userID primitive.ObjectID, start, end time.Time) ([]entities.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIdBetween", ctx, userID, start, end)
+	ret0, _ := ret[0].([]entities.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIdBetween indicates an expected call of FindByUserIdBetween.
+func (mr *MockRepositoryMockRecorder) FindByUserIdBetween(ctx, userID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIdBetween", reflect.TypeOf((*MockRepository)(nil).FindByUserIdBetween), ctx, userID, start, end)
+}
+
+// SumAmountByCategory mocks base method.
+func (m *MockRepository) SumAmountByCategory(ctx context.Context, userID primitive.ObjectID, start, end time.Time) ([]entities.CategoryTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumAmountByCategory", ctx, userID, start, end)
+	ret0, _ := ret[0].([]entities.CategoryTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumAmountByCategory indicates an expected call of SumAmountByCategory.
+func (mr *MockRepositoryMockRecorder) SumAmountByCategory(ctx, userID, start, end any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockRepository)(nil).FindByUserId), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumAmountByCategory", reflect.TypeOf((*MockRepository)(nil).SumAmountByCategory), ctx, userID, start, end)
+}
+
+// SumAmountByInterval mocks base method.
+func (m *MockRepository) SumAmountByInterval(ctx context.Context, userID primitive.ObjectID, start, end time.Time, interval string) ([]entities.ReportBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumAmountByInterval", ctx, userID, start, end, interval)
+	ret0, _ := ret[0].([]entities.ReportBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumAmountByInterval indicates an expected call of SumAmountByInterval.
+func (mr *MockRepositoryMockRecorder) SumAmountByInterval(ctx, userID, start, end, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumAmountByInterval", reflect.TypeOf((*MockRepository)(nil).SumAmountByInterval), ctx, userID, start, end, interval)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, transaction *entities.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, transaction)
 }
 
 // MockTransactionStore is a mock of TransactionStore interface.
@@ -96,34 +201,6 @@ func (m *MockTransactionStore) EXPECT() *MockTransactionStoreMockRecorder {
 	return m.recorder
 }
 
-// AddByUserId mocks base method.
-func (m *MockTransactionStore) AddByUserId(ctx context.Context, userID string, transaction *entities.Transaction) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddByUserId", ctx, userID, transaction)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// AddByUserId indicates an expected call of AddByUserId.
-func (mr *MockTransactionStoreMockRecorder) AddByUserId(ctx, userID, transaction any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddByUserId", reflect.TypeOf((*MockTransactionStore)(nil).AddByUserId), ctx, userID, transaction)
-}
-
-// SetMultipleByUserId mocks base method.
-func (m *MockTransactionStore) SetMultipleByUserId(ctx context.Context, userID string, transactions []entities.Transaction) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetMultipleByUserId", ctx, userID, transactions)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetMultipleByUserId indicates an expected call of SetMultipleByUserId.
-func (mr *MockTransactionStoreMockRecorder) SetMultipleByUserId(ctx, userID, transactions any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultipleByUserId", reflect.TypeOf((*MockTransactionStore)(nil).SetMultipleByUserId), ctx, userID, transactions)
-}
-
 // DeleteByUserId mocks base method.
 func (m *MockTransactionStore) DeleteByUserId(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
@@ -138,17 +215,31 @@ func (mr *MockTransactionStoreMockRecorder) DeleteByUserId(ctx, userID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockTransactionStore)(nil).DeleteByUserId), ctx, userID)
 }
 
-// GetByUserId mocks base method.
-func (m *MockTransactionStore) GetByUserId(ctx context.Context, userID string) ([]entities.Transaction, error) {
+// GetPage mocks base method.
+func (m *MockTransactionStore) GetPage(ctx context.Context, userID string, query entities.TransactionQuery) (*entities.TransactionPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserId", ctx, userID)
-	ret0, _ := ret[0].([]entities.Transaction)
+	ret := m.ctrl.Call(m, "GetPage", ctx, userID, query)
+	ret0, _ := ret[0].(*entities.TransactionPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByUserId indicates an expected call of GetByUserId.
-func (mr *MockTransactionStoreMockRecorder) GetByUserId(ctx, userID any) *gomock.Call {
+// GetPage indicates an expected call of GetPage.
+func (mr *MockTransactionStoreMockRecorder) GetPage(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockTransactionStore)(nil).GetPage), ctx, userID, query)
+}
+
+// SetPage mocks base method.
+func (m *MockTransactionStore) SetPage(ctx context.Context, userID string, query entities.TransactionQuery, page *entities.TransactionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPage", ctx, userID, query, page)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPage indicates an expected call of SetPage.
+func (mr *MockTransactionStoreMockRecorder) SetPage(ctx, userID, query, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserId", reflect.TypeOf((*MockTransactionStore)(nil).GetByUserId), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPage", reflect.TypeOf((*MockTransactionStore)(nil).SetPage), ctx, userID, query, page)
 }
@@ -0,0 +1,217 @@
+package transaction_usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+)
+
+// ImportTransactions reads a bank statement and tells, row by row, whether each
+// transaction is new, already recorded or invalid. With req.Commit it also saves the
+// new ones, so a client previews an import and then sends the same file to commit it.
+func (s *Service) ImportTransactions(ctx context.Context, userID string, statement io.Reader, req *dto.ImportTransactionsRequest) (*entities.TransactionImport, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	user, err := s.userRepo.FindById(ctx, objectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	baseCurrency := user.BaseCurrency()
+	currency := baseCurrency
+	if req.Currency != "" {
+		money, err := parseTransactionAmount(dto.Money{Currency: req.Currency})
+		if err != nil {
+			return nil, err
+		}
+		currency = money.Currency
+	}
+
+	rows, err := readStatement(statement, req.Format, req.Mapping, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.TransactionImport{Rows: make([]entities.ImportRow, len(rows))}
+	rates := &memoRates{RateProvider: s.rates, rates: make(map[string]float64)}
+	now := time.Now().UTC()
+	for i, row := range rows {
+		result.Rows[i] = entities.ImportRow{Line: row.line, Status: entities.ImportRowInvalid}
+		if row.err != nil {
+			result.Rows[i].Error = row.err.Error()
+			continue
+		}
+
+		transaction := row.transaction
+		transaction.UserID = objectID
+		transaction.CreatedAt = now
+		transaction.UpdatedAt = now
+		transaction.BaseAmount, err = convertAmount(ctx, rates, transaction.Amount, baseCurrency, transaction.Date)
+		if errors.Is(err, exchange_domain.ErrRateNotFound) {
+			result.Rows[i].Error = fmt.Sprintf("no %s to %s exchange rate is known for %s",
+				transaction.Amount.Currency, baseCurrency, transaction.Date.Format(time.DateOnly))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Rows[i].Status = entities.ImportRowNew
+		result.Rows[i].Transaction = &transaction
+	}
+
+	if err := s.markDuplicates(ctx, objectID, result.Rows); err != nil {
+		return nil, err
+	}
+	if !req.Commit {
+		return result, nil
+	}
+
+	var transactions []*entities.Transaction
+	for _, row := range result.Rows {
+		if row.Status == entities.ImportRowNew {
+			transactions = append(transactions, row.Transaction)
+		}
+	}
+	if len(transactions) > 0 {
+		inserted, err := s.repo.CreateMany(ctx, transactions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import transactions: %w", err)
+		}
+
+		// The rows another import of the same statement saved in the meantime were
+		// skipped; they are duplicates now.
+		for i, row := range result.Rows {
+			if row.Status == entities.ImportRowNew && row.Transaction.ID.IsZero() {
+				result.Rows[i].Status = entities.ImportRowDuplicate
+			}
+		}
+
+		s.deleteTransactionsFromStore(ctx, userID)
+
+		for _, transaction := range inserted {
+			s.publish(ctx, transaction_domain.EventTransactionCreated, transaction)
+		}
+	}
+	result.Committed = true
+
+	return result, nil
+}
+
+// markDuplicates gives the new rows their import key and marks those that match a
+// transaction the user already has: an imported one with the same key, or else one
+// recorded by hand with the same import hash. Each recorded transaction matches one
+// row at most, so of two identical payments in a statement the second is still
+// imported when only the first was recorded.
+func (s *Service) markDuplicates(ctx context.Context, userID primitive.ObjectID, rows []entities.ImportRow) error {
+	var start, end time.Time
+	for _, row := range rows {
+		if row.Status != entities.ImportRowNew {
+			continue
+		}
+		if start.IsZero() || row.Transaction.Date.Before(start) {
+			start = row.Transaction.Date
+		}
+		if row.Transaction.Date.After(end) {
+			end = row.Transaction.Date
+		}
+	}
+	if start.IsZero() {
+		return nil
+	}
+
+	recorded, err := s.repo.FindByUserIdBetween(ctx, userID, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		return fmt.Errorf("failed to get transactions: %w", err)
+	}
+	imported := make(map[string]bool, len(recorded))
+	unmatched := make(map[string]int, len(recorded))
+	for i := range recorded {
+		if recorded[i].ImportKey != "" {
+			imported[recorded[i].ImportKey] = true
+		} else {
+			unmatched[importHash(&recorded[i])]++
+		}
+	}
+
+	occurrences := make(map[string]int)
+	for i, row := range rows {
+		if row.Status != entities.ImportRowNew {
+			continue
+		}
+		hash := importHash(row.Transaction)
+		occurrences[hash]++
+		row.Transaction.ImportKey = importKey(hash, occurrences[hash])
+
+		switch {
+		case imported[row.Transaction.ImportKey]:
+			rows[i].Status = entities.ImportRowDuplicate
+		case unmatched[hash] > 0:
+			unmatched[hash]--
+			rows[i].Status = entities.ImportRowDuplicate
+		}
+	}
+	return nil
+}
+
+// importKey numbers the statement rows that share an import hash, so that identical
+// payments get distinct keys while every import of the statement gives a row the
+// same key.
+func importKey(hash string, occurrence int) string {
+	return hash + "-" + strconv.Itoa(occurrence)
+}
+
+// importHash identifies a transaction by its date, signed amount and description,
+// which is all a statement reliably tells about it. Descriptions are compared ignoring
+// case and spacing.
+func importHash(transaction *entities.Transaction) string {
+	amount := transaction.Amount.Amount
+	if strings.EqualFold(transaction.Type, entities.TransactionTypeExpense) {
+		amount = -amount
+	}
+	description := strings.Join(strings.Fields(strings.ToLower(transaction.Description)), " ")
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		transaction.Date.UTC().Format(time.DateOnly),
+		strconv.FormatInt(amount, 10),
+		transaction.Amount.Currency,
+		description,
+	}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// memoRates remembers the rates it was asked for, as the rows of a statement often
+// share their currency and date.
+type memoRates struct {
+	exchange_domain.RateProvider
+	rates map[string]float64
+}
+
+func (m *memoRates) Rate(ctx context.Context, from, to string, on time.Time) (float64, error) {
+	key := from + "/" + to + "/" + on.Format(time.DateOnly)
+	if rate, ok := m.rates[key]; ok {
+		return rate, nil
+	}
+
+	rate, err := m.RateProvider.Rate(ctx, from, to, on)
+	if err != nil {
+		return 0, err
+	}
+	m.rates[key] = rate
+	return rate, nil
+}
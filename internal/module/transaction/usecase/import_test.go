@@ -0,0 +1,425 @@
+package transaction_usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+)
+
+const csvStatement = `Date,Description,Amount,Currency
+2024-03-01,Coffee,-3.50,
+2024-03-01,Coffee,-3.50,
+2024-03-02,Salary,"2,500.00",
+2024-03-03,Hotel,-120.00,EUR
+2024-03-03,Museum,-15,eur
+yesterday,Cinema,-12.00,
+2024-03-04,Refund,abc,
+`
+
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<DTSTART>20240301
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240301120000.000[-5:EST]
+<TRNAMT>-42.10
+<FITID>1
+<NAME>Groceries &amp; more
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240302
+<TRNAMT>100
+<FITID>2
+<MEMO>Transfer from savings
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>2024
+<TRNAMT>-1
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const xmlStatement = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+  <CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+    <CURDEF>JPY</CURDEF>
+    <BANKTRANLIST>
+      <STMTTRN>
+        <TRNTYPE>DEBIT</TRNTYPE>
+        <DTPOSTED>20240305</DTPOSTED>
+        <TRNAMT>-1500</TRNAMT>
+        <NAME>Ramen</NAME>
+      </STMTTRN>
+      <STMTTRN>
+        <TRNTYPE>DEBIT</TRNTYPE>
+        <DTPOSTED>20240306</DTPOSTED>
+        <TRNAMT>-1.5</TRNAMT>
+        <NAME>Typo</NAME>
+      </STMTTRN>
+    </BANKTRANLIST>
+  </CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+var csvMapping = &dto.CSVMapping{Date: "date", Amount: "Amount", Description: "Description", Currency: "Currency"}
+
+func day(d int) time.Time {
+	return time.Date(2024, time.March, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestImportTransactions(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	t.Run("Previews a CSV statement", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		// Both EUR rows share the date, so the rate is only looked up once.
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "EUR", "USD", day(3)).Return(1.1, nil)
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(1), day(4)).Return([]entities.Transaction{
+			{Amount: entities.Money{Amount: 350, Currency: "USD"}, Type: "Expense", Date: day(1), Description: " coffee"},
+			{Amount: entities.Money{Amount: 350, Currency: "USD"}, Type: entities.TransactionTypeIncome, Date: day(1), Description: "Coffee"},
+		}, nil)
+
+		result, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(csvStatement), &dto.ImportTransactionsRequest{
+			Format:  "CSV",
+			Mapping: csvMapping,
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Committed)
+		require.Len(t, result.Rows, 7)
+
+		var statuses []string
+		for _, row := range result.Rows {
+			statuses = append(statuses, row.Status)
+		}
+		assert.Equal(t, []string{
+			entities.ImportRowDuplicate, entities.ImportRowNew, entities.ImportRowNew, entities.ImportRowNew,
+			entities.ImportRowNew, entities.ImportRowInvalid, entities.ImportRowInvalid,
+		}, statuses)
+
+		coffee := result.Rows[1]
+		assert.Equal(t, 3, coffee.Line)
+		assert.Equal(t, &entities.Transaction{
+			UserID:      userID,
+			Amount:      entities.Money{Amount: 350, Currency: "USD"},
+			BaseAmount:  entities.Money{Amount: 350, Currency: "USD"},
+			Description: "Coffee",
+			Date:        day(1),
+			Category:    "Uncategorized",
+			Type:        entities.TransactionTypeExpense,
+			ImportKey:   coffee.Transaction.ImportKey,
+			CreatedAt:   coffee.Transaction.CreatedAt,
+			UpdatedAt:   coffee.Transaction.UpdatedAt,
+		}, coffee.Transaction)
+		// Both coffees share the import hash and are numbered in statement order.
+		assert.Regexp(t, `^[0-9a-f]{64}-1$`, result.Rows[0].Transaction.ImportKey)
+		assert.Equal(t, strings.TrimSuffix(result.Rows[0].Transaction.ImportKey, "1")+"2", coffee.Transaction.ImportKey)
+
+		salary := result.Rows[2].Transaction
+		assert.Equal(t, entities.TransactionTypeIncome, salary.Type)
+		assert.Equal(t, entities.Money{Amount: 250000, Currency: "USD"}, salary.Amount)
+
+		museum := result.Rows[4].Transaction
+		assert.Equal(t, entities.Money{Amount: 1500, Currency: "EUR"}, museum.Amount)
+		assert.Equal(t, entities.Money{Amount: 1650, Currency: "USD"}, museum.BaseAmount)
+
+		assert.Equal(t, 7, result.Rows[5].Line)
+		assert.Equal(t, `date "yesterday" is not formatted as YYYY-MM-DD`, result.Rows[5].Error)
+		assert.Nil(t, result.Rows[5].Transaction)
+		assert.Equal(t, `amount "abc" is not a USD amount`, result.Rows[6].Error)
+	})
+
+	t.Run("Commits the new rows", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "EUR")
+
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(1), day(3)).Return([]entities.Transaction{
+			{Amount: entities.Money{Amount: 4210, Currency: "EUR"}, Type: entities.TransactionTypeExpense, Date: day(1), Description: "Groceries & More"},
+		}, nil)
+		var saved []*entities.Transaction
+		mocks.mockRepo.EXPECT().CreateMany(gomock.Any(), gomock.Len(1)).DoAndReturn(
+			func(_ context.Context, transactions []*entities.Transaction) ([]*entities.Transaction, error) {
+				for _, transaction := range transactions {
+					transaction.ID = primitive.NewObjectID()
+				}
+				saved = transactions
+				return transactions, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, event transaction_domain.TransactionEvent) error {
+				assert.Equal(t, transaction_domain.EventTransactionCreated, event.Type)
+				assert.Equal(t, "Transfer from savings", event.Transaction.Description)
+				return nil
+			},
+		)
+
+		// The statement's CURDEF overrides the currency of the request.
+		sgml := strings.Replace(sgmlStatement, "<CURDEF>USD", "<CURDEF>eur", 1)
+		result, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(sgml), &dto.ImportTransactionsRequest{
+			Format:   "qfx",
+			Currency: "USD",
+			Commit:   true,
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Committed)
+		require.Len(t, result.Rows, 3)
+
+		assert.Equal(t, entities.ImportRowDuplicate, result.Rows[0].Status)
+		assert.Equal(t, "Groceries & more", result.Rows[0].Transaction.Description)
+		assert.True(t, result.Rows[0].Transaction.ID.IsZero())
+
+		assert.Equal(t, entities.ImportRowNew, result.Rows[1].Status)
+		assert.Equal(t, saved[0], result.Rows[1].Transaction)
+		assert.False(t, saved[0].ID.IsZero())
+		assert.Equal(t, entities.Money{Amount: 10000, Currency: "EUR"}, saved[0].Amount)
+		assert.Equal(t, entities.TransactionTypeIncome, saved[0].Type)
+
+		assert.Equal(t, 3, result.Rows[2].Line)
+		assert.Equal(t, `posting date "2024" is not a date`, result.Rows[2].Error)
+	})
+
+	t.Run("Commits nothing when no row is new", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "JPY")
+
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(5), day(6)).Return([]entities.Transaction{
+			{Amount: entities.Money{Amount: 1500, Currency: "JPY"}, Type: entities.TransactionTypeExpense, Date: day(5), Description: "RAMEN"},
+		}, nil)
+
+		result, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(xmlStatement), &dto.ImportTransactionsRequest{
+			Format: "ofx",
+			Commit: true,
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Committed)
+		require.Len(t, result.Rows, 2)
+		assert.Equal(t, entities.ImportRowDuplicate, result.Rows[0].Status)
+		assert.Equal(t, `amount "-1.5" is not a JPY amount`, result.Rows[1].Error)
+	})
+
+	t.Run("Matches imported transactions by import key", func(t *testing.T) {
+		statement := "date,amount\n2024-03-01,-1\n2024-03-01,-1\n"
+		req := &dto.ImportTransactionsRequest{Format: "csv", Mapping: &dto.CSVMapping{Date: "date", Amount: "amount"}}
+
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(1), day(2)).Return(nil, nil)
+
+		preview, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(statement), req)
+		require.NoError(t, err)
+		require.Len(t, preview.Rows, 2)
+		assert.NotEqual(t, preview.Rows[0].Transaction.ImportKey, preview.Rows[1].Transaction.ImportKey)
+
+		// Only the second of the identical payments is left after the user deleted
+		// the first, so the first is imported again.
+		mocks = NewMocks(t)
+		service = mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(1), day(2)).Return([]entities.Transaction{
+			*preview.Rows[1].Transaction,
+		}, nil)
+
+		result, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(statement), req)
+		require.NoError(t, err)
+		require.Len(t, result.Rows, 2)
+		assert.Equal(t, entities.ImportRowNew, result.Rows[0].Status)
+		assert.Equal(t, entities.ImportRowDuplicate, result.Rows[1].Status)
+	})
+
+	t.Run("Rows another import saved first are duplicates", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(1), day(2)).Return(nil, nil)
+		mocks.mockRepo.EXPECT().CreateMany(gomock.Any(), gomock.Len(2)).DoAndReturn(
+			func(_ context.Context, transactions []*entities.Transaction) ([]*entities.Transaction, error) {
+				transactions[0].ID = primitive.NewObjectID()
+				return transactions[:1], nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		result, err := service.ImportTransactions(context.Background(), userID.Hex(),
+			strings.NewReader("date,amount\n2024-03-01,-1\n2024-03-01,2\n"),
+			&dto.ImportTransactionsRequest{Format: "csv", Mapping: &dto.CSVMapping{Date: "date", Amount: "amount"}, Commit: true})
+		require.NoError(t, err)
+		assert.True(t, result.Committed)
+		require.Len(t, result.Rows, 2)
+		assert.Equal(t, entities.ImportRowNew, result.Rows[0].Status)
+		assert.Equal(t, entities.ImportRowDuplicate, result.Rows[1].Status)
+	})
+
+	t.Run("Reads types from a type column", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "GBP", "USD", day(2)).Return(1.25, nil)
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(2), day(3)).Return(nil, nil)
+
+		statement := "\ufeffBooked,Payee,Sum,Kind,Tag\n2/3/2024,Bakery,-4.00,Debit,Food\n02/03/2024,Employer,10,CR,\n03/03/2024,Shop,1,Other,\n"
+		result, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(statement), &dto.ImportTransactionsRequest{
+			Format:   "csv",
+			Currency: "gbp",
+			Mapping: &dto.CSVMapping{
+				Date:        "Booked",
+				Amount:      "Sum",
+				Description: "Payee",
+				Category:    "Tag",
+				Type:        " kind ",
+				DateFormat:  "dd/mm/yyyy",
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Rows, 3)
+
+		bakery := result.Rows[0].Transaction
+		assert.Equal(t, entities.TransactionTypeExpense, bakery.Type)
+		assert.Equal(t, entities.Money{Amount: 400, Currency: "GBP"}, bakery.Amount)
+		assert.Equal(t, entities.Money{Amount: 500, Currency: "USD"}, bakery.BaseAmount)
+		assert.Equal(t, "Food", bakery.Category)
+		assert.Equal(t, entities.TransactionTypeIncome, result.Rows[1].Transaction.Type)
+		assert.Equal(t, `type "Other" is neither income nor expense`, result.Rows[2].Error)
+	})
+
+	t.Run("Rows without an exchange rate are invalid", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "CHF", "USD", day(1)).Return(0.0, exchange_domain.ErrRateNotFound).Times(2)
+
+		result, err := service.ImportTransactions(context.Background(), userID.Hex(),
+			strings.NewReader("date,amount\n2024-03-01,1\n2024-03-01,2\n2024-03-01,0\n"),
+			&dto.ImportTransactionsRequest{Format: "csv", Currency: "CHF", Mapping: &dto.CSVMapping{Date: "date", Amount: "amount"}})
+		require.NoError(t, err)
+		require.Len(t, result.Rows, 3)
+		assert.Equal(t, "no CHF to USD exchange rate is known for 2024-03-01", result.Rows[0].Error)
+		assert.Equal(t, entities.ImportRowInvalid, result.Rows[1].Status)
+		assert.Equal(t, `amount "0" is zero`, result.Rows[2].Error)
+	})
+
+	t.Run("Rejects statements that can't be read", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			statement string
+			req       dto.ImportTransactionsRequest
+		}{
+			"unknown format":      {csvStatement, dto.ImportTransactionsRequest{Format: "xlsx", Mapping: csvMapping}},
+			"no mapping":          {csvStatement, dto.ImportTransactionsRequest{Format: "csv"}},
+			"incomplete mapping":  {csvStatement, dto.ImportTransactionsRequest{Format: "csv", Mapping: &dto.CSVMapping{Date: "Date"}}},
+			"missing column":      {csvStatement, dto.ImportTransactionsRequest{Format: "csv", Mapping: &dto.CSVMapping{Date: "Date", Amount: "Value"}}},
+			"unknown date format": {csvStatement, dto.ImportTransactionsRequest{Format: "csv", Mapping: &dto.CSVMapping{Date: "Date", Amount: "Amount", DateFormat: "DD-MM-YY"}}},
+			"empty CSV":           {"", dto.ImportTransactionsRequest{Format: "csv", Mapping: csvMapping}},
+			"malformed CSV":       {"Date,Amount\n2024-03-01,\"1\n", dto.ImportTransactionsRequest{Format: "csv", Mapping: &dto.CSVMapping{Date: "Date", Amount: "Amount"}}},
+			"not OFX":             {csvStatement, dto.ImportTransactionsRequest{Format: "ofx"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				mocks := NewMocks(t)
+				service := mocks.newService()
+				mocks.expectBaseCurrency(userID, "USD")
+
+				result, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(tc.statement), &tc.req)
+				assert.ErrorIs(t, err, transaction_domain.ErrInvalidStatement)
+				assert.Nil(t, result)
+			})
+		}
+	})
+
+	t.Run("Rejects statements with too many transactions", func(t *testing.T) {
+		csv := "date,amount\n" + strings.Repeat("2024-03-01,1\n", 1001)
+		ofx := "<OFX>" + strings.Repeat("<STMTTRN><DTPOSTED>20240301<TRNAMT>1</STMTTRN>", 1001)
+
+		for format, statement := range map[string]string{"csv": csv, "ofx": ofx} {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+			mocks.expectBaseCurrency(userID, "USD")
+
+			_, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(statement), &dto.ImportTransactionsRequest{
+				Format:  format,
+				Mapping: &dto.CSVMapping{Date: "date", Amount: "amount"},
+			})
+			assert.ErrorIs(t, err, transaction_domain.ErrStatementTooLarge, format)
+		}
+	})
+
+	t.Run("Rejects an invalid currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		_, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(csvStatement), &dto.ImportTransactionsRequest{
+			Format:   "csv",
+			Mapping:  csvMapping,
+			Currency: "dollars",
+		})
+		assert.ErrorIs(t, err, transaction_domain.ErrInvalidAmount)
+	})
+
+	t.Run("Fails on repository errors", func(t *testing.T) {
+		dbErr := errors.New("database error")
+
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "JPY")
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(5), day(6)).Return(nil, dbErr)
+
+		_, err := service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(xmlStatement), &dto.ImportTransactionsRequest{Format: "ofx"})
+		assert.ErrorIs(t, err, dbErr)
+
+		mocks = NewMocks(t)
+		service = mocks.newService()
+		mocks.expectBaseCurrency(userID, "JPY")
+		mocks.mockRepo.EXPECT().FindByUserIdBetween(gomock.Any(), userID, day(5), day(6)).Return(nil, nil)
+		mocks.mockRepo.EXPECT().CreateMany(gomock.Any(), gomock.Len(1)).Return(nil, dbErr)
+
+		_, err = service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(xmlStatement), &dto.ImportTransactionsRequest{Format: "ofx", Commit: true})
+		assert.ErrorIs(t, err, dbErr)
+
+		mocks = NewMocks(t)
+		service = mocks.newService()
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(nil, dbErr)
+
+		_, err = service.ImportTransactions(context.Background(), userID.Hex(), strings.NewReader(xmlStatement), &dto.ImportTransactionsRequest{Format: "ofx"})
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		_, err := service.ImportTransactions(context.Background(), "invalid", strings.NewReader(xmlStatement), &dto.ImportTransactionsRequest{Format: "ofx"})
+		assert.Error(t, err)
+	})
+}
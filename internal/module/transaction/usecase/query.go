@@ -0,0 +1,165 @@
+package transaction_usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	sortAscending  = "asc"
+	sortDescending = "desc"
+)
+
+// GetTransactions returns a page of the user's transactions matching the query,
+// newest first unless the query asks for ascending order.
+func (s *Service) GetTransactions(ctx context.Context, userID string, req *dto.GetTransactionsQuery) (*entities.TransactionPage, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	query, err := parseQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedPage, err := s.store.GetPage(ctx, userID, query)
+	if err == nil && cachedPage != nil {
+		return cachedPage, nil
+	}
+
+	// One transaction more than the page holds tells whether another page follows.
+	lookahead := query
+	lookahead.Limit++
+	transactions, err := s.repo.FindByQuery(ctx, objectID, lookahead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	page := &entities.TransactionPage{Transactions: transactions}
+	if len(transactions) > query.Limit {
+		page.Transactions = transactions[:query.Limit]
+		last := page.Transactions[query.Limit-1]
+		page.NextCursor = encodeCursor(entities.TransactionCursor{Date: last.Date, ID: last.ID})
+	}
+
+	if cacheErr := s.store.SetPage(ctx, userID, query, page); cacheErr != nil {
+		s.log.Warnf("Failed to cache transactions for userID %s: %v", userID, cacheErr)
+	}
+
+	return page, nil
+}
+
+// parseQuery validates the listing parameters. Dates are inclusive days, so To is
+// moved to the start of the following day.
+func parseQuery(req *dto.GetTransactionsQuery) (entities.TransactionQuery, error) {
+	query := entities.TransactionQuery{
+		Category: strings.TrimSpace(req.Category),
+		Search:   strings.TrimSpace(req.Search),
+		Limit:    defaultPageSize,
+	}
+
+	var err error
+	if req.From != "" {
+		if query.From, err = time.Parse(time.DateOnly, req.From); err != nil {
+			return query, fmt.Errorf("%w: from must be formatted as YYYY-MM-DD", transaction_domain.ErrInvalidQuery)
+		}
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.DateOnly, req.To)
+		if err != nil {
+			return query, fmt.Errorf("%w: to must be formatted as YYYY-MM-DD", transaction_domain.ErrInvalidQuery)
+		}
+		query.To = to.AddDate(0, 0, 1)
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return query, fmt.Errorf("%w: from must not be after to", transaction_domain.ErrInvalidQuery)
+	}
+
+	switch transactionType := strings.ToLower(req.Type); transactionType {
+	case "", entities.TransactionTypeIncome, entities.TransactionTypeExpense:
+		query.Type = transactionType
+	default:
+		return query, fmt.Errorf("%w: type must be income or expense", transaction_domain.ErrInvalidQuery)
+	}
+
+	if query.MinAmount, err = parseAmount("min_amount", req.MinAmount); err != nil {
+		return query, err
+	}
+	if query.MaxAmount, err = parseAmount("max_amount", req.MaxAmount); err != nil {
+		return query, err
+	}
+	if query.MinAmount != nil && query.MaxAmount != nil && *query.MinAmount > *query.MaxAmount {
+		return query, fmt.Errorf("%w: min_amount must not exceed max_amount", transaction_domain.ErrInvalidQuery)
+	}
+
+	switch req.Sort {
+	case "", sortDescending:
+	case sortAscending:
+		query.Ascending = true
+	default:
+		return query, fmt.Errorf("%w: sort must be asc or desc", transaction_domain.ErrInvalidQuery)
+	}
+
+	if req.Limit != "" {
+		limit, err := strconv.Atoi(req.Limit)
+		if err != nil || limit < 1 || limit > maxPageSize {
+			return query, fmt.Errorf("%w: limit must be between 1 and %d", transaction_domain.ErrInvalidQuery, maxPageSize)
+		}
+		query.Limit = limit
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return query, err
+		}
+		query.After = &cursor
+	}
+
+	return query, nil
+}
+
+func parseAmount(name, value string) (*int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be an integer", transaction_domain.ErrInvalidQuery, name)
+	}
+	return &amount, nil
+}
+
+// encodeCursor packs the position into an opaque URL-safe token: the date in Unix
+// nanoseconds followed by the ID.
+func encodeCursor(cursor entities.TransactionCursor) string {
+	data := binary.BigEndian.AppendUint64(nil, uint64(cursor.Date.UnixNano()))
+	data = append(data, cursor.ID[:]...)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string) (entities.TransactionCursor, error) {
+	var cursor entities.TransactionCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) != 8+len(cursor.ID) {
+		return cursor, fmt.Errorf("%w: malformed cursor", transaction_domain.ErrInvalidQuery)
+	}
+	cursor.Date = time.Unix(0, int64(binary.BigEndian.Uint64(data[:8]))).UTC()
+	copy(cursor.ID[:], data[8:])
+	return cursor, nil
+}
@@ -0,0 +1,163 @@
+package transaction_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+)
+
+func TestGetTransactions(t *testing.T) {
+	userID := primitive.NewObjectID()
+
+	newTransactions := func(n int) []entities.Transaction {
+		transactions := make([]entities.Transaction, n)
+		for i := range transactions {
+			transactions[i] = entities.Transaction{
+				ID:     primitive.NewObjectID(),
+				UserID: userID,
+				Amount: entities.Money{Amount: int64(100 * (i + 1)), Currency: "USD"},
+				Date:   time.Date(2023, time.January, 31-i, 0, 0, 0, 0, time.UTC),
+			}
+		}
+		return transactions
+	}
+
+	t.Run("Returns a cached page", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		page := &entities.TransactionPage{Transactions: newTransactions(1)}
+		mocks.mockStore.EXPECT().GetPage(gomock.Any(), userID.Hex(), entities.TransactionQuery{Limit: 20}).Return(page, nil)
+
+		result, err := service.GetTransactions(context.Background(), userID.Hex(), &dto.GetTransactionsQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+	})
+
+	t.Run("Pages through the repository and caches the page", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		transactions := newTransactions(3)
+		query := entities.TransactionQuery{Limit: 2}
+		mocks.mockStore.EXPECT().GetPage(gomock.Any(), userID.Hex(), query).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindByQuery(gomock.Any(), userID, entities.TransactionQuery{Limit: 3}).Return(transactions, nil)
+		mocks.mockStore.EXPECT().SetPage(gomock.Any(), userID.Hex(), query, gomock.Any()).Return(errors.New("cache error"))
+
+		first, err := service.GetTransactions(context.Background(), userID.Hex(), &dto.GetTransactionsQuery{Limit: "2"})
+		require.NoError(t, err)
+		assert.Equal(t, transactions[:2], first.Transactions)
+		require.NotEmpty(t, first.NextCursor)
+
+		// The cursor resumes after the last transaction of the first page.
+		next := entities.TransactionQuery{
+			Limit: 2,
+			After: &entities.TransactionCursor{Date: transactions[1].Date, ID: transactions[1].ID},
+		}
+		mocks.mockStore.EXPECT().GetPage(gomock.Any(), userID.Hex(), next).Return(nil, errors.New("redis: nil"))
+		lookahead := next
+		lookahead.Limit = 3
+		mocks.mockRepo.EXPECT().FindByQuery(gomock.Any(), userID, lookahead).Return(transactions[2:], nil)
+		mocks.mockStore.EXPECT().SetPage(gomock.Any(), userID.Hex(), next, &entities.TransactionPage{Transactions: transactions[2:]}).Return(nil)
+
+		second, err := service.GetTransactions(context.Background(), userID.Hex(), &dto.GetTransactionsQuery{Limit: "2", Cursor: first.NextCursor})
+		require.NoError(t, err)
+		assert.Equal(t, transactions[2:], second.Transactions)
+		assert.Empty(t, second.NextCursor)
+	})
+
+	t.Run("Parses filters", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		minAmount, maxAmount := int64(100), int64(100)
+		query := entities.TransactionQuery{
+			From:      time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			To:        time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Category:  "Food",
+			Type:      entities.TransactionTypeExpense,
+			MinAmount: &minAmount,
+			MaxAmount: &maxAmount,
+			Search:    "lunch",
+			Ascending: true,
+			Limit:     50,
+		}
+		mocks.mockStore.EXPECT().GetPage(gomock.Any(), userID.Hex(), query).Return(nil, errors.New("redis: nil"))
+		lookahead := query
+		lookahead.Limit = 51
+		mocks.mockRepo.EXPECT().FindByQuery(gomock.Any(), userID, lookahead).Return(nil, nil)
+		mocks.mockStore.EXPECT().SetPage(gomock.Any(), userID.Hex(), query, gomock.Any()).Return(nil)
+
+		result, err := service.GetTransactions(context.Background(), userID.Hex(), &dto.GetTransactionsQuery{
+			From:      "2023-01-01",
+			To:        "2023-01-31",
+			Category:  " Food ",
+			Type:      "Expense",
+			MinAmount: "100",
+			MaxAmount: "100",
+			Search:    "lunch",
+			Sort:      "asc",
+			Limit:     "50",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Transactions)
+		assert.Empty(t, result.NextCursor)
+	})
+
+	invalidQueries := map[string]dto.GetTransactionsQuery{
+		"Malformed from":         {From: "01/01/2023"},
+		"Malformed to":           {To: "tomorrow"},
+		"From after to":          {From: "2023-02-01", To: "2023-01-31"},
+		"Unknown type":           {Type: "transfer"},
+		"Malformed min amount":   {MinAmount: "ten"},
+		"Malformed max amount":   {MaxAmount: "1.5"},
+		"Min above max":          {MinAmount: "200", MaxAmount: "100"},
+		"Unknown sort":           {Sort: "amount"},
+		"Malformed limit":        {Limit: "all"},
+		"Limit too small":        {Limit: "0"},
+		"Limit too large":        {Limit: "101"},
+		"Malformed cursor":       {Cursor: "not a cursor"},
+		"Cursor of wrong length": {Cursor: "AAAA"},
+	}
+	for name, req := range invalidQueries {
+		t.Run(name, func(t *testing.T) {
+			mocks := NewMocks(t)
+			service := mocks.newService()
+
+			result, err := service.GetTransactions(context.Background(), userID.Hex(), &req)
+			assert.ErrorIs(t, err, transaction_domain.ErrInvalidQuery)
+			assert.Nil(t, result)
+		})
+	}
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockStore.EXPECT().GetPage(gomock.Any(), userID.Hex(), gomock.Any()).Return(nil, errors.New("redis: nil"))
+		mocks.mockRepo.EXPECT().FindByQuery(gomock.Any(), userID, gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.GetTransactions(context.Background(), userID.Hex(), &dto.GetTransactionsQuery{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		result, err := service.GetTransactions(context.Background(), "invalid", &dto.GetTransactionsQuery{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
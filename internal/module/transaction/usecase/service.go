@@ -2,34 +2,56 @@ package transaction_usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/infrastructure/logger"
 	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
 	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
+	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type Service struct {
-	repo  transaction_repository.Repository
-	store transaction_repository.TransactionStore
-	log   logger.Logger
+	repo          transaction_repository.Repository
+	store         transaction_repository.TransactionStore
+	userRepo      user_repository.Repository
+	rates         exchange_domain.RateProvider
+	log           logger.Logger
+	eventHandlers []transaction_domain.EventHandler
 }
 
-func NewService(repo transaction_repository.Repository, store transaction_repository.TransactionStore, log logger.Logger) *Service {
+func NewService(
+	repo transaction_repository.Repository,
+	store transaction_repository.TransactionStore,
+	userRepo user_repository.Repository,
+	rates exchange_domain.RateProvider,
+	log logger.Logger,
+	eventHandlers ...transaction_domain.EventHandler,
+) *Service {
 	return &Service{
-		repo:  repo,
-		store: store,
-		log:   log,
+		repo:          repo,
+		store:         store,
+		userRepo:      userRepo,
+		rates:         rates,
+		log:           log,
+		eventHandlers: eventHandlers,
 	}
 }
 
 func (s *Service) CreateTransaction(ctx context.Context, userID string, req *dto.CreateTransactionRequest) (*entities.Transaction, error) {
-	transactionDate, err := time.Parse("2006-01-02", req.Date)
+	transactionDate, err := parseTransactionDate(req.Date)
 	if err != nil {
-		return nil, fmt.Errorf("invalid date format: %w", err)
+		return nil, err
+	}
+	amount, err := parseTransactionAmount(req.Amount)
+	if err != nil {
+		return nil, err
 	}
 
 	objectID, err := primitive.ObjectIDFromHex(userID)
@@ -37,13 +59,19 @@ func (s *Service) CreateTransaction(ctx context.Context, userID string, req *dto
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	baseAmount, err := s.toBaseCurrency(ctx, objectID, amount, transactionDate)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert DTO to Entity
 	transaction := &entities.Transaction{
 		UserID:      objectID,
-		Amount:      req.Amount,
+		Amount:      amount,
+		BaseAmount:  baseAmount,
 		Category:    req.Category,
 		Type:        req.Type,
-		Date:        transactionDate.UTC(),
+		Date:        transactionDate,
 		Description: req.Description,
 		CreatedAt:   time.Now().UTC(),
 		UpdatedAt:   time.Now().UTC(),
@@ -55,37 +83,201 @@ func (s *Service) CreateTransaction(ctx context.Context, userID string, req *dto
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
 
-	cacheErr := s.store.DeleteByUserId(ctx, userID)
-	if cacheErr != nil {
-		s.log.Warnf("Failed to delete transaction cache for userID %s: %v", userID, cacheErr)
+	s.deleteTransactionsFromStore(ctx, userID)
+
+	s.publish(ctx, transaction_domain.EventTransactionCreated, createdTransaction)
+
+	return createdTransaction, nil
+}
+
+// RecordTransaction saves a transaction made on the user's behalf, such as an
+// investment payout, converting its amount into the user's base currency as
+// CreateTransaction does. It may run inside a database transaction that is later
+// rolled back, so clearing the cache and notifying handlers is left to
+// TransactionRecorded.
+func (s *Service) RecordTransaction(ctx context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+	baseAmount, err := s.toBaseCurrency(ctx, transaction.UserID, transaction.Amount, transaction.Date)
+	if err != nil {
+		return nil, err
 	}
+	transaction.BaseAmount = baseAmount
 
+	createdTransaction, err := s.repo.Create(ctx, transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
 	return createdTransaction, nil
 }
 
-func (s *Service) GetTransactions(ctx context.Context, userID string) ([]entities.Transaction, error) {
-	objectID, err := primitive.ObjectIDFromHex(userID)
+// TransactionRecorded clears the user's cached transactions and notifies handlers of a
+// transaction saved with RecordTransaction, once it has been committed.
+func (s *Service) TransactionRecorded(ctx context.Context, transaction *entities.Transaction) {
+	s.deleteTransactionsFromStore(ctx, transaction.UserID.Hex())
+
+	s.publish(ctx, transaction_domain.EventTransactionCreated, transaction)
+}
+
+func (s *Service) GetTransaction(ctx context.Context, userID, transactionID string) (*entities.Transaction, error) {
+	userObjectID, transactionObjectID, err := parseTransactionIDs(userID, transactionID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %w", err)
+		return nil, err
+	}
+
+	transaction, err := s.repo.FindById(ctx, userObjectID, transactionObjectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, transaction_domain.ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+func (s *Service) UpdateTransaction(ctx context.Context, userID, transactionID string, req *dto.UpdateTransactionRequest) (*entities.Transaction, error) {
+	transactionDate, err := parseTransactionDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseTransactionAmount(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetTransaction(ctx, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAmount, err := s.toBaseCurrency(ctx, transaction.UserID, amount, transactionDate)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := *transaction
+	transaction.Amount = amount
+	transaction.BaseAmount = baseAmount
+	transaction.Category = req.Category
+	transaction.Type = req.Type
+	transaction.Date = transactionDate
+	transaction.Description = req.Description
+
+	err = s.repo.Update(ctx, transaction)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, transaction_domain.ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	s.deleteTransactionsFromStore(ctx, userID)
+
+	s.notify(ctx, transaction_domain.TransactionEvent{
+		Type:        transaction_domain.EventTransactionUpdated,
+		Transaction: *transaction,
+		Previous:    &previous,
+	})
+
+	return transaction, nil
+}
+
+func (s *Service) DeleteTransaction(ctx context.Context, userID, transactionID string) error {
+	userObjectID, transactionObjectID, err := parseTransactionIDs(userID, transactionID)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := s.repo.Delete(ctx, userObjectID, transactionObjectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return transaction_domain.ErrTransactionNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete transaction: %w", err)
+	}
+
+	s.deleteTransactionsFromStore(ctx, userID)
+
+	s.publish(ctx, transaction_domain.EventTransactionDeleted, transaction)
+
+	return nil
+}
+
+// publish notifies the registered handlers of an event about the transaction.
+func (s *Service) publish(ctx context.Context, eventType transaction_domain.EventType, transaction *entities.Transaction) {
+	s.notify(ctx, transaction_domain.TransactionEvent{
+		Type:        eventType,
+		Transaction: *transaction,
+	})
+}
+
+// notify passes the event to the registered handlers. A failing handler must not undo
+// the already persisted transaction, so errors are only logged.
+func (s *Service) notify(ctx context.Context, event transaction_domain.TransactionEvent) {
+	for _, handler := range s.eventHandlers {
+		if err := handler.HandleTransactionEvent(ctx, event); err != nil {
+			s.log.WithError(err).Warnf("Failed to handle %s event for transaction %s", event.Type, event.Transaction.ID.Hex())
+		}
+	}
+}
+
+func (s *Service) deleteTransactionsFromStore(ctx context.Context, userID string) {
+	if cacheErr := s.store.DeleteByUserId(ctx, userID); cacheErr != nil {
+		s.log.Warnf("Failed to delete transaction cache for userID %s: %v", userID, cacheErr)
 	}
-	// Check if transactions are cached
-	cachedTransactions, err := s.store.GetByUserId(ctx, userID)
-	if err != nil && err.Error() != "redis: nil" {
-		return nil, fmt.Errorf("failed to get cached transactions: %w", err)
+}
+
+func parseTransactionDate(date string) (time.Time, error) {
+	transactionDate, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", transaction_domain.ErrInvalidTransactionDate, err)
+	}
+	return transactionDate.UTC(), nil
+}
+
+func parseTransactionAmount(amount dto.Money) (entities.Money, error) {
+	money, err := entities.NewMoney(amount.Amount, amount.Currency)
+	if err != nil {
+		return entities.Money{}, fmt.Errorf("%w: %v", transaction_domain.ErrInvalidAmount, err)
+	}
+	return money, nil
+}
+
+// toBaseCurrency converts the amount into the user's base currency at the rate of the
+// transaction date.
+func (s *Service) toBaseCurrency(ctx context.Context, userID primitive.ObjectID, amount entities.Money, date time.Time) (entities.Money, error) {
+	user, err := s.userRepo.FindById(ctx, userID)
+	if err != nil {
+		return entities.Money{}, fmt.Errorf("failed to get user: %w", err)
 	}
-	if cachedTransactions != nil {
-		return cachedTransactions, nil
+
+	return convertAmount(ctx, s.rates, amount, user.BaseCurrency(), date)
+}
+
+func convertAmount(ctx context.Context, rates exchange_domain.RateProvider, amount entities.Money, currency string, date time.Time) (entities.Money, error) {
+	if amount.Currency == currency {
+		return amount, nil
 	}
-	// If not cached, fetch from the repository
-	transactions, err := s.repo.FindByUserId(ctx, objectID)
+
+	rate, err := rates.Rate(ctx, amount.Currency, currency, date)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get transactions: %w", err)
+		return entities.Money{}, err
 	}
 
-	// Cache the fetched transactions
-	cacheErr := s.store.SetMultipleByUserId(ctx, userID, transactions)
-	if cacheErr != nil {
-		s.log.Warnf("Failed to cache transaction for userID %s: %v", userID, cacheErr)
+	baseAmount, err := amount.Convert(currency, rate)
+	if err != nil {
+		return entities.Money{}, fmt.Errorf("%w: %v", transaction_domain.ErrInvalidAmount, err)
+	}
+	return baseAmount, nil
+}
+
+func parseTransactionIDs(userID, transactionID string) (primitive.ObjectID, primitive.ObjectID, error) {
+	userObjectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, fmt.Errorf("invalid user ID: %w", err)
+	}
+	transactionObjectID, err := primitive.ObjectIDFromHex(transactionID)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, transaction_domain.ErrTransactionNotFound
 	}
-	return transactions, nil
+	return userObjectID, transactionObjectID, nil
 }
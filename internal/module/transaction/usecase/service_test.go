@@ -0,0 +1,469 @@
+package transaction_usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	exchange_domain "github.com/Financial-Partner/server/internal/module/exchange/domain"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+	transaction_repository "github.com/Financial-Partner/server/internal/module/transaction/repository"
+	transaction_usecase "github.com/Financial-Partner/server/internal/module/transaction/usecase"
+	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
+)
+
+type Mocks struct {
+	ctrl         *gomock.Controller
+	mockRepo     *transaction_repository.MockRepository
+	mockStore    *transaction_repository.MockTransactionStore
+	mockUserRepo *user_repository.MockRepository
+	mockRates    *exchange_domain.MockRateProvider
+	mockHandler  *transaction_domain.MockEventHandler
+}
+
+func NewMocks(t *testing.T) *Mocks {
+	ctrl := gomock.NewController(t)
+
+	return &Mocks{
+		ctrl:         ctrl,
+		mockRepo:     transaction_repository.NewMockRepository(ctrl),
+		mockStore:    transaction_repository.NewMockTransactionStore(ctrl),
+		mockUserRepo: user_repository.NewMockRepository(ctrl),
+		mockRates:    exchange_domain.NewMockRateProvider(ctrl),
+		mockHandler:  transaction_domain.NewMockEventHandler(ctrl),
+	}
+}
+
+func (m *Mocks) newService() *transaction_usecase.Service {
+	return transaction_usecase.NewService(m.mockRepo, m.mockStore, m.mockUserRepo, m.mockRates, logger.NewNopLogger(), m.mockHandler)
+}
+
+// expectBaseCurrency expects the user to be looked up for their base currency.
+func (m *Mocks) expectBaseCurrency(userID primitive.ObjectID, currency string) {
+	m.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(&entities.User{ID: userID, Currency: currency}, nil)
+}
+
+func TestCreateTransaction(t *testing.T) {
+	userID := primitive.NewObjectID()
+	req := &dto.CreateTransactionRequest{
+		Amount:      dto.Money{Amount: 1000, Currency: "USD"},
+		Category:    "Food",
+		Type:        entities.TransactionTypeExpense,
+		Date:        "2023-01-01",
+		Description: "Lunch",
+	}
+
+	t.Run("Publishes created event", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				transaction.ID = primitive.NewObjectID()
+				return transaction, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, event transaction_domain.TransactionEvent) error {
+				assert.Equal(t, transaction_domain.EventTransactionCreated, event.Type)
+				assert.Equal(t, userID, event.Transaction.UserID)
+				assert.Equal(t, entities.Money{Amount: 1000, Currency: "USD"}, event.Transaction.Amount)
+				return nil
+			},
+		)
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), req)
+		require.NoError(t, err)
+		assert.Equal(t, userID, result.UserID)
+	})
+
+	t.Run("Handler error does not fail creation", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				return transaction, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(errors.New("cache error"))
+		mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), gomock.Any()).Return(errors.New("handler error"))
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("Converts into the user's base currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		foreign := *req
+		foreign.Amount = dto.Money{Amount: 1000, Currency: "eur"}
+
+		mocks.expectBaseCurrency(userID, "TWD")
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "EUR", "TWD", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)).Return(34.5, nil)
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				return transaction, nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), &foreign)
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 1000, Currency: "EUR"}, result.Amount)
+		assert.Equal(t, entities.Money{Amount: 34500, Currency: "TWD"}, result.BaseAmount)
+	})
+
+	t.Run("Exchange rate not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		foreign := *req
+		foreign.Amount = dto.Money{Amount: 1000, Currency: "EUR"}
+
+		mocks.expectBaseCurrency(userID, "")
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "EUR", "USD", gomock.Any()).Return(0.0, exchange_domain.ErrRateNotFound)
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), &foreign)
+		assert.ErrorIs(t, err, exchange_domain.ErrRateNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("User error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockUserRepo.EXPECT().FindById(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		invalid := *req
+		invalid.Date = "01/01/2023"
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), &invalid)
+		assert.ErrorIs(t, err, transaction_domain.ErrInvalidTransactionDate)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		invalid := *req
+		invalid.Amount = dto.Money{Amount: 1000, Currency: "dollars"}
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), &invalid)
+		assert.ErrorIs(t, err, transaction_domain.ErrInvalidAmount)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error skips handlers", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.CreateTransaction(context.Background(), userID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRecordTransaction(t *testing.T) {
+	userID := primitive.NewObjectID()
+	date := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	newPayout := func() *entities.Transaction {
+		return &entities.Transaction{
+			UserID: userID,
+			Amount: entities.Money{Amount: 1200, Currency: "EUR"},
+			Type:   entities.TransactionTypeIncome,
+			Date:   date,
+		}
+	}
+
+	t.Run("Converts into the user's base currency", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.expectBaseCurrency(userID, "TWD")
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "EUR", "TWD", date).Return(34.5, nil)
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) (*entities.Transaction, error) {
+				return transaction, nil
+			},
+		)
+
+		result, err := service.RecordTransaction(context.Background(), newPayout())
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 41400, Currency: "TWD"}, result.BaseAmount)
+	})
+
+	t.Run("Exchange rate not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.expectBaseCurrency(userID, "")
+		mocks.mockRates.EXPECT().Rate(gomock.Any(), "EUR", "USD", date).Return(0.0, exchange_domain.ErrRateNotFound)
+
+		result, err := service.RecordTransaction(context.Background(), newPayout())
+		assert.ErrorIs(t, err, exchange_domain.ErrRateNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.expectBaseCurrency(userID, "EUR")
+		mocks.mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := service.RecordTransaction(context.Background(), newPayout())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTransactionRecorded(t *testing.T) {
+	mocks := NewMocks(t)
+	service := mocks.newService()
+	transaction := &entities.Transaction{ID: primitive.NewObjectID(), UserID: primitive.NewObjectID()}
+
+	mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), transaction.UserID.Hex()).Return(nil)
+	mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), transaction_domain.TransactionEvent{
+		Type:        transaction_domain.EventTransactionCreated,
+		Transaction: *transaction,
+	}).Return(nil)
+
+	service.TransactionRecorded(context.Background(), transaction)
+}
+
+func TestGetTransaction(t *testing.T) {
+	userID := primitive.NewObjectID()
+	transactionID := primitive.NewObjectID()
+
+	t.Run("Returns the user's transaction", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		transaction := &entities.Transaction{ID: transactionID, UserID: userID, Amount: entities.Money{Amount: 1000, Currency: "USD"}}
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, transactionID).Return(transaction, nil)
+
+		result, err := service.GetTransaction(context.Background(), userID.Hex(), transactionID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, transaction, result)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, transactionID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := service.GetTransaction(context.Background(), userID.Hex(), transactionID.Hex())
+		assert.ErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid transaction ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		result, err := service.GetTransaction(context.Background(), userID.Hex(), "invalid")
+		assert.ErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Invalid user ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		result, err := service.GetTransaction(context.Background(), "invalid", transactionID.Hex())
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, transactionID).Return(nil, errors.New("db error"))
+
+		result, err := service.GetTransaction(context.Background(), userID.Hex(), transactionID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestUpdateTransaction(t *testing.T) {
+	userID := primitive.NewObjectID()
+	transactionID := primitive.NewObjectID()
+	req := &dto.UpdateTransactionRequest{
+		Amount:      dto.Money{Amount: 1200, Currency: "USD"},
+		Category:    "Food",
+		Type:        entities.TransactionTypeExpense,
+		Date:        "2023-01-02",
+		Description: "Dinner",
+	}
+
+	t.Run("Updates the transaction and publishes updated event", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, transactionID).Return(&entities.Transaction{
+			ID:     transactionID,
+			UserID: userID,
+			Amount: entities.Money{Amount: 12000, Currency: "USD"},
+			Type:   entities.TransactionTypeExpense,
+		}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, transaction *entities.Transaction) error {
+				assert.Equal(t, transactionID, transaction.ID)
+				assert.Equal(t, userID, transaction.UserID)
+				assert.Equal(t, entities.Money{Amount: 1200, Currency: "USD"}, transaction.Amount)
+				assert.Equal(t, entities.Money{Amount: 1200, Currency: "USD"}, transaction.BaseAmount)
+				assert.Equal(t, "Dinner", transaction.Description)
+				assert.Equal(t, time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC), transaction.Date)
+				return nil
+			},
+		)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(nil)
+		mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, event transaction_domain.TransactionEvent) error {
+				assert.Equal(t, transaction_domain.EventTransactionUpdated, event.Type)
+				assert.Equal(t, entities.Money{Amount: 1200, Currency: "USD"}, event.Transaction.Amount)
+				require.NotNil(t, event.Previous)
+				assert.Equal(t, entities.Money{Amount: 12000, Currency: "USD"}, event.Previous.Amount)
+				return nil
+			},
+		)
+
+		result, err := service.UpdateTransaction(context.Background(), userID.Hex(), transactionID.Hex(), req)
+		require.NoError(t, err)
+		assert.Equal(t, entities.Money{Amount: 1200, Currency: "USD"}, result.Amount)
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		invalid := *req
+		invalid.Date = "yesterday"
+
+		result, err := service.UpdateTransaction(context.Background(), userID.Hex(), transactionID.Hex(), &invalid)
+		assert.ErrorIs(t, err, transaction_domain.ErrInvalidTransactionDate)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, transactionID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := service.UpdateTransaction(context.Background(), userID.Hex(), transactionID.Hex(), req)
+		assert.ErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Deleted before update", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+		mocks.expectBaseCurrency(userID, "USD")
+
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, transactionID).Return(&entities.Transaction{ID: transactionID, UserID: userID}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(mongo.ErrNoDocuments)
+
+		result, err := service.UpdateTransaction(context.Background(), userID.Hex(), transactionID.Hex(), req)
+		assert.ErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.expectBaseCurrency(userID, "USD")
+		mocks.mockRepo.EXPECT().FindById(gomock.Any(), userID, transactionID).Return(&entities.Transaction{ID: transactionID, UserID: userID}, nil)
+		mocks.mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		result, err := service.UpdateTransaction(context.Background(), userID.Hex(), transactionID.Hex(), req)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestDeleteTransaction(t *testing.T) {
+	userID := primitive.NewObjectID()
+	transactionID := primitive.NewObjectID()
+
+	t.Run("Deletes the transaction and publishes deleted event", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		deleted := &entities.Transaction{ID: transactionID, UserID: userID, Amount: entities.Money{Amount: 1000, Currency: "USD"}}
+		mocks.mockRepo.EXPECT().Delete(gomock.Any(), userID, transactionID).Return(deleted, nil)
+		mocks.mockStore.EXPECT().DeleteByUserId(gomock.Any(), userID.Hex()).Return(errors.New("cache error"))
+		mocks.mockHandler.EXPECT().HandleTransactionEvent(gomock.Any(), transaction_domain.TransactionEvent{
+			Type:        transaction_domain.EventTransactionDeleted,
+			Transaction: *deleted,
+		}).Return(nil)
+
+		err := service.DeleteTransaction(context.Background(), userID.Hex(), transactionID.Hex())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().Delete(gomock.Any(), userID, transactionID).Return(nil, mongo.ErrNoDocuments)
+
+		err := service.DeleteTransaction(context.Background(), userID.Hex(), transactionID.Hex())
+		assert.ErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+	})
+
+	t.Run("Invalid transaction ID", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		err := service.DeleteTransaction(context.Background(), userID.Hex(), "invalid")
+		assert.ErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		mocks := NewMocks(t)
+		service := mocks.newService()
+
+		mocks.mockRepo.EXPECT().Delete(gomock.Any(), userID, transactionID).Return(nil, errors.New("db error"))
+
+		err := service.DeleteTransaction(context.Background(), userID.Hex(), transactionID.Hex())
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, transaction_domain.ErrTransactionNotFound)
+	})
+}
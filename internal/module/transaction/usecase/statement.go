@@ -0,0 +1,284 @@
+package transaction_usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Financial-Partner/server/internal/entities"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	transaction_domain "github.com/Financial-Partner/server/internal/module/transaction/domain"
+)
+
+// maxStatementRows bounds the number of transactions imported from one statement.
+const maxStatementRows = 1000
+
+// statementCategory is the category of imported transactions the statement doesn't
+// categorize.
+const statementCategory = "Uncategorized"
+
+// csvDateFormats maps the date formats a CSV mapping can name to their layouts. The
+// layouts with slashes and dots accept days and months without a leading zero.
+var csvDateFormats = map[string]string{
+	"YYYY-MM-DD": time.DateOnly,
+	"YYYY/MM/DD": "2006/01/02",
+	"YYYYMMDD":   "20060102",
+	"MM/DD/YYYY": "1/2/2006",
+	"DD/MM/YYYY": "2/1/2006",
+	"DD.MM.YYYY": "2.1.2006",
+}
+
+// ofxElement matches a start or end tag and the text following it. OFX 1.x is SGML
+// whose leaf elements have no end tags, so the text up to the next tag is the value.
+var ofxElement = regexp.MustCompile(`<(/?)([A-Za-z0-9.]+)>([^<]*)`)
+
+// statementRow is a transaction read from a bank statement, or why it couldn't be read.
+type statementRow struct {
+	line        int
+	transaction entities.Transaction
+	err         error
+}
+
+// readStatement reads the transactions of a statement whose amounts are in currency
+// unless it says otherwise. Errors in single rows are reported with the row; only a
+// statement that can't be read at all fails.
+func readStatement(r io.Reader, format string, mapping *dto.CSVMapping, currency string) ([]statementRow, error) {
+	switch strings.ToLower(format) {
+	case "csv":
+		return readCSVStatement(r, mapping, currency)
+	case "ofx", "qfx":
+		return readOFXStatement(r, currency)
+	}
+	return nil, fmt.Errorf("%w: unsupported format %q", transaction_domain.ErrInvalidStatement, format)
+}
+
+// csvColumns holds the indexes of the mapped columns, -1 for those not mapped.
+type csvColumns struct {
+	date, amount, description, category, kind, currency int
+}
+
+func readCSVStatement(r io.Reader, mapping *dto.CSVMapping, currency string) ([]statementRow, error) {
+	if mapping == nil || mapping.Date == "" || mapping.Amount == "" {
+		return nil, fmt.Errorf("%w: the column mapping must name the date and amount columns", transaction_domain.ErrInvalidStatement)
+	}
+	dateFormat := strings.ToUpper(mapping.DateFormat)
+	if dateFormat == "" {
+		dateFormat = "YYYY-MM-DD"
+	}
+	layout, ok := csvDateFormats[dateFormat]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported date format %q", transaction_domain.ErrInvalidStatement, mapping.DateFormat)
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read header: %v", transaction_domain.ErrInvalidStatement, err)
+	}
+	columns, err := mapCSVColumns(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []statementRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", transaction_domain.ErrInvalidStatement, err)
+		}
+		if len(rows) == maxStatementRows {
+			return nil, transaction_domain.ErrStatementTooLarge
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := statementRow{line: line}
+		row.transaction, row.err = columns.transaction(record, layout, dateFormat, currency)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// mapCSVColumns finds the mapped columns in the header, ignoring case and surrounding
+// space.
+func mapCSVColumns(header []string, mapping *dto.CSVMapping) (csvColumns, error) {
+	indexes := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			// Spreadsheet programs may start the file with a byte order mark.
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		indexes[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	var missing []string
+	index := func(name string) int {
+		if name == "" {
+			return -1
+		}
+		i, ok := indexes[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			missing = append(missing, fmt.Sprintf("%q", name))
+			return -1
+		}
+		return i
+	}
+	columns := csvColumns{
+		date:        index(mapping.Date),
+		amount:      index(mapping.Amount),
+		description: index(mapping.Description),
+		category:    index(mapping.Category),
+		kind:        index(mapping.Type),
+		currency:    index(mapping.Currency),
+	}
+	if len(missing) > 0 {
+		return csvColumns{}, fmt.Errorf("%w: the header has no column %s", transaction_domain.ErrInvalidStatement, strings.Join(missing, ", "))
+	}
+	return columns, nil
+}
+
+func (c csvColumns) transaction(record []string, layout, dateFormat, currency string) (entities.Transaction, error) {
+	field := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	date, err := time.Parse(layout, field(c.date))
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("date %q is not formatted as %s", field(c.date), dateFormat)
+	}
+	if code := field(c.currency); code != "" {
+		currency = strings.ToUpper(code)
+	}
+	amount, err := parseStatementAmount(field(c.amount), currency)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	kind := ""
+	if c.kind >= 0 {
+		switch value := field(c.kind); strings.ToLower(value) {
+		case "income", "credit", "cr":
+			kind = entities.TransactionTypeIncome
+		case "expense", "debit", "dr":
+			kind = entities.TransactionTypeExpense
+		default:
+			return entities.Transaction{}, fmt.Errorf("type %q is neither income nor expense", value)
+		}
+	}
+
+	return newStatementTransaction(date, amount, kind, field(c.description), field(c.category)), nil
+}
+
+// readOFXStatement reads the transactions of an OFX or QFX file, either the SGML of
+// OFX 1.x or the XML of OFX 2.x. A statement's CURDEF overrides currency.
+func readOFXStatement(r io.Reader, currency string) ([]statementRow, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", transaction_domain.ErrInvalidStatement, err)
+	}
+	if !bytes.Contains(bytes.ToUpper(data), []byte("<OFX>")) {
+		return nil, fmt.Errorf("%w: not an OFX file", transaction_domain.ErrInvalidStatement)
+	}
+
+	var rows []statementRow
+	var fields map[string]string
+	for _, match := range ofxElement.FindAllSubmatch(data, -1) {
+		end := len(match[1]) > 0
+		name := strings.ToUpper(string(match[2]))
+		value := html.UnescapeString(strings.TrimSpace(string(match[3])))
+
+		switch {
+		case name == "STMTTRN" && !end:
+			fields = make(map[string]string)
+		case name == "STMTTRN" && fields != nil:
+			if len(rows) == maxStatementRows {
+				return nil, transaction_domain.ErrStatementTooLarge
+			}
+			row := statementRow{line: len(rows) + 1}
+			row.transaction, row.err = ofxTransaction(fields, currency)
+			rows = append(rows, row)
+			fields = nil
+		case name == "CURDEF" && !end && value != "":
+			currency = strings.ToUpper(value)
+		case fields != nil && !end && value != "":
+			fields[name] = value
+		}
+	}
+	return rows, nil
+}
+
+func ofxTransaction(fields map[string]string, currency string) (entities.Transaction, error) {
+	posted := fields["DTPOSTED"]
+	if len(posted) < 8 {
+		return entities.Transaction{}, fmt.Errorf("posting date %q is not a date", posted)
+	}
+	// Only the date matters; the time and time zone that may follow it are ignored.
+	date, err := time.Parse("20060102", posted[:8])
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("posting date %q is not a date", posted)
+	}
+
+	amount, err := parseStatementAmount(fields["TRNAMT"], currency)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	description := fields["NAME"]
+	if description == "" {
+		description = fields["MEMO"]
+	}
+	return newStatementTransaction(date, amount, "", description, ""), nil
+}
+
+func parseStatementAmount(amount, currency string) (entities.Money, error) {
+	money, err := entities.ParseMoney(amount, currency)
+	if errors.Is(err, entities.ErrInvalidCurrency) {
+		return entities.Money{}, fmt.Errorf("currency %q is not an ISO 4217 code", currency)
+	}
+	if err != nil {
+		return entities.Money{}, fmt.Errorf("amount %q is not a %s amount", amount, currency)
+	}
+	if money.IsZero() {
+		return entities.Money{}, fmt.Errorf("amount %q is zero", amount)
+	}
+	return money, nil
+}
+
+// newStatementTransaction returns the transaction of a statement row. Without a kind,
+// the sign of the amount tells income from expenses.
+func newStatementTransaction(date time.Time, amount entities.Money, kind, description, category string) entities.Transaction {
+	if kind == "" {
+		kind = entities.TransactionTypeIncome
+		if amount.Amount < 0 {
+			kind = entities.TransactionTypeExpense
+		}
+	}
+	if amount.Amount < 0 {
+		amount.Amount = -amount.Amount
+	}
+	if category == "" {
+		category = statementCategory
+	}
+
+	return entities.Transaction{
+		Amount:      amount,
+		Category:    category,
+		Type:        kind,
+		Date:        date.UTC(),
+		Description: description,
+	}
+}
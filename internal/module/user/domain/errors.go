@@ -0,0 +1,15 @@
+package user_domain
+
+import "errors"
+
+var (
+	ErrUserNotFound        = errors.New("user not found")
+	ErrInsufficientBalance = errors.New("insufficient wallet balance")
+	ErrCurrencyMismatch    = errors.New("savings are held in another currency")
+	ErrCharacterNotFound   = errors.New("character not found")
+	ErrCharacterNotOwned   = errors.New("character has not been unlocked")
+	ErrCharacterExists     = errors.New("character already exists")
+	ErrInvalidTimezone     = errors.New("invalid timezone")
+	ErrInvalidCurrency     = errors.New("invalid currency")
+	ErrCurrencyLocked      = errors.New("currency can't change while savings are held")
+)
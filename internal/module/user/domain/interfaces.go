@@ -11,5 +11,6 @@ import (
 type UserService interface {
 	GetUser(ctx context.Context, email string) (*entities.User, error)
 	GetOrCreateUser(ctx context.Context, email, name string) (*entities.User, error)
-	UpdateUserName(ctx context.Context, email, name string) (*entities.User, error)
+	UpdateWallet(ctx context.Context, userID string, diamonds int64, savings entities.Money) (*entities.User, error)
+	UnlockCharacter(ctx context.Context, userID, characterID string) error
 }
@@ -71,17 +71,31 @@ func (mr *MockUserServiceMockRecorder) GetUser(ctx, email any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserService)(nil).GetUser), ctx, email)
 }
 
-// UpdateUserName mocks base method.
-func (m *MockUserService) UpdateUserName(ctx context.Context, email, name string) (*entities.User, error) {
+// UnlockCharacter mocks base method.
+func (m *MockUserService) UnlockCharacter(ctx context.Context, userID, characterID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserName", ctx, email, name)
+	ret := m.ctrl.Call(m, "UnlockCharacter", ctx, userID, characterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockCharacter indicates an expected call of UnlockCharacter.
+func (mr *MockUserServiceMockRecorder) UnlockCharacter(ctx, userID, characterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockCharacter", reflect.TypeOf((*MockUserService)(nil).UnlockCharacter), ctx, userID, characterID)
+}
+
+// UpdateWallet mocks base method.
+func (m *MockUserService) UpdateWallet(ctx context.Context, userID string, diamonds int64, savings entities.Money) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWallet", ctx, userID, diamonds, savings)
 	ret0, _ := ret[0].(*entities.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUserName indicates an expected call of UpdateUserName.
-func (mr *MockUserServiceMockRecorder) UpdateUserName(ctx, email, name any) *gomock.Call {
+// UpdateWallet indicates an expected call of UpdateWallet.
+func (mr *MockUserServiceMockRecorder) UpdateWallet(ctx, userID, diamonds, savings any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserName", reflect.TypeOf((*MockUserService)(nil).UpdateUserName), ctx, email, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWallet", reflect.TypeOf((*MockUserService)(nil).UpdateWallet), ctx, userID, diamonds, savings)
 }
@@ -3,6 +3,8 @@ package user_repository
 import (
 	"context"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/Financial-Partner/server/internal/entities"
 )
 
@@ -10,8 +12,22 @@ import (
 
 type Repository interface {
 	FindByEmail(ctx context.Context, email string) (*entities.User, error)
+	FindById(ctx context.Context, id primitive.ObjectID) (*entities.User, error)
 	Create(ctx context.Context, entity *entities.User) (*entities.User, error)
 	Update(ctx context.Context, entity *entities.User) error
+	UpdateWallet(ctx context.Context, id primitive.ObjectID, diamonds int64, savings entities.Money) (*entities.User, error)
+	AddCharacter(ctx context.Context, id primitive.ObjectID, characterID string) (*entities.User, error)
+	EquipCharacter(ctx context.Context, id primitive.ObjectID, character entities.Character) (*entities.User, error)
+	UpdateTimezone(ctx context.Context, id primitive.ObjectID, timezone string) (*entities.User, error)
+	UpdateProfile(ctx context.Context, id primitive.ObjectID, name, currency string) (*entities.User, error)
+	FindTimezones(ctx context.Context) ([]string, error)
+	FindByTimezone(ctx context.Context, timezone string, afterID primitive.ObjectID, limit int64) ([]entities.User, error)
+}
+
+type CharacterRepository interface {
+	FindById(ctx context.Context, id string) (*entities.Character, error)
+	FindByIds(ctx context.Context, ids []string) ([]entities.Character, error)
+	Create(ctx context.Context, character *entities.Character) (*entities.Character, error)
 }
 
 type UserStore interface {
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entities "github.com/Financial-Partner/server/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +42,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddCharacter mocks base method.
+func (m *MockRepository) AddCharacter(ctx context.Context, id primitive.ObjectID, characterID string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCharacter", ctx, id, characterID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCharacter indicates an expected call of AddCharacter.
+func (mr *MockRepositoryMockRecorder) AddCharacter(ctx, id, characterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCharacter", reflect.TypeOf((*MockRepository)(nil).AddCharacter), ctx, id, characterID)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(ctx context.Context, entity *entities.User) (*entities.User, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +72,21 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, entity any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, entity)
 }
 
+// EquipCharacter mocks base method.
+func (m *MockRepository) EquipCharacter(ctx context.Context, id primitive.ObjectID, character entities.Character) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EquipCharacter", ctx, id, character)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EquipCharacter indicates an expected call of EquipCharacter.
+func (mr *MockRepositoryMockRecorder) EquipCharacter(ctx, id, character any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EquipCharacter", reflect.TypeOf((*MockRepository)(nil).EquipCharacter), ctx, id, character)
+}
+
 // FindByEmail mocks base method.
 func (m *MockRepository) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +102,51 @@ func (mr *MockRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockRepository)(nil).FindByEmail), ctx, email)
 }
 
+// FindById mocks base method.
+func (m *MockRepository) FindById(ctx context.Context, id primitive.ObjectID) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepository)(nil).FindById), ctx, id)
+}
+
+// FindByTimezone mocks base method.
+func (m *MockRepository) FindByTimezone(ctx context.Context, timezone string, afterID primitive.ObjectID, limit int64) ([]entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTimezone", ctx, timezone, afterID, limit)
+	ret0, _ := ret[0].([]entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTimezone indicates an expected call of FindByTimezone.
+func (mr *MockRepositoryMockRecorder) FindByTimezone(ctx, timezone, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTimezone", reflect.TypeOf((*MockRepository)(nil).FindByTimezone), ctx, timezone, afterID, limit)
+}
+
+// FindTimezones mocks base method.
+func (m *MockRepository) FindTimezones(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTimezones", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTimezones indicates an expected call of FindTimezones.
+func (mr *MockRepositoryMockRecorder) FindTimezones(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTimezones", reflect.TypeOf((*MockRepository)(nil).FindTimezones), ctx)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, entity *entities.User) error {
 	m.ctrl.T.Helper()
@@ -85,6 +161,120 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, entity any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, entity)
 }
 
+// UpdateProfile mocks base method.
+func (m *MockRepository) UpdateProfile(ctx context.Context, id primitive.ObjectID, name, currency string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, id, name, currency)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockRepositoryMockRecorder) UpdateProfile(ctx, id, name, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockRepository)(nil).UpdateProfile), ctx, id, name, currency)
+}
+
+// UpdateTimezone mocks base method.
+func (m *MockRepository) UpdateTimezone(ctx context.Context, id primitive.ObjectID, timezone string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", ctx, id, timezone)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockRepositoryMockRecorder) UpdateTimezone(ctx, id, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockRepository)(nil).UpdateTimezone), ctx, id, timezone)
+}
+
+// UpdateWallet mocks base method.
+func (m *MockRepository) UpdateWallet(ctx context.Context, id primitive.ObjectID, diamonds int64, savings entities.Money) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWallet", ctx, id, diamonds, savings)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWallet indicates an expected call of UpdateWallet.
+func (mr *MockRepositoryMockRecorder) UpdateWallet(ctx, id, diamonds, savings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWallet", reflect.TypeOf((*MockRepository)(nil).UpdateWallet), ctx, id, diamonds, savings)
+}
+
+// MockCharacterRepository is a mock of CharacterRepository interface.
+type MockCharacterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharacterRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCharacterRepositoryMockRecorder is the mock recorder for MockCharacterRepository.
+type MockCharacterRepositoryMockRecorder struct {
+	mock *MockCharacterRepository
+}
+
+// NewMockCharacterRepository creates a new mock instance.
+func NewMockCharacterRepository(ctrl *gomock.Controller) *MockCharacterRepository {
+	mock := &MockCharacterRepository{ctrl: ctrl}
+	mock.recorder = &MockCharacterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharacterRepository) EXPECT() *MockCharacterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCharacterRepository) Create(ctx context.Context, character *entities.Character) (*entities.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, character)
+	ret0, _ := ret[0].(*entities.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCharacterRepositoryMockRecorder) Create(ctx, character any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCharacterRepository)(nil).Create), ctx, character)
+}
+
+// FindById mocks base method.
+func (m *MockCharacterRepository) FindById(ctx context.Context, id string) (*entities.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*entities.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockCharacterRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockCharacterRepository)(nil).FindById), ctx, id)
+}
+
+// FindByIds mocks base method.
+func (m *MockCharacterRepository) FindByIds(ctx context.Context, ids []string) ([]entities.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIds", ctx, ids)
+	ret0, _ := ret[0].([]entities.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIds indicates an expected call of FindByIds.
+func (mr *MockCharacterRepositoryMockRecorder) FindByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIds", reflect.TypeOf((*MockCharacterRepository)(nil).FindByIds), ctx, ids)
+}
+
 // MockUserStore is a mock of UserStore interface.
 type MockUserStore struct {
 	ctrl     *gomock.Controller
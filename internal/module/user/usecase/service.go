@@ -2,12 +2,18 @@ package user_usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
 	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 const (
@@ -15,16 +21,18 @@ const (
 )
 
 type Service struct {
-	repo  user_repository.Repository
-	store user_repository.UserStore
-	log   logger.Logger
+	repo          user_repository.Repository
+	characterRepo user_repository.CharacterRepository
+	store         user_repository.UserStore
+	log           logger.Logger
 }
 
-func NewService(repo user_repository.Repository, store user_repository.UserStore, log logger.Logger) *Service {
+func NewService(repo user_repository.Repository, characterRepo user_repository.CharacterRepository, store user_repository.UserStore, log logger.Logger) *Service {
 	return &Service{
-		repo:  repo,
-		store: store,
-		log:   log,
+		repo:          repo,
+		characterRepo: characterRepo,
+		store:         store,
+		log:           log,
 	}
 }
 
@@ -67,9 +75,10 @@ func (s *Service) GetOrCreateUser(ctx context.Context, email, name string) (*ent
 		ID:    primitive.NewObjectID(),
 		Email: email,
 		Name:  name,
+		Role:  entities.UserRoleUser,
 		Wallet: entities.Wallet{
 			Diamonds: 0,
-			Savings:  0,
+			Savings:  entities.Money{Amount: 0, Currency: entities.DefaultCurrency},
 		},
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -88,8 +97,219 @@ func (s *Service) GetOrCreateUser(ctx context.Context, email, name string) (*ent
 	return entity, nil
 }
 
-func (s *Service) UpdateUserName(ctx context.Context, id, name string) (*entities.User, error) {
-	return nil, nil
+// UpdateUser changes the user's name and base currency. The currency can only change
+// while the user has no savings, which are kept in it and aren't converted.
+func (s *Service) UpdateUser(ctx context.Context, userID string, req *dto.UpdateUserRequest) (*entities.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	var currency string
+	if req.Currency != "" {
+		money, err := entities.NewMoney(0, req.Currency)
+		if err != nil {
+			return nil, user_domain.ErrInvalidCurrency
+		}
+		currency = money.Currency
+	}
+
+	entity, err := s.repo.UpdateProfile(ctx, objectID, strings.TrimSpace(req.Name), currency)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, s.profileUpdateError(ctx, objectID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	s.deleteUserFromStore(ctx, entity.Email)
+
+	return entity, nil
+}
+
+// profileUpdateError tells why a profile update matched no user: the user doesn't
+// exist or holds savings in another currency.
+func (s *Service) profileUpdateError(ctx context.Context, id primitive.ObjectID) error {
+	_, err := s.repo.FindById(ctx, id)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return user_domain.ErrUserNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	return user_domain.ErrCurrencyLocked
+}
+
+// UpdateWallet credits (positive) or debits (negative) the user's diamonds and savings.
+// Savings are debited only in the currency they are held in.
+func (s *Service) UpdateWallet(ctx context.Context, userID string, diamonds int64, savings entities.Money) (*entities.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	entity, err := s.repo.UpdateWallet(ctx, objectID, diamonds, savings)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, s.walletUpdateError(ctx, objectID, diamonds, savings)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update wallet: %w", err)
+	}
+
+	// The wallet may be updated inside a transaction that is later rolled back,
+	// so drop the cached user instead of caching a balance that might not stick.
+	s.deleteUserFromStore(ctx, entity.Email)
+
+	return entity, nil
+}
+
+// walletUpdateError tells why a wallet update matched no user: the user doesn't
+// exist, holds savings in another currency or can't afford the debit.
+func (s *Service) walletUpdateError(ctx context.Context, id primitive.ObjectID, diamonds int64, savings entities.Money) error {
+	if !savings.IsZero() {
+		entity, err := s.repo.FindById(ctx, id)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return user_domain.ErrUserNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if entity.Wallet.Savings.Currency != savings.Currency {
+			return user_domain.ErrCurrencyMismatch
+		}
+	}
+	if diamonds < 0 || savings.Amount < 0 {
+		return user_domain.ErrInsufficientBalance
+	}
+	return user_domain.ErrUserNotFound
+}
+
+// GetCharacters lists the characters the user has unlocked.
+func (s *Service) GetCharacters(ctx context.Context, userID string) ([]entities.Character, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	entity, err := s.repo.FindById(ctx, objectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, user_domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if len(entity.OwnedCharacters) == 0 {
+		return []entities.Character{}, nil
+	}
+
+	characters, err := s.characterRepo.FindByIds(ctx, entity.OwnedCharacters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters: %w", err)
+	}
+
+	return characters, nil
+}
+
+// EquipCharacter makes one of the user's unlocked characters their equipped character.
+func (s *Service) EquipCharacter(ctx context.Context, userID, characterID string) (*entities.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	character, err := s.characterRepo.FindById(ctx, characterID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, user_domain.ErrCharacterNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get character: %w", err)
+	}
+
+	entity, err := s.repo.EquipCharacter(ctx, objectID, *character)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, user_domain.ErrCharacterNotOwned
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to equip character: %w", err)
+	}
+
+	s.deleteUserFromStore(ctx, entity.Email)
+
+	return entity, nil
+}
+
+// UpdateTimezone sets the timezone the user's monthly reports follow. The timezone must
+// be an IANA name such as "Asia/Taipei".
+func (s *Service) UpdateTimezone(ctx context.Context, userID, timezone string) (*entities.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if timezone == "" || timezone == "Local" {
+		return nil, user_domain.ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, user_domain.ErrInvalidTimezone
+	}
+
+	entity, err := s.repo.UpdateTimezone(ctx, objectID, timezone)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, user_domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update timezone: %w", err)
+	}
+
+	s.deleteUserFromStore(ctx, entity.Email)
+
+	return entity, nil
+}
+
+// CreateCharacter adds a character to the catalog.
+func (s *Service) CreateCharacter(ctx context.Context, req *dto.CreateCharacterRequest) (*entities.Character, error) {
+	_, err := s.characterRepo.FindById(ctx, req.ID)
+	if err == nil {
+		return nil, user_domain.ErrCharacterExists
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to get character: %w", err)
+	}
+
+	character, err := s.characterRepo.Create(ctx, &entities.Character{
+		ID:       req.ID,
+		Name:     req.Name,
+		ImageURL: req.ImageURL,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, user_domain.ErrCharacterExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create character: %w", err)
+	}
+
+	return character, nil
+}
+
+// UnlockCharacter adds a character to the user's collection. Unlocking a character
+// the user already owns has no effect.
+func (s *Service) UnlockCharacter(ctx context.Context, userID, characterID string) error {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	entity, err := s.repo.AddCharacter(ctx, objectID, characterID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return user_domain.ErrUserNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unlock character: %w", err)
+	}
+
+	s.deleteUserFromStore(ctx, entity.Email)
+
+	return nil
 }
 
 func (s *Service) setUserToStore(ctx context.Context, entity *entities.User) {
@@ -99,6 +319,12 @@ func (s *Service) setUserToStore(ctx context.Context, entity *entities.User) {
 	}
 }
 
+func (s *Service) deleteUserFromStore(ctx context.Context, email string) {
+	if err := s.store.Delete(ctx, email); err != nil {
+		s.log.WithError(err).Warnf("Failed to delete user from store")
+	}
+}
+
 // getBypassUser returns a fake/bypass user without accessing the database
 func (s *Service) getBypassUser(_ context.Context) (*entities.User, error) {
 	objectID, _ := primitive.ObjectIDFromHex("000000000000000000000001")
@@ -108,17 +334,19 @@ func (s *Service) getBypassUser(_ context.Context) (*entities.User, error) {
 		ID:    objectID,
 		Email: BypassUserEmail,
 		Name:  "Bypass User",
+		Role:  entities.UserRoleAdmin,
 		Wallet: entities.Wallet{
 			Diamonds: 1000,
-			Savings:  1000,
+			Savings:  entities.Money{Amount: 1000, Currency: entities.DefaultCurrency},
 		},
 		Character: entities.Character{
 			ID:       characterID.Hex(),
 			Name:     "Bypass Character",
 			ImageURL: "https://example.com/bypass-character.png",
 		},
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		OwnedCharacters: []string{characterID.Hex()},
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
 	s.log.WithField("email", BypassUserEmail).Infof("Access using bypass user")
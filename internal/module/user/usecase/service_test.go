@@ -7,10 +7,14 @@ import (
 
 	"github.com/Financial-Partner/server/internal/entities"
 	"github.com/Financial-Partner/server/internal/infrastructure/logger"
+	"github.com/Financial-Partner/server/internal/interfaces/http/dto"
+	user_domain "github.com/Financial-Partner/server/internal/module/user/domain"
 	user_repository "github.com/Financial-Partner/server/internal/module/user/repository"
 	user_usecase "github.com/Financial-Partner/server/internal/module/user/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/mock/gomock"
 )
 
@@ -23,7 +27,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "test@example.com"
 
@@ -47,7 +51,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "test@example.com"
 
@@ -74,7 +78,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "test@example.com"
 
@@ -101,7 +105,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "test@example.com"
 
@@ -124,7 +128,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "test@example.com"
 		name := "Existing User"
@@ -149,7 +153,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "new@example.com"
 		name := "New User"
@@ -167,6 +171,7 @@ func TestService(t *testing.T) {
 			func(ctx context.Context, entity *entities.User) (*entities.User, error) {
 				assert.Equal(t, email, entity.Email)
 				assert.Equal(t, name, entity.Name)
+				assert.Equal(t, entities.UserRoleUser, entity.Role)
 				return createdUser, nil
 			},
 		)
@@ -185,7 +190,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "new@example.com"
 		name := "New User"
@@ -221,7 +226,7 @@ func TestService(t *testing.T) {
 		mockStore := user_repository.NewMockUserStore(ctrl)
 		mockLogger := logger.NewNopLogger()
 
-		svc := user_usecase.NewService(mockRepo, mockStore, mockLogger)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
 		ctx := context.Background()
 		email := "fail@example.com"
 		name := "Fail User"
@@ -239,4 +244,748 @@ func TestService(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Equal(t, creationErr, err)
 	})
+
+	t.Run("UpdateWalletSuccess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		expectedUser := &entities.User{
+			ID:    userID,
+			Email: "test@example.com",
+		}
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(10), entities.Money{}).Return(expectedUser, nil)
+		mockStore.EXPECT().Delete(ctx, expectedUser.Email).Return(nil)
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), 10, entities.Money{})
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, result)
+	})
+
+	t.Run("UpdateWalletSuccessButDeleteFromStoreFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		expectedUser := &entities.User{
+			ID:    userID,
+			Email: "test@example.com",
+		}
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(0), entities.Money{Amount: 100, Currency: "USD"}).Return(expectedUser, nil)
+		mockStore.EXPECT().Delete(ctx, expectedUser.Email).Return(errors.New("store error"))
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), 0, entities.Money{Amount: 100, Currency: "USD"})
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, result)
+	})
+
+	t.Run("UpdateWalletInsufficientBalance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(-10), entities.Money{}).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), -10, entities.Money{})
+		assert.ErrorIs(t, err, user_domain.ErrInsufficientBalance)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateWalletInsufficientSavings", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+		debit := entities.Money{Amount: -500, Currency: "USD"}
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(0), debit).Return(nil, mongo.ErrNoDocuments)
+		mockRepo.EXPECT().FindById(ctx, userID).Return(&entities.User{
+			ID:     userID,
+			Wallet: entities.Wallet{Savings: entities.Money{Amount: 100, Currency: "USD"}},
+		}, nil)
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), 0, debit)
+		assert.ErrorIs(t, err, user_domain.ErrInsufficientBalance)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateWalletCurrencyMismatch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+		debit := entities.Money{Amount: -500, Currency: "EUR"}
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(0), debit).Return(nil, mongo.ErrNoDocuments)
+		mockRepo.EXPECT().FindById(ctx, userID).Return(&entities.User{
+			ID:     userID,
+			Wallet: entities.Wallet{Savings: entities.Money{Amount: 10000, Currency: "USD"}},
+		}, nil)
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), 0, debit)
+		assert.ErrorIs(t, err, user_domain.ErrCurrencyMismatch)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateWalletSavingsOfMissingUser", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+		credit := entities.Money{Amount: 500, Currency: "USD"}
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(0), credit).Return(nil, mongo.ErrNoDocuments)
+		mockRepo.EXPECT().FindById(ctx, userID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), 0, credit)
+		assert.ErrorIs(t, err, user_domain.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateWalletUserNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(10), entities.Money{}).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), 10, entities.Money{})
+		assert.ErrorIs(t, err, user_domain.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateWalletRepoFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateWallet(ctx, userID, int64(10), entities.Money{}).Return(nil, errors.New("db error"))
+
+		result, err := svc.UpdateWallet(ctx, userID.Hex(), 10, entities.Money{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateWalletInvalidUserID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+
+		result, err := svc.UpdateWallet(context.Background(), "invalid", 10, entities.Money{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+	t.Run("GetCharactersSuccess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, mockCharacterRepo, mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		user := &entities.User{ID: userID, OwnedCharacters: []string{"char_001", "char_002"}}
+		expectedCharacters := []entities.Character{
+			{ID: "char_001", Name: "Financial Assistant"},
+			{ID: "char_002", Name: "Savings Coach"},
+		}
+
+		mockRepo.EXPECT().FindById(ctx, userID).Return(user, nil)
+		mockCharacterRepo.EXPECT().FindByIds(ctx, user.OwnedCharacters).Return(expectedCharacters, nil)
+
+		result, err := svc.GetCharacters(ctx, userID.Hex())
+		require.NoError(t, err)
+		assert.Equal(t, expectedCharacters, result)
+	})
+
+	t.Run("GetCharactersNoneOwned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().FindById(ctx, userID).Return(&entities.User{ID: userID}, nil)
+
+		result, err := svc.GetCharacters(ctx, userID.Hex())
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("GetCharactersUserNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().FindById(ctx, userID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.GetCharacters(ctx, userID.Hex())
+		assert.ErrorIs(t, err, user_domain.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("GetCharactersCatalogFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, mockCharacterRepo, mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		user := &entities.User{ID: userID, OwnedCharacters: []string{"char_001"}}
+
+		mockRepo.EXPECT().FindById(ctx, userID).Return(user, nil)
+		mockCharacterRepo.EXPECT().FindByIds(ctx, user.OwnedCharacters).Return(nil, errors.New("db error"))
+
+		result, err := svc.GetCharacters(ctx, userID.Hex())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("GetCharactersInvalidUserID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+
+		result, err := svc.GetCharacters(context.Background(), "invalid")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateTimezoneSuccess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, logger.NewNopLogger())
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+		expectedUser := &entities.User{ID: userID, Email: "test@example.com", Timezone: "Asia/Taipei"}
+
+		mockRepo.EXPECT().UpdateTimezone(ctx, userID, "Asia/Taipei").Return(expectedUser, nil)
+		mockStore.EXPECT().Delete(ctx, expectedUser.Email).Return(nil)
+
+		result, err := svc.UpdateTimezone(ctx, userID.Hex(), "Asia/Taipei")
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, result)
+	})
+
+	t.Run("UpdateTimezoneInvalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+
+		for _, timezone := range []string{"", "Local", "Mars/Olympus_Mons", "+08:00"} {
+			result, err := svc.UpdateTimezone(context.Background(), primitive.NewObjectID().Hex(), timezone)
+			assert.ErrorIs(t, err, user_domain.ErrInvalidTimezone, timezone)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("UpdateTimezoneInvalidUserID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+
+		result, err := svc.UpdateTimezone(context.Background(), "invalid", "UTC")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateTimezoneUserNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateTimezone(gomock.Any(), userID, "Europe/Paris").Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.UpdateTimezone(context.Background(), userID.Hex(), "Europe/Paris")
+		assert.ErrorIs(t, err, user_domain.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateTimezoneRepoFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateTimezone(gomock.Any(), userID, "Europe/Paris").Return(nil, errors.New("db error"))
+
+		result, err := svc.UpdateTimezone(context.Background(), userID.Hex(), "Europe/Paris")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, user_domain.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateUserSuccess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, logger.NewNopLogger())
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+		expectedUser := &entities.User{ID: userID, Email: "test@example.com", Name: "New Name", Currency: "EUR"}
+
+		mockRepo.EXPECT().UpdateProfile(ctx, userID, "New Name", "EUR").Return(expectedUser, nil)
+		mockStore.EXPECT().Delete(ctx, expectedUser.Email).Return(nil)
+
+		result, err := svc.UpdateUser(ctx, userID.Hex(), &dto.UpdateUserRequest{Name: " New Name ", Currency: "eur"})
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, result)
+	})
+
+	t.Run("UpdateUserInvalidCurrency", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+
+		result, err := svc.UpdateUser(context.Background(), primitive.NewObjectID().Hex(), &dto.UpdateUserRequest{Currency: "XYZ"})
+		assert.ErrorIs(t, err, user_domain.ErrInvalidCurrency)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateUserInvalidUserID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+
+		result, err := svc.UpdateUser(context.Background(), "invalid", &dto.UpdateUserRequest{Name: "New Name"})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateUserCurrencyLocked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateProfile(gomock.Any(), userID, "", "EUR").Return(nil, mongo.ErrNoDocuments)
+		mockRepo.EXPECT().FindById(gomock.Any(), userID).Return(&entities.User{ID: userID}, nil)
+
+		result, err := svc.UpdateUser(context.Background(), userID.Hex(), &dto.UpdateUserRequest{Currency: "EUR"})
+		assert.ErrorIs(t, err, user_domain.ErrCurrencyLocked)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateUserNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateProfile(gomock.Any(), userID, "New Name", "").Return(nil, mongo.ErrNoDocuments)
+		mockRepo.EXPECT().FindById(gomock.Any(), userID).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.UpdateUser(context.Background(), userID.Hex(), &dto.UpdateUserRequest{Name: "New Name"})
+		assert.ErrorIs(t, err, user_domain.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateUserRepoFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateProfile(gomock.Any(), userID, "New Name", "").Return(nil, errors.New("db error"))
+
+		result, err := svc.UpdateUser(context.Background(), userID.Hex(), &dto.UpdateUserRequest{Name: "New Name"})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UpdateUserLookupFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().UpdateProfile(gomock.Any(), userID, "", "EUR").Return(nil, mongo.ErrNoDocuments)
+		mockRepo.EXPECT().FindById(gomock.Any(), userID).Return(nil, errors.New("db error"))
+
+		result, err := svc.UpdateUser(context.Background(), userID.Hex(), &dto.UpdateUserRequest{Currency: "EUR"})
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, user_domain.ErrCurrencyLocked)
+		assert.Nil(t, result)
+	})
+
+	t.Run("EquipCharacterSuccess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, mockCharacterRepo, mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		character := &entities.Character{ID: "char_001", Name: "Financial Assistant"}
+		expectedUser := &entities.User{
+			ID:        userID,
+			Email:     "test@example.com",
+			Character: *character,
+		}
+
+		mockCharacterRepo.EXPECT().FindById(ctx, character.ID).Return(character, nil)
+		mockRepo.EXPECT().EquipCharacter(ctx, userID, *character).Return(expectedUser, nil)
+		mockStore.EXPECT().Delete(ctx, expectedUser.Email).Return(nil)
+
+		result, err := svc.EquipCharacter(ctx, userID.Hex(), character.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedUser, result)
+	})
+
+	t.Run("EquipCharacterNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, mockCharacterRepo, mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockCharacterRepo.EXPECT().FindById(ctx, "char_404").Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.EquipCharacter(ctx, userID.Hex(), "char_404")
+		assert.ErrorIs(t, err, user_domain.ErrCharacterNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("EquipCharacterCatalogFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, mockCharacterRepo, mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockCharacterRepo.EXPECT().FindById(ctx, "char_001").Return(nil, errors.New("db error"))
+
+		result, err := svc.EquipCharacter(ctx, userID.Hex(), "char_001")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("EquipCharacterNotOwned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, mockCharacterRepo, mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		character := &entities.Character{ID: "char_001", Name: "Financial Assistant"}
+
+		mockCharacterRepo.EXPECT().FindById(ctx, character.ID).Return(character, nil)
+		mockRepo.EXPECT().EquipCharacter(ctx, userID, *character).Return(nil, mongo.ErrNoDocuments)
+
+		result, err := svc.EquipCharacter(ctx, userID.Hex(), character.ID)
+		assert.ErrorIs(t, err, user_domain.ErrCharacterNotOwned)
+		assert.Nil(t, result)
+	})
+
+	t.Run("EquipCharacterRepoFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, mockCharacterRepo, mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		character := &entities.Character{ID: "char_001", Name: "Financial Assistant"}
+
+		mockCharacterRepo.EXPECT().FindById(ctx, character.ID).Return(character, nil)
+		mockRepo.EXPECT().EquipCharacter(ctx, userID, *character).Return(nil, errors.New("db error"))
+
+		result, err := svc.EquipCharacter(ctx, userID.Hex(), character.ID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("EquipCharacterInvalidUserID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+
+		result, err := svc.EquipCharacter(context.Background(), "invalid", "char_001")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("UnlockCharacterSuccess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		expectedUser := &entities.User{
+			ID:              userID,
+			Email:           "test@example.com",
+			OwnedCharacters: []string{"char_001"},
+		}
+
+		mockRepo.EXPECT().AddCharacter(ctx, userID, "char_001").Return(expectedUser, nil)
+		mockStore.EXPECT().Delete(ctx, expectedUser.Email).Return(nil)
+
+		err := svc.UnlockCharacter(ctx, userID.Hex(), "char_001")
+		assert.NoError(t, err)
+	})
+
+	t.Run("UnlockCharacterUserNotFound", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().AddCharacter(ctx, userID, "char_001").Return(nil, mongo.ErrNoDocuments)
+
+		err := svc.UnlockCharacter(ctx, userID.Hex(), "char_001")
+		assert.ErrorIs(t, err, user_domain.ErrUserNotFound)
+	})
+
+	t.Run("UnlockCharacterRepoFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+		ctx := context.Background()
+		userID := primitive.NewObjectID()
+
+		mockRepo.EXPECT().AddCharacter(ctx, userID, "char_001").Return(nil, errors.New("db error"))
+
+		err := svc.UnlockCharacter(ctx, userID.Hex(), "char_001")
+		assert.Error(t, err)
+	})
+
+	t.Run("UnlockCharacterInvalidUserID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := user_repository.NewMockRepository(ctrl)
+		mockStore := user_repository.NewMockUserStore(ctrl)
+		mockLogger := logger.NewNopLogger()
+
+		svc := user_usecase.NewService(mockRepo, user_repository.NewMockCharacterRepository(ctrl), mockStore, mockLogger)
+
+		err := svc.UnlockCharacter(context.Background(), "invalid", "char_001")
+		assert.Error(t, err)
+	})
+
+	t.Run("CreateCharacterSuccess", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), mockCharacterRepo, user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		ctx := context.Background()
+
+		req := &dto.CreateCharacterRequest{ID: "char_010", Name: "Advisor", ImageURL: "https://example.com/advisor.png"}
+		expected := &entities.Character{ID: req.ID, Name: req.Name, ImageURL: req.ImageURL}
+
+		mockCharacterRepo.EXPECT().FindById(ctx, "char_010").Return(nil, mongo.ErrNoDocuments)
+		mockCharacterRepo.EXPECT().Create(ctx, expected).Return(expected, nil)
+
+		result, err := svc.CreateCharacter(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("CreateCharacterAlreadyExists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), mockCharacterRepo, user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		ctx := context.Background()
+
+		mockCharacterRepo.EXPECT().FindById(ctx, "char_001").Return(&entities.Character{ID: "char_001"}, nil)
+
+		result, err := svc.CreateCharacter(ctx, &dto.CreateCharacterRequest{ID: "char_001", Name: "Advisor"})
+		assert.ErrorIs(t, err, user_domain.ErrCharacterExists)
+		assert.Nil(t, result)
+	})
+
+	t.Run("CreateCharacterCatalogFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), mockCharacterRepo, user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		ctx := context.Background()
+
+		mockCharacterRepo.EXPECT().FindById(ctx, "char_010").Return(nil, errors.New("db error"))
+
+		result, err := svc.CreateCharacter(ctx, &dto.CreateCharacterRequest{ID: "char_010", Name: "Advisor"})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("CreateCharacterRepoFailure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCharacterRepo := user_repository.NewMockCharacterRepository(ctrl)
+		svc := user_usecase.NewService(user_repository.NewMockRepository(ctrl), mockCharacterRepo, user_repository.NewMockUserStore(ctrl), logger.NewNopLogger())
+		ctx := context.Background()
+
+		mockCharacterRepo.EXPECT().FindById(ctx, "char_010").Return(nil, mongo.ErrNoDocuments)
+		mockCharacterRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := svc.CreateCharacter(ctx, &dto.CreateCharacterRequest{ID: "char_010", Name: "Advisor"})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
 }
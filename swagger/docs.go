@@ -165,9 +165,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/gacha/draw": {
+        "/characters": {
             "post": {
-                "description": "Decrease user's gacha amount and return gacha result",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a character to the catalog. Admin only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -175,32 +180,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "gacha"
+                    "users"
                 ],
-                "summary": "Decrease user's gacha amount and return gacha result",
+                "summary": "CreateCharacter",
                 "parameters": [
-                    {
-                        "description": "Draw gacha request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.DrawGachaRequest"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "Create character request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateCharacterRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Character created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CharacterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
                         "schema": {
-                            "$ref": "#/definitions/dto.GachaResponse"
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
@@ -209,8 +220,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Character already exists",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -218,9 +241,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/gacha/preview": {
-            "get": {
-                "description": "Get 9 gacha images for preview",
+        "/gacha/draw": {
+            "post": {
+                "description": "Debit the pool's cost from the user's diamonds and add a randomly drawn item to their inventory, or shards if they already own it. The latest pool is used when no pool is given.",
                 "consumes": [
                     "application/json"
                 ],
@@ -230,8 +253,17 @@ const docTemplate = `{
                 "tags": [
                     "gacha"
                 ],
-                "summary": "Get 9 gacha images for preview",
+                "summary": "Spend diamonds to draw a gacha",
                 "parameters": [
+                    {
+                        "description": "Draw gacha request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DrawGachaRequest"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
@@ -244,7 +276,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PreviewGachasResponse"
+                            "$ref": "#/definitions/dto.GachaDrawResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
@@ -253,6 +291,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -262,9 +312,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/goals": {
-            "get": {
-                "description": "Get user's current saving goal and status",
+        "/gacha/draw/batch": {
+            "post": {
+                "description": "Make up to 10 draws from a pool at a discount. Either all draws succeed or no diamonds are spent. Duplicates are converted into shards.",
                 "consumes": [
                     "application/json"
                 ],
@@ -272,10 +322,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "goals"
+                    "gacha"
                 ],
-                "summary": "Get current saving goal",
+                "summary": "Spend diamonds to draw several gachas at once",
                 "parameters": [
+                    {
+                        "description": "Batch draw gacha request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchDrawGachaRequest"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
@@ -288,7 +347,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetGoalResponse"
+                            "$ref": "#/definitions/dto.BatchDrawGachaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
@@ -297,6 +362,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -304,9 +381,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Set user's saving goal amount and period",
+            }
+        },
+        "/gacha/inventory": {
+            "get": {
+                "description": "List the items the user has drawn, newest first, and their shard balance",
                 "consumes": [
                     "application/json"
                 ],
@@ -314,19 +393,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "goals"
+                    "gacha"
                 ],
-                "summary": "Create user's saving goal",
+                "summary": "Get the user's gacha inventory",
                 "parameters": [
-                    {
-                        "description": "Create goal request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateGoalRequest"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
@@ -339,13 +409,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GoalResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.GetGachaInventoryResponse"
                         }
                     },
                     "401": {
@@ -363,9 +427,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/goals/suggestion": {
+        "/gacha/pools": {
             "post": {
-                "description": "Calculate and return suggested saving goals based on user's input expense data",
+                "description": "Add a pool of items that can be drawn for a fixed diamond cost. Admin only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -373,17 +437,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "goals"
+                    "gacha"
                 ],
-                "summary": "Calculate and return suggested saving goals based on user's input expense data",
+                "summary": "Create a gacha pool",
                 "parameters": [
                     {
-                        "description": "Goal suggestion request",
+                        "description": "Create gacha pool request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.GoalSuggestionRequest"
+                            "$ref": "#/definitions/dto.CreateGachaPoolRequest"
                         }
                     },
                     {
@@ -398,7 +462,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GoalSuggestionResponse"
+                            "$ref": "#/definitions/dto.GachaPoolResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
@@ -407,6 +477,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -416,9 +492,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/goals/suggestion/me": {
+        "/gacha/preview": {
             "get": {
-                "description": "Calculate and return suggested saving goals based on user's expense data",
+                "description": "Get up to 9 items of a gacha pool with the pool's drop rates and the user's pity progress. The latest pool is previewed when no pool is given.",
                 "consumes": [
                     "application/json"
                 ],
@@ -426,10 +502,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "goals"
+                    "gacha"
                 ],
-                "summary": "Calculate and return suggested saving goals based on user's expense data",
+                "summary": "Get 9 gacha images for preview",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Gacha pool ID",
+                        "name": "pool_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
@@ -442,7 +524,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GoalSuggestionResponse"
+                            "$ref": "#/definitions/dto.PreviewGachasResponse"
                         }
                     },
                     "401": {
@@ -451,6 +533,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -460,9 +548,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/investments": {
+        "/goals": {
             "get": {
-                "description": "Get investment opportunities for a user",
+                "description": "List the user's saving goals in funding order, optionally filtered by status",
                 "consumes": [
                     "application/json"
                 ],
@@ -470,10 +558,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "investments"
+                    "goals"
                 ],
-                "summary": "Get investment opportunities",
+                "summary": "List saving goals",
                 "parameters": [
+                    {
+                        "enum": [
+                            "active",
+                            "completed",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Goal status",
+                        "name": "status",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
@@ -486,7 +585,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetOpportunitiesResponse"
+                            "$ref": "#/definitions/dto.GetGoalsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
@@ -504,7 +609,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create an investment opportunity",
+                "description": "Create a new saving goal. A user can have several active goals; each income is allocated across them by allocation percent first and then by priority.",
                 "consumes": [
                     "application/json"
                 ],
@@ -512,17 +617,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "investments"
+                    "goals"
                 ],
-                "summary": "Create an investment opportunity",
+                "summary": "Create a saving goal",
                 "parameters": [
                     {
-                        "description": "Create opportunity request",
+                        "description": "Create goal request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateOpportunityRequest"
+                            "$ref": "#/definitions/dto.CreateGoalRequest"
                         }
                     },
                     {
@@ -534,10 +639,10 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateOpportunityResponse"
+                            "$ref": "#/definitions/dto.GoalResponse"
                         }
                     },
                     "400": {
@@ -561,9 +666,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/reports/analysis": {
+        "/goals/milestones": {
             "get": {
-                "description": "Get report summary generated by AI for a user",
+                "description": "Get the progress tracks of the user's active saving goals, in the order the goals are funded. Each milestone names its goal.",
                 "consumes": [
                     "application/json"
                 ],
@@ -571,9 +676,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reports"
+                    "goals"
                 ],
-                "summary": "Get report summary",
+                "summary": "Get milestones of the active saving goals",
                 "parameters": [
                     {
                         "type": "string",
@@ -587,7 +692,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ReportSummaryResponse"
+                            "$ref": "#/definitions/dto.GetGoalMilestonesResponse"
                         }
                     },
                     "401": {
@@ -596,6 +701,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -605,9 +716,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/reports/finance": {
-            "get": {
-                "description": "Get report for a user",
+        "/goals/suggestion": {
+            "post": {
+                "description": "Calculate and return suggested saving goals based on user's input expense data",
                 "consumes": [
                     "application/json"
                 ],
@@ -615,10 +726,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reports"
+                    "goals"
                 ],
-                "summary": "Get report",
+                "summary": "Calculate and return suggested saving goals based on user's input expense data",
                 "parameters": [
+                    {
+                        "description": "Goal suggestion request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GoalSuggestionRequest"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
@@ -628,29 +748,16 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Type of report (summary or detailed)",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Start time as Unix timestamp (seconds since epoch)",
-                        "name": "start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "End time as Unix timestamp (seconds since epoch)",
-                        "name": "end",
-                        "in": "query"
+                        "description": "Language of the suggestion message, e.g. zh-TW",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ReportResponse"
+                            "$ref": "#/definitions/dto.GoalSuggestionResponse"
                         }
                     },
                     "400": {
@@ -674,9 +781,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/transactions": {
+        "/goals/suggestion/me": {
             "get": {
-                "description": "Get transactions for a user",
+                "description": "Calculate and return suggested saving goals from the average income and expenses of the user's recorded transactions",
                 "consumes": [
                     "application/json"
                 ],
@@ -684,9 +791,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "goals"
                 ],
-                "summary": "Get transactions",
+                "summary": "Calculate and return suggested saving goals based on user's expense data",
                 "parameters": [
                     {
                         "type": "string",
@@ -694,13 +801,19 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the suggestion message, e.g. zh-TW",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetTransactionsResponse"
+                            "$ref": "#/definitions/dto.GoalSuggestionResponse"
                         }
                     },
                     "401": {
@@ -716,9 +829,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a transaction for user",
+            }
+        },
+        "/goals/{id}": {
+            "get": {
+                "description": "Get one of the user's saving goals and its status",
                 "consumes": [
                     "application/json"
                 ],
@@ -726,18 +841,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "goals"
                 ],
-                "summary": "Create a transaction",
+                "summary": "Get a saving goal",
                 "parameters": [
                     {
-                        "description": "Create transaction request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateTransactionRequest"
-                        }
+                        "type": "string",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
@@ -751,17 +864,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TransactionResponse"
+                            "$ref": "#/definitions/dto.GetGoalResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -773,16 +886,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/me": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get the detailed information of the current user, with the option to return specific data fields",
+            },
+            "put": {
+                "description": "Change the name, target, period, priority or allocation percent of an active saving goal",
                 "consumes": [
                     "application/json"
                 ],
@@ -790,33 +896,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "goals"
                 ],
-                "summary": "GetUser",
+                "summary": "Update a saving goal",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update goal request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateGoalRequest"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Fields to include (profile, wallet, character). If not specified, returns all",
-                        "name": "scope",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved user information",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetUserResponse"
+                            "$ref": "#/definitions/dto.GoalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
@@ -825,21 +943,28 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
             },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Update the current user's nickname",
+            "delete": {
+                "description": "Delete one of the user's saving goals",
                 "consumes": [
                     "application/json"
                 ],
@@ -847,48 +972,100 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "goals"
                 ],
-                "summary": "UpdateUser",
+                "summary": "Delete a saving goal",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "Bearer {token}",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
-                    {
-                        "description": "Update user request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
+                }
+            }
+        },
+        "/goals/{id}/milestones": {
+            "get": {
+                "description": "Get the progress track of a saving goal and the rewards of each milestone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Get milestones of a saving goal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Update user successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserResponse"
+                            "$ref": "#/definitions/dto.GetGoalMilestonesResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request format",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -896,9 +1073,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/investments": {
+        "/investments": {
             "get": {
-                "description": "Get user investments",
+                "description": "Get investment opportunities for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -908,7 +1085,7 @@ const docTemplate = `{
                 "tags": [
                     "investments"
                 ],
-                "summary": "Get user investments",
+                "summary": "Get investment opportunities",
                 "parameters": [
                     {
                         "type": "string",
@@ -922,7 +1099,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetUserInvestmentsResponse"
+                            "$ref": "#/definitions/dto.GetOpportunitiesResponse"
                         }
                     },
                     "401": {
@@ -940,7 +1117,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create investment for a user",
+                "description": "Create an investment opportunity. Admin only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -950,15 +1127,15 @@ const docTemplate = `{
                 "tags": [
                     "investments"
                 ],
-                "summary": "Create user investment",
+                "summary": "Create an investment opportunity",
                 "parameters": [
                     {
-                        "description": "Create user investment request",
+                        "description": "Create opportunity request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateUserInvestmentRequest"
+                            "$ref": "#/definitions/dto.CreateOpportunityRequest"
                         }
                     },
                     {
@@ -973,11 +1150,11 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateUserInvestmentResponse"
+                            "$ref": "#/definitions/dto.CreateOpportunityResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid currency, duration days, variation or minimum amount",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -988,6 +1165,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -996,70 +1179,1466 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.CharacterResponse": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "char_001"
-                },
-                "image_url": {
-                    "type": "string",
-                    "example": "https://example.com/characters/advisor.png"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "Character Name"
-                }
-            }
         },
-        "dto.CreateGoalRequest": {
-            "type": "object",
-            "required": [
-                "period",
-                "target_amount"
-            ],
-            "properties": {
-                "period": {
-                    "type": "integer",
-                    "example": 30
-                },
-                "target_amount": {
-                    "type": "integer",
-                    "example": 10000
+        "/investments/{id}/prices": {
+            "get": {
+                "description": "Get the simulated daily market prices of an investment opportunity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Get opportunity prices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Opportunity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetOpportunityPricesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "dto.CreateOpportunityRequest": {
-            "type": "object",
-            "required": [
-                "description",
-                "duration",
-                "is_increase",
-                "min_amount",
-                "tags",
-                "title",
-                "variation"
-            ],
-            "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "Investment in stock market is a good way to make money"
-                },
+        "/reports/analysis": {
+            "get": {
+                "description": "Get a plain-language summary of the user's report for a period, generated by AI or from a template depending on the server configuration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get report summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period of the report: daily, weekly, monthly (default) or yearly",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Start time as Unix timestamp (seconds since epoch), defaults to the start of the current period",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "End time as Unix timestamp (seconds since epoch), exclusive, defaults to one period after start",
+                        "name": "end",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/finance": {
+            "get": {
+                "description": "Get report for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period of the report: daily, weekly, monthly (default) or yearly",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Start time as Unix timestamp (seconds since epoch), defaults to the start of the current period",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "End time as Unix timestamp (seconds since epoch), exclusive, defaults to one period after start",
+                        "name": "end",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket length of the time series: daily, weekly, monthly or yearly, picked from the period length by default",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/finance/export": {
+            "get": {
+                "description": "Download the user's report for a period together with its transactions as CSV or as a PDF with a category chart. The format is taken from the format parameter, or negotiated from the Accept header when it is missing",
+                "produces": [
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Export report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Export format: csv or pdf",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period of the report: daily, weekly, monthly (default) or yearly",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Start time as Unix timestamp (seconds since epoch), defaults to the start of the current period",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "End time as Unix timestamp (seconds since epoch), exclusive, defaults to one period after start",
+                        "name": "end",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket length of the time series: daily, weekly, monthly or yearly, picked from the period length by default",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/history": {
+            "get": {
+                "description": "List the user's monthly report snapshots, most recent first. A snapshot is taken when a month ends in the user's timezone and doesn't change afterwards",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get report history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportHistoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "description": "Get a page of the user's transactions, newest first by default. Pass next_cursor from a response as cursor to get the following page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Earliest transaction date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest transaction date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "income",
+                            "expense"
+                        ],
+                        "type": "string",
+                        "description": "Transaction type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum amount",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum amount",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text to search for in descriptions",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Date order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page to get",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetTransactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a transaction for user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Create a transaction",
+                "parameters": [
+                    {
+                        "description": "Create transaction request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/import": {
+            "post": {
+                "description": "Preview the transactions of a CSV, OFX or QFX bank statement row by row. Rows matching a recorded transaction by date, amount and description are duplicates. A preview saves nothing; send the same file with commit set to save the new rows",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Import a bank statement",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Bank statement",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ofx",
+                            "qfx"
+                        ],
+                        "type": "string",
+                        "description": "Statement format, by default the file name's extension",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column mapping of a CSV statement, a JSON dto.CSVMapping",
+                        "name": "mapping",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency of amounts the statement gives none for, by default the user's base currency",
+                        "name": "currency",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Save the new rows",
+                        "name": "commit",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportTransactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}": {
+            "get": {
+                "description": "Get one of the user's transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Correct the amount, category, type, date or description of one of the user's transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Update a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update transaction request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete one of the user's transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Delete a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the detailed information of the current user, with the option to return specific data fields",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "GetUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Fields to include (profile, wallet, character). If not specified, returns all",
+                        "name": "scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved user information",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the current user's nickname and base currency. The currency can only change while savings are empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "UpdateUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update user request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update user successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Savings are held in the current currency",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/character": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Equip one of the current user's unlocked characters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "EquipCharacter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Equip character request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EquipCharacterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Character equipped successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request format",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Character has not been unlocked",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Character not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/characters": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the characters the current user has unlocked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "GetCharacters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved characters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetCharactersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/investments": {
+            "get": {
+                "description": "Get user investments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Get user investments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUserInvestmentsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create investment for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Create user investment",
+                "parameters": [
+                    {
+                        "description": "Create user investment request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserInvestmentRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserInvestmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid opportunity ID, amount below the minimum or currency other than the opportunity's or savings'",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Opportunity not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Not enough savings",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/portfolio": {
+            "get": {
+                "description": "Get the user's investments aggregated by opportunity, with profit and loss and allocation by tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investments"
+                ],
+                "summary": "Get user portfolio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetPortfolioResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/timezone": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the timezone the current user's monthly report snapshots follow",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "UpdateTimezone",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update timezone request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTimezoneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Timezone updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid timezone",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.BatchDrawGachaRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "count"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 900
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "pool_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567891"
+                }
+            }
+        },
+        "dto.BatchDrawGachaResponse": {
+            "type": "object",
+            "properties": {
+                "draws": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GachaDrawResponse"
+                    }
+                },
+                "shards": {
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
+        "dto.CharacterResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "char_001"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://example.com/characters/advisor.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Character Name"
+                }
+            }
+        },
+        "dto.CreateCharacterRequest": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "char_001"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://example.com/characters/advisor.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Character Name"
+                }
+            }
+        },
+        "dto.CreateGachaItemRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "rarity",
+                "weight"
+            ],
+            "properties": {
+                "character_id": {
+                    "type": "string",
+                    "example": "piggy"
+                },
+                "img_src": {
+                    "type": "string",
+                    "example": "https://example.com/image.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Golden piggy bank"
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "rare"
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "dto.CreateGachaPoolRequest": {
+            "type": "object",
+            "required": [
+                "cost",
+                "items",
+                "name"
+            ],
+            "properties": {
+                "cost": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CreateGachaItemRequest"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Spring festival"
+                }
+            }
+        },
+        "dto.CreateGoalRequest": {
+            "type": "object",
+            "required": [
+                "period",
+                "target_amount"
+            ],
+            "properties": {
+                "allocation_percent": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Trip to Japan"
+                },
+                "period": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "target_amount": {
+                    "$ref": "#/definitions/dto.Money"
+                }
+            }
+        },
+        "dto.CreateOpportunityRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "duration",
+                "duration_days",
+                "is_increase",
+                "min_amount",
+                "tags",
+                "title",
+                "variation"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Investment in stock market is a good way to make money"
+                },
                 "duration": {
                     "type": "string",
                     "example": "a month"
                 },
+                "duration_days": {
+                    "type": "integer",
+                    "example": 30
+                },
                 "is_increase": {
                     "type": "boolean",
                     "example": true
                 },
                 "min_amount": {
-                    "type": "integer",
-                    "example": 1000
+                    "$ref": "#/definitions/dto.Money"
                 },
                 "tags": {
                     "type": "array",
@@ -1089,104 +2668,293 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.CreateTransactionRequest": {
+        "dto.CreateTransactionRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category",
+                "date",
+                "description",
+                "transaction_type"
+            ],
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/dto.Money"
+                },
+                "category": {
+                    "type": "string",
+                    "example": "Food"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2023-01-01"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Lunch"
+                },
+                "transaction_type": {
+                    "type": "string",
+                    "example": "Expense"
+                }
+            }
+        },
+        "dto.CreateUserInvestmentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "opportunity_id"
+            ],
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/dto.Money"
+                },
+                "opportunity_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                }
+            }
+        },
+        "dto.CreateUserInvestmentResponse": {
+            "type": "object",
+            "properties": {
+                "investment": {
+                    "$ref": "#/definitions/dto.InvestmentResponse"
+                }
+            }
+        },
+        "dto.DrawGachaRequest": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "pool_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567891"
+                }
+            }
+        },
+        "dto.EquipCharacterRequest": {
+            "type": "object",
+            "required": [
+                "character_id"
+            ],
+            "properties": {
+                "character_id": {
+                    "type": "string",
+                    "example": "char_001"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GachaDrawResponse": {
+            "type": "object",
+            "properties": {
+                "duplicate": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
+                "img_src": {
+                    "type": "string",
+                    "example": "https://example.com/image.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Golden piggy bank"
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "rare"
+                },
+                "shards": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "dto.GachaDropRateResponse": {
+            "type": "object",
+            "properties": {
+                "rarity": {
+                    "type": "string",
+                    "example": "legendary"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 1.5
+                }
+            }
+        },
+        "dto.GachaInventoryItemResponse": {
+            "type": "object",
+            "properties": {
+                "drawn_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "gacha_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567892"
+                },
+                "img_src": {
+                    "type": "string",
+                    "example": "https://example.com/image.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Golden piggy bank"
+                },
+                "pool_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567891"
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "rare"
+                }
+            }
+        },
+        "dto.GachaPityResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "rare"
+                },
+                "threshold": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "dto.GachaPoolResponse": {
             "type": "object",
-            "required": [
-                "amount",
-                "category",
-                "date",
-                "description",
-                "transaction_type"
-            ],
             "properties": {
-                "amount": {
+                "cost": {
                     "type": "integer",
-                    "example": 1000
+                    "example": 100
                 },
-                "category": {
+                "created_at": {
                     "type": "string",
-                    "example": "Food"
+                    "example": "2023-01-01T00:00:00Z"
                 },
-                "date": {
+                "id": {
                     "type": "string",
-                    "example": "2023-01-01"
+                    "example": "60d6ec33f777b123e4567891"
                 },
-                "description": {
-                    "type": "string",
-                    "example": "Lunch"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GachaResponse"
+                    }
                 },
-                "transaction_type": {
+                "name": {
                     "type": "string",
-                    "example": "Expense"
+                    "example": "Spring festival"
                 }
             }
         },
-        "dto.CreateUserInvestmentRequest": {
+        "dto.GachaResponse": {
             "type": "object",
-            "required": [
-                "amount",
-                "opportunity_id"
-            ],
             "properties": {
-                "amount": {
-                    "type": "integer",
-                    "example": 1000
-                },
-                "opportunity_id": {
+                "id": {
                     "type": "string",
                     "example": "60d6ec33f777b123e4567890"
+                },
+                "img_src": {
+                    "type": "string",
+                    "example": "https://example.com/image.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Golden piggy bank"
+                },
+                "rarity": {
+                    "type": "string",
+                    "example": "rare"
                 }
             }
         },
-        "dto.CreateUserInvestmentResponse": {
+        "dto.GetCharactersResponse": {
             "type": "object",
             "properties": {
-                "investment": {
-                    "$ref": "#/definitions/dto.InvestmentResponse"
+                "characters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CharacterResponse"
+                    }
                 }
             }
         },
-        "dto.DrawGachaRequest": {
+        "dto.GetGachaInventoryResponse": {
             "type": "object",
-            "required": [
-                "amount"
-            ],
             "properties": {
-                "amount": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GachaInventoryItemResponse"
+                    }
+                },
+                "shards": {
                     "type": "integer",
-                    "example": 100
+                    "example": 25
                 }
             }
         },
-        "dto.ErrorResponse": {
+        "dto.GetGoalMilestonesResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "message": {
-                    "type": "string"
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GoalMilestoneResponse"
+                    }
                 }
             }
         },
-        "dto.GachaResponse": {
+        "dto.GetGoalResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "60d6ec33f777b123e4567890"
-                },
-                "img_src": {
-                    "type": "string",
-                    "example": "https://example.com/image.png"
+                "goal": {
+                    "$ref": "#/definitions/dto.GoalResponse"
                 }
             }
         },
-        "dto.GetGoalResponse": {
+        "dto.GetGoalsResponse": {
             "type": "object",
             "properties": {
-                "goal": {
-                    "$ref": "#/definitions/dto.GoalResponse"
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GoalResponse"
+                    }
                 }
             }
         },
@@ -1201,9 +2969,65 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.GetOpportunityPricesResponse": {
+            "type": "object",
+            "properties": {
+                "opportunity_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
+                "prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PricePointResponse"
+                    }
+                }
+            }
+        },
+        "dto.GetPortfolioResponse": {
+            "type": "object",
+            "properties": {
+                "allocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagAllocationResponse"
+                    }
+                },
+                "cost_basis": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "current_value": {
+                    "type": "integer",
+                    "example": 1080
+                },
+                "holdings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HoldingResponse"
+                    }
+                },
+                "realized_pnl": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "unrealized_pnl": {
+                    "type": "integer",
+                    "example": 80
+                }
+            }
+        },
         "dto.GetTransactionsResponse": {
             "type": "object",
             "properties": {
+                "next_cursor": {
+                    "type": "string",
+                    "example": "AAABhYJ8hQBg1uwz93eyE-RWeJA"
+                },
                 "transactions": {
                     "type": "array",
                     "items": {
@@ -1233,6 +3057,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2025-03-07T12:00:00Z"
                 },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
                 "email": {
                     "type": "string",
                     "example": "user@example.com"
@@ -1245,6 +3073,14 @@ const docTemplate = `{
                     "type": "string",
                     "example": "User Name"
                 },
+                "role": {
+                    "type": "string",
+                    "example": "user"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Taipei"
+                },
                 "updated_at": {
                     "type": "string",
                     "example": "2025-03-07T12:00:00Z"
@@ -1254,86 +3090,229 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.GoalMilestoneResponse": {
+            "type": "object",
+            "properties": {
+                "character_id": {
+                    "type": "string",
+                    "example": "char_001"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2023-01-15T00:00:00Z"
+                },
+                "goal_id": {
+                    "description": "set when milestones of several goals are listed",
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
+                "is_completed": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "reward": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "reward_withheld": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "target_percent": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Halfway there"
+                }
+            }
+        },
         "dto.GoalResponse": {
             "type": "object",
             "properties": {
+                "allocation_percent": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "created_at": {
                     "type": "string",
                     "example": "2023-01-01T00:00:00Z"
                 },
                 "current_amount": {
+                    "$ref": "#/definitions/dto.Money"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Trip to Japan"
+                },
+                "period": {
                     "type": "integer",
-                    "example": 5000
+                    "example": 30
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "target_amount": {
+                    "$ref": "#/definitions/dto.Money"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-06-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.GoalSuggestionRequest": {
+            "type": "object",
+            "required": [
+                "daily_expenses",
+                "daily_income",
+                "monthly_expenses",
+                "monthly_income",
+                "weekly_expenses",
+                "weekly_income"
+            ],
+            "properties": {
+                "currency": {
+                    "description": "Currency of the amounts above, in its minor units; defaults to USD.",
+                    "type": "string",
+                    "example": "TWD"
+                },
+                "daily_expenses": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "daily_income": {
+                    "type": "integer",
+                    "example": 2000
+                },
+                "monthly_expenses": {
+                    "type": "integer",
+                    "example": 30000
+                },
+                "monthly_income": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "weekly_expenses": {
+                    "type": "integer",
+                    "example": 7000
+                },
+                "weekly_income": {
+                    "type": "integer",
+                    "example": 14000
+                }
+            }
+        },
+        "dto.GoalSuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Based on your income and expense analysis, we recommend that you can save 150.00 TWD per month."
                 },
                 "period": {
                     "type": "integer",
                     "example": 30
                 },
-                "status": {
+                "suggested_amount": {
+                    "$ref": "#/definitions/dto.Money"
+                }
+            }
+        },
+        "dto.HoldingResponse": {
+            "type": "object",
+            "properties": {
+                "cost_basis": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "current_value": {
+                    "type": "integer",
+                    "example": 1080
+                },
+                "opportunity_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
+                "realized_pnl": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "high risk",
+                        "long term"
+                    ]
+                },
+                "title": {
                     "type": "string",
-                    "example": "Need to work harder"
+                    "example": "Real Estate"
                 },
-                "target_amount": {
+                "unrealized_pnl": {
                     "type": "integer",
-                    "example": 10000
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2023-06-01T00:00:00Z"
+                    "example": 80
                 }
             }
         },
-        "dto.GoalSuggestionRequest": {
+        "dto.ImportRowResponse": {
             "type": "object",
-            "required": [
-                "daily_expenses",
-                "daily_income",
-                "monthly_expenses",
-                "monthly_income",
-                "weekly_expenses",
-                "weekly_income"
-            ],
             "properties": {
-                "daily_expenses": {
-                    "type": "integer",
-                    "example": 1000
-                },
-                "daily_income": {
-                    "type": "integer",
-                    "example": 2000
-                },
-                "monthly_expenses": {
-                    "type": "integer",
-                    "example": 30000
+                "error": {
+                    "type": "string",
+                    "example": "amount \"12,5\" is not a number"
                 },
-                "monthly_income": {
+                "line": {
                     "type": "integer",
-                    "example": 50000
+                    "example": 2
                 },
-                "weekly_expenses": {
-                    "type": "integer",
-                    "example": 7000
+                "status": {
+                    "description": "new, duplicate or invalid",
+                    "type": "string",
+                    "example": "new"
                 },
-                "weekly_income": {
-                    "type": "integer",
-                    "example": 14000
+                "transaction": {
+                    "$ref": "#/definitions/dto.TransactionResponse"
                 }
             }
         },
-        "dto.GoalSuggestionResponse": {
+        "dto.ImportTransactionsResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "Based on your income and expense analysis, we recommend that you can save 15,000 yuan per month."
+                "committed": {
+                    "description": "Committed tells whether the new rows were saved.",
+                    "type": "boolean"
                 },
-                "period": {
+                "duplicates": {
                     "type": "integer",
-                    "example": 30
+                    "example": 3
                 },
-                "suggested_amount": {
+                "invalid": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "new": {
                     "type": "integer",
-                    "example": 15000
+                    "example": 12
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportRowResponse"
+                    }
                 }
             }
         },
@@ -1341,17 +3320,31 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "amount": {
-                    "type": "integer",
-                    "example": 1000
+                    "$ref": "#/definitions/dto.Money"
                 },
                 "created_at": {
                     "type": "string",
                     "example": "2023-01-01T00:00:00Z"
                 },
+                "matures_at": {
+                    "type": "string",
+                    "example": "2023-01-31T00:00:00Z"
+                },
                 "opportunity_id": {
                     "type": "string",
                     "example": "60d6ec33f777b123e4567890"
                 },
+                "payout": {
+                    "$ref": "#/definitions/dto.Money"
+                },
+                "settled_at": {
+                    "type": "string",
+                    "example": "2023-01-31T00:01:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "settled"
+                },
                 "updated_at": {
                     "type": "string",
                     "example": "2023-06-01T00:00:00Z"
@@ -1419,6 +3412,19 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.Money": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                }
+            }
+        },
         "dto.OpportunityResponse": {
             "type": "object",
             "properties": {
@@ -1434,13 +3440,16 @@ const docTemplate = `{
                     "type": "string",
                     "example": "a month"
                 },
+                "duration_days": {
+                    "type": "integer",
+                    "example": 30
+                },
                 "is_increase": {
                     "type": "boolean",
                     "example": true
                 },
                 "min_amount": {
-                    "type": "integer",
-                    "example": 1000
+                    "$ref": "#/definitions/dto.Money"
                 },
                 "opportunity_id": {
                     "type": "string",
@@ -1473,11 +3482,41 @@ const docTemplate = `{
         "dto.PreviewGachasResponse": {
             "type": "object",
             "properties": {
+                "cost": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "drop_rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GachaDropRateResponse"
+                    }
+                },
                 "gachas": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/dto.GachaResponse"
                     }
+                },
+                "pity": {
+                    "$ref": "#/definitions/dto.GachaPityResponse"
+                },
+                "pool_id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567891"
+                }
+            }
+        },
+        "dto.PricePointResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2023-01-01"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 101.25
                 }
             }
         },
@@ -1514,6 +3553,67 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.ReportBucketResponse": {
+            "type": "object",
+            "properties": {
+                "expense": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "income": {
+                    "type": "integer",
+                    "example": 3000
+                },
+                "net": {
+                    "type": "integer",
+                    "example": 1800
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2025-03-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.ReportComparisonResponse": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "integer",
+                    "example": 6000
+                },
+                "expenses_change": {
+                    "type": "number",
+                    "example": -16.67
+                },
+                "net_profit": {
+                    "type": "integer",
+                    "example": 2000
+                },
+                "net_profit_change": {
+                    "type": "number",
+                    "example": 150
+                },
+                "revenue": {
+                    "type": "integer",
+                    "example": 8000
+                },
+                "revenue_change": {
+                    "type": "number",
+                    "example": 25
+                }
+            }
+        },
+        "dto.ReportHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "snapshots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReportSnapshotResponse"
+                    }
+                }
+            }
+        },
         "dto.ReportResponse": {
             "type": "object",
             "required": [
@@ -1545,10 +3645,22 @@ const docTemplate = `{
                         "Transport"
                     ]
                 },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "end": {
+                    "type": "string",
+                    "example": "2025-04-01T00:00:00Z"
+                },
                 "expenses": {
                     "type": "integer",
                     "example": 5000
                 },
+                "interval": {
+                    "type": "string",
+                    "example": "daily"
+                },
                 "net_profit": {
                     "type": "integer",
                     "example": 5000
@@ -1563,21 +3675,79 @@ const docTemplate = `{
                         0.67
                     ]
                 },
+                "previous": {
+                    "$ref": "#/definitions/dto.ReportComparisonResponse"
+                },
                 "revenue": {
                     "type": "integer",
                     "example": 10000
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReportBucketResponse"
+                    }
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2025-03-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.ReportSnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-03-31T16:05:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
+                "report": {
+                    "$ref": "#/definitions/dto.ReportResponse"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Taipei"
                 }
             }
         },
         "dto.ReportSummaryResponse": {
             "type": "object",
             "properties": {
+                "end": {
+                    "type": "string",
+                    "example": "2025-04-01T00:00:00Z"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2025-03-01T00:00:00Z"
+                },
                 "summary": {
                     "type": "string",
                     "example": "Report generated by AI"
                 }
             }
         },
+        "dto.TagAllocationResponse": {
+            "type": "object",
+            "properties": {
+                "percent": {
+                    "type": "number",
+                    "example": 50
+                },
+                "tag": {
+                    "type": "string",
+                    "example": "high risk"
+                },
+                "value": {
+                    "type": "integer",
+                    "example": 540
+                }
+            }
+        },
         "dto.TransactionResponse": {
             "type": "object",
             "required": [
@@ -1589,8 +3759,15 @@ const docTemplate = `{
             ],
             "properties": {
                 "amount": {
-                    "type": "integer",
-                    "example": 1000
+                    "$ref": "#/definitions/dto.Money"
+                },
+                "base_amount": {
+                    "description": "in the user's base currency",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.Money"
+                        }
+                    ]
                 },
                 "category": {
                     "type": "string",
@@ -1608,6 +3785,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Lunch"
                 },
+                "id": {
+                    "type": "string",
+                    "example": "60d6ec33f777b123e4567890"
+                },
                 "transaction_type": {
                     "type": "string",
                     "example": "Expense"
@@ -1618,12 +3799,85 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.UpdateUserRequest": {
+        "dto.UpdateGoalRequest": {
             "type": "object",
             "required": [
-                "name"
+                "period",
+                "target_amount"
+            ],
+            "properties": {
+                "allocation_percent": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Trip to Japan"
+                },
+                "period": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "target_amount": {
+                    "$ref": "#/definitions/dto.Money"
+                }
+            }
+        },
+        "dto.UpdateTimezoneRequest": {
+            "type": "object",
+            "required": [
+                "timezone"
+            ],
+            "properties": {
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Taipei"
+                }
+            }
+        },
+        "dto.UpdateTransactionRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category",
+                "date",
+                "description",
+                "transaction_type"
             ],
             "properties": {
+                "amount": {
+                    "$ref": "#/definitions/dto.Money"
+                },
+                "category": {
+                    "type": "string",
+                    "example": "Food"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2023-01-01"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Lunch"
+                },
+                "transaction_type": {
+                    "type": "string",
+                    "example": "Expense"
+                }
+            }
+        },
+        "dto.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "only while savings are empty",
+                    "type": "string",
+                    "example": "EUR"
+                },
                 "name": {
                     "type": "string",
                     "example": "New User Name"
@@ -1633,6 +3887,13 @@ const docTemplate = `{
         "dto.UpdateUserResponse": {
             "type": "object",
             "properties": {
+                "character": {
+                    "$ref": "#/definitions/dto.CharacterResponse"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
                 "diamonds": {
                     "type": "integer",
                     "example": 100
@@ -1650,8 +3911,7 @@ const docTemplate = `{
                     "example": "New User Name"
                 },
                 "savings": {
-                    "type": "integer",
-                    "example": 5000
+                    "$ref": "#/definitions/dto.Money"
                 },
                 "updated_at": {
                     "type": "string",
@@ -1692,8 +3952,7 @@ const docTemplate = `{
                     "example": 100
                 },
                 "savings": {
-                    "type": "integer",
-                    "example": 5000
+                    "$ref": "#/definitions/dto.Money"
                 }
             }
         }